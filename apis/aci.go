@@ -20,9 +20,15 @@ package apis
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	admin "github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
 	applicationmanagement "github.com/jgomezve/provider-aci/apis/application-management/v1alpha1"
+	fabric "github.com/jgomezve/provider-aci/apis/fabric/v1alpha1"
+	monitoring "github.com/jgomezve/provider-aci/apis/monitoring/v1alpha1"
 	networking "github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	rest "github.com/jgomezve/provider-aci/apis/rest/v1alpha1"
+	services "github.com/jgomezve/provider-aci/apis/services/v1alpha1"
 	aciv1alpha1 "github.com/jgomezve/provider-aci/apis/v1alpha1"
+	vmm "github.com/jgomezve/provider-aci/apis/vmm/v1alpha1"
 )
 
 func init() {
@@ -31,6 +37,12 @@ func init() {
 		aciv1alpha1.SchemeBuilder.AddToScheme,
 		networking.SchemeBuilder.AddToScheme,
 		applicationmanagement.SchemeBuilder.AddToScheme,
+		vmm.SchemeBuilder.AddToScheme,
+		fabric.SchemeBuilder.AddToScheme,
+		admin.SchemeBuilder.AddToScheme,
+		services.SchemeBuilder.AddToScheme,
+		monitoring.SchemeBuilder.AddToScheme,
+		rest.SchemeBuilder.AddToScheme,
 	)
 }
 
@@ -0,0 +1,113 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A ProviderRef is a member (aaaProviderRef) of an AuthProviderGroup.
+type ProviderRef struct {
+	// Name is the name of the LDAPProvider, RADIUSProvider or
+	// TACACSPlusProvider, depending on the protocol of the group.
+	Name string `json:"name"`
+	// Order is the priority of the server in the group, starting at 1.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=16
+	Order int `json:"order"`
+}
+
+// AuthProviderGroupParameters are the configurable fields of a
+// AuthProviderGroup.
+type AuthProviderGroupParameters struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Enum=ldap;radius;tacacs
+	Protocol string `json:"protocol"`
+	// Providers are reconciled as a whole: providers that are not listed
+	// here are removed from the group.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	Providers []ProviderRef `json:"providers,omitempty"`
+}
+
+// AuthProviderGroupObservation are the observable fields of a
+// AuthProviderGroup.
+type AuthProviderGroupObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A AuthProviderGroupSpec defines the desired state of a AuthProviderGroup.
+type AuthProviderGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AuthProviderGroupParameters `json:"forProvider"`
+}
+
+// A AuthProviderGroupStatus represents the observed state of a AuthProviderGroup.
+type AuthProviderGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AuthProviderGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AuthProviderGroup is a group of LDAP, RADIUS or TACACS+ servers
+// (aaaLdapProviderGroup, aaaRadiusProviderGroup or aaaTacacsPlusProviderGroup)
+// a login domain authenticates against.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type AuthProviderGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AuthProviderGroupSpec   `json:"spec"`
+	Status AuthProviderGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AuthProviderGroupList contains a list of AuthProviderGroup
+type AuthProviderGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AuthProviderGroup `json:"items"`
+}
+
+// AuthProviderGroup type metadata.
+var (
+	AuthProviderGroupKind             = reflect.TypeOf(AuthProviderGroup{}).Name()
+	AuthProviderGroupGroupKind        = schema.GroupKind{Group: Group, Kind: AuthProviderGroupKind}.String()
+	AuthProviderGroupKindAPIVersion   = AuthProviderGroupKind + "." + SchemeGroupVersion.String()
+	AuthProviderGroupGroupVersionKind = SchemeGroupVersion.WithKind(AuthProviderGroupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AuthProviderGroup{}, &AuthProviderGroupList{})
+}
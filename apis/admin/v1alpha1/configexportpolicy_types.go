@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ConfigJobStatus is the status of the last job (configJob) run by a
+// configuration export or import policy.
+type ConfigJobStatus struct {
+	Name                string `json:"name,omitempty"`
+	Status              string `json:"status,omitempty"`
+	Details             string `json:"details,omitempty"`
+	FileName            string `json:"fileName,omitempty"`
+	ExecuteTime         string `json:"executeTime,omitempty"`
+	LastStepDescription string `json:"lastStepDescription,omitempty"`
+}
+
+// ConfigExportPolicyParameters are the configurable fields of a
+// ConfigExportPolicy.
+type ConfigExportPolicyParameters struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=json;xml
+	// +kubebuilder:default=json
+	Format string `json:"format"`
+	// TargetDn limits the export to the subtree of the supplied DN, e.g.
+	// uni/tn-common. The whole configuration is exported if it is empty.
+	// +kubebuilder:validation:Optional
+	TargetDn string `json:"targetDn"`
+	// Snapshot stores the export on the APIC instead of a remote location.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=yes
+	Snapshot string `json:"snapshot"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=yes
+	IncludeSecureFields string `json:"includeSecureFields"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=global-limit
+	MaxSnapshotCount string `json:"maxSnapshotCount"`
+	// Scheduler is the name of the scheduler (trigSchedP) that runs the
+	// export.
+	// +kubebuilder:validation:Optional
+	Scheduler string `json:"scheduler"`
+	// RemoteLocation is the name of the remote location (fileRemotePath)
+	// the export is copied to when Snapshot is no.
+	// +kubebuilder:validation:Optional
+	RemoteLocation string `json:"remoteLocation"`
+}
+
+// ConfigExportPolicyObservation are the observable fields of a
+// ConfigExportPolicy.
+type ConfigExportPolicyObservation struct {
+	Dn      string          `json:"dn,omitempty"`
+	LastJob ConfigJobStatus `json:"lastJob,omitempty"`
+}
+
+// A ConfigExportPolicySpec defines the desired state of a ConfigExportPolicy.
+type ConfigExportPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ConfigExportPolicyParameters `json:"forProvider"`
+}
+
+// A ConfigExportPolicyStatus represents the observed state of a ConfigExportPolicy.
+type ConfigExportPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ConfigExportPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ConfigExportPolicy is a configuration export policy (configExportP).
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:printcolumn:name="JOB",type="string",JSONPath=".status.atProvider.lastJob.status"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type ConfigExportPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConfigExportPolicySpec   `json:"spec"`
+	Status ConfigExportPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ConfigExportPolicyList contains a list of ConfigExportPolicy
+type ConfigExportPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConfigExportPolicy `json:"items"`
+}
+
+// ConfigExportPolicy type metadata.
+var (
+	ConfigExportPolicyKind             = reflect.TypeOf(ConfigExportPolicy{}).Name()
+	ConfigExportPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: ConfigExportPolicyKind}.String()
+	ConfigExportPolicyKindAPIVersion   = ConfigExportPolicyKind + "." + SchemeGroupVersion.String()
+	ConfigExportPolicyGroupVersionKind = SchemeGroupVersion.WithKind(ConfigExportPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ConfigExportPolicy{}, &ConfigExportPolicyList{})
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ConfigRollbackParameters are the configurable fields of a ConfigRollback.
+type ConfigRollbackParameters struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// Snapshot is the file name of the snapshot to roll back to, e.g.
+	// ce2_DailyAutoBackup-2023-01-01T00-00-00.tar.gz.
+	Snapshot string `json:"snapshot"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=replace;merge
+	// +kubebuilder:default=replace
+	ImportType string `json:"importType"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=atomic;best-effort
+	// +kubebuilder:default=atomic
+	ImportMode string `json:"importMode"`
+	// Trigger runs the rollback once each time it is set to a new value.
+	// The rollback is never run while Trigger is empty or unchanged.
+	// +kubebuilder:validation:Optional
+	Trigger string `json:"trigger"`
+}
+
+// ConfigRollbackObservation are the observable fields of a ConfigRollback.
+type ConfigRollbackObservation struct {
+	Dn string `json:"dn,omitempty"`
+	// LastTrigger is the value of Trigger the rollback was last run with.
+	LastTrigger string          `json:"lastTrigger,omitempty"`
+	LastJob     ConfigJobStatus `json:"lastJob,omitempty"`
+}
+
+// A ConfigRollbackSpec defines the desired state of a ConfigRollback.
+type ConfigRollbackSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ConfigRollbackParameters `json:"forProvider"`
+}
+
+// A ConfigRollbackStatus represents the observed state of a ConfigRollback.
+type ConfigRollbackStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ConfigRollbackObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ConfigRollback is a configuration import policy (configImportP) that
+// rolls the configuration back to a snapshot.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:printcolumn:name="JOB",type="string",JSONPath=".status.atProvider.lastJob.status"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type ConfigRollback struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConfigRollbackSpec   `json:"spec"`
+	Status ConfigRollbackStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ConfigRollbackList contains a list of ConfigRollback
+type ConfigRollbackList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConfigRollback `json:"items"`
+}
+
+// ConfigRollback type metadata.
+var (
+	ConfigRollbackKind             = reflect.TypeOf(ConfigRollback{}).Name()
+	ConfigRollbackGroupKind        = schema.GroupKind{Group: Group, Kind: ConfigRollbackKind}.String()
+	ConfigRollbackKindAPIVersion   = ConfigRollbackKind + "." + SchemeGroupVersion.String()
+	ConfigRollbackGroupVersionKind = SchemeGroupVersion.WithKind(ConfigRollbackKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ConfigRollback{}, &ConfigRollbackList{})
+}
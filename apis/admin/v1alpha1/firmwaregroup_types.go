@@ -0,0 +1,113 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A NodeBlock is a range of fabric node IDs (fabricNodeBlk).
+type NodeBlock struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Minimum=101
+	// +kubebuilder:validation:Maximum=16000
+	From int `json:"from"`
+	// +kubebuilder:validation:Minimum=101
+	// +kubebuilder:validation:Maximum=16000
+	To int `json:"to"`
+}
+
+// FirmwareGroupParameters are the configurable fields of a FirmwareGroup.
+type FirmwareGroupParameters struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// Version is the target firmware version, e.g. n9000-15.2(7f).
+	Version string `json:"version"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=no
+	IgnoreCompatibility string `json:"ignoreCompatibility"`
+	// NodeBlocks are reconciled as a whole: blocks that are not listed here
+	// are removed from the group.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	NodeBlocks []NodeBlock `json:"nodeBlocks,omitempty"`
+}
+
+// FirmwareGroupObservation are the observable fields of a FirmwareGroup.
+type FirmwareGroupObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A FirmwareGroupSpec defines the desired state of a FirmwareGroup.
+type FirmwareGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FirmwareGroupParameters `json:"forProvider"`
+}
+
+// A FirmwareGroupStatus represents the observed state of a FirmwareGroup.
+type FirmwareGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FirmwareGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A FirmwareGroup is a firmware group (firmwareFwGrp) with the firmware policy
+// (firmwareFwP) holding its target version.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type FirmwareGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FirmwareGroupSpec   `json:"spec"`
+	Status FirmwareGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FirmwareGroupList contains a list of FirmwareGroup
+type FirmwareGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FirmwareGroup `json:"items"`
+}
+
+// FirmwareGroup type metadata.
+var (
+	FirmwareGroupKind             = reflect.TypeOf(FirmwareGroup{}).Name()
+	FirmwareGroupGroupKind        = schema.GroupKind{Group: Group, Kind: FirmwareGroupKind}.String()
+	FirmwareGroupKindAPIVersion   = FirmwareGroupKind + "." + SchemeGroupVersion.String()
+	FirmwareGroupGroupVersionKind = SchemeGroupVersion.WithKind(FirmwareGroupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&FirmwareGroup{}, &FirmwareGroupList{})
+}
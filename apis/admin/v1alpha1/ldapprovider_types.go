@@ -0,0 +1,138 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// LDAPProviderParameters are the configurable fields of a LDAPProvider.
+type LDAPProviderParameters struct {
+	// Name is the hostname or IP address of the LDAP server.
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="389"
+	Port string `json:"port"`
+	// RootDn is the DN the provider binds with, e.g.
+	// cn=admin,dc=example,dc=com.
+	// +kubebuilder:validation:Optional
+	RootDn string `json:"rootDn"`
+	// BindPasswordSecretRef references the secret key holding the password
+	// of RootDn. The password is only pushed when the secret changes.
+	// +kubebuilder:validation:Optional
+	BindPasswordSecretRef *xpv1.SecretKeySelector `json:"bindPasswordSecretRef,omitempty"`
+	// BaseDn is the DN users are searched under, e.g. dc=example,dc=com.
+	// +kubebuilder:validation:Optional
+	BaseDn string `json:"baseDn"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="cn=$userid"
+	Filter string `json:"filter"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=CiscoAVPair
+	Attribute string `json:"attribute"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=no
+	EnableSSL string `json:"enableSsl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=strict;permissive
+	// +kubebuilder:default=strict
+	SSLValidationLevel string `json:"sslValidationLevel"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="30"
+	Timeout string `json:"timeout"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="1"
+	Retries string `json:"retries"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +kubebuilder:default=disabled
+	MonitorServer string `json:"monitorServer"`
+	// MonitoringUser is the user used to check that the server is reachable
+	// when MonitorServer is enabled.
+	// +kubebuilder:validation:Optional
+	MonitoringUser string `json:"monitoringUser"`
+	// ManagementEPG is the management EPG the server is reached through,
+	// either oob-default or inb-<name>.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=oob-default
+	ManagementEPG string `json:"managementEpg"`
+}
+
+// LDAPProviderObservation are the observable fields of a LDAPProvider.
+type LDAPProviderObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A LDAPProviderSpec defines the desired state of a LDAPProvider.
+type LDAPProviderSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LDAPProviderParameters `json:"forProvider"`
+}
+
+// A LDAPProviderStatus represents the observed state of a LDAPProvider.
+type LDAPProviderStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LDAPProviderObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An LDAPProvider is an LDAP server (aaaLdapProvider) users are
+// authenticated against.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type LDAPProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LDAPProviderSpec   `json:"spec"`
+	Status LDAPProviderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LDAPProviderList contains a list of LDAPProvider
+type LDAPProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LDAPProvider `json:"items"`
+}
+
+// LDAPProvider type metadata.
+var (
+	LDAPProviderKind             = reflect.TypeOf(LDAPProvider{}).Name()
+	LDAPProviderGroupKind        = schema.GroupKind{Group: Group, Kind: LDAPProviderKind}.String()
+	LDAPProviderKindAPIVersion   = LDAPProviderKind + "." + SchemeGroupVersion.String()
+	LDAPProviderGroupVersionKind = SchemeGroupVersion.WithKind(LDAPProviderKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&LDAPProvider{}, &LDAPProviderList{})
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A UserRole is a role (aaaUserRole) of a LocalUser in a security domain.
+type UserRole struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=readPriv;writePriv
+	// +kubebuilder:default=readPriv
+	PrivilegeType string `json:"privilegeType"`
+}
+
+// A UserDomain is a security domain (aaaUserDomain) a LocalUser has access
+// to, with the roles of the user in it.
+type UserDomain struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	Roles []UserRole `json:"roles,omitempty"`
+}
+
+// LocalUserParameters are the configurable fields of a LocalUser.
+type LocalUserParameters struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// PasswordSecretRef references the secret key holding the password of
+	// the user. The password is only pushed when the secret changes.
+	PasswordSecretRef xpv1.SecretKeySelector `json:"passwordSecretRef"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=active;inactive
+	// +kubebuilder:default=active
+	AccountStatus string `json:"accountStatus"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=no
+	Expires string `json:"expires"`
+	// Expiration is the expiration date of the account in the format APIC
+	// reports it, e.g. 2024-12-31T00:00:00.000+00:00. It is only used when
+	// Expires is yes.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=never
+	Expiration string `json:"expiration"`
+	// +kubebuilder:validation:Optional
+	Email string `json:"email"`
+	// +kubebuilder:validation:Optional
+	FirstName string `json:"firstName"`
+	// +kubebuilder:validation:Optional
+	LastName string `json:"lastName"`
+	// +kubebuilder:validation:Optional
+	Phone string `json:"phone"`
+	// Domains are reconciled as a whole: domains that are not listed here
+	// are removed from the user.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	Domains []UserDomain `json:"domains,omitempty"`
+}
+
+// LocalUserObservation are the observable fields of a LocalUser.
+type LocalUserObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A LocalUserSpec defines the desired state of a LocalUser.
+type LocalUserSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LocalUserParameters `json:"forProvider"`
+}
+
+// A LocalUserStatus represents the observed state of a LocalUser.
+type LocalUserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LocalUserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LocalUser is a local APIC user (aaaUser).
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type LocalUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LocalUserSpec   `json:"spec"`
+	Status LocalUserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LocalUserList contains a list of LocalUser
+type LocalUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LocalUser `json:"items"`
+}
+
+// LocalUser type metadata.
+var (
+	LocalUserKind             = reflect.TypeOf(LocalUser{}).Name()
+	LocalUserGroupKind        = schema.GroupKind{Group: Group, Kind: LocalUserKind}.String()
+	LocalUserKindAPIVersion   = LocalUserKind + "." + SchemeGroupVersion.String()
+	LocalUserGroupVersionKind = SchemeGroupVersion.WithKind(LocalUserKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&LocalUser{}, &LocalUserList{})
+}
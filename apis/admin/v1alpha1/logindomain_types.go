@@ -0,0 +1,99 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// LoginDomainParameters are the configurable fields of a LoginDomain.
+type LoginDomainParameters struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	NameAlias string `json:"nameAlias"`
+	// Realm is the authentication realm (aaaDomainAuth) of the domain.
+	// +kubebuilder:validation:Enum=local;ldap;radius;tacacs
+	Realm string `json:"realm"`
+	// ProviderGroup is the name of the AuthProviderGroup of the realm. It is
+	// required unless the realm is local.
+	// +kubebuilder:validation:Optional
+	ProviderGroup string `json:"providerGroup"`
+}
+
+// LoginDomainObservation are the observable fields of a LoginDomain.
+type LoginDomainObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A LoginDomainSpec defines the desired state of a LoginDomain.
+type LoginDomainSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LoginDomainParameters `json:"forProvider"`
+}
+
+// A LoginDomainStatus represents the observed state of a LoginDomain.
+type LoginDomainStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LoginDomainObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LoginDomain is a login domain (aaaLoginDomain) mapping the users
+// that log in to it to an authentication realm.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type LoginDomain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LoginDomainSpec   `json:"spec"`
+	Status LoginDomainStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoginDomainList contains a list of LoginDomain
+type LoginDomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoginDomain `json:"items"`
+}
+
+// LoginDomain type metadata.
+var (
+	LoginDomainKind             = reflect.TypeOf(LoginDomain{}).Name()
+	LoginDomainGroupKind        = schema.GroupKind{Group: Group, Kind: LoginDomainKind}.String()
+	LoginDomainKindAPIVersion   = LoginDomainKind + "." + SchemeGroupVersion.String()
+	LoginDomainGroupVersionKind = SchemeGroupVersion.WithKind(LoginDomainKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&LoginDomain{}, &LoginDomainList{})
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// MaintenanceGroupParameters are the configurable fields of a
+// MaintenanceGroup.
+type MaintenanceGroupParameters struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// Version is the firmware version the nodes are upgraded to, e.g.
+	// n9000-15.2(7f).
+	Version string `json:"version"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=pauseOnlyOnFailures;pauseAlwaysBetweenGroups;pauseNever
+	// +kubebuilder:default=pauseOnlyOnFailures
+	RunMode string `json:"runMode"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=no
+	Graceful string `json:"graceful"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=no
+	IgnoreCompatibility string `json:"ignoreCompatibility"`
+	// NodeBlocks are reconciled as a whole: blocks that are not listed here
+	// are removed from the group.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	NodeBlocks []NodeBlock `json:"nodeBlocks,omitempty"`
+	// Trigger starts the upgrade of the group once each time it is set to a
+	// new value. The upgrade is never started while Trigger is empty or
+	// unchanged, so resyncs and other updates never trigger it.
+	// +kubebuilder:validation:Optional
+	Trigger string `json:"trigger"`
+}
+
+// NodeUpgradeStatus is the upgrade progress of a node (maintUpgJob).
+type NodeUpgradeStatus struct {
+	Node           string `json:"node"`
+	Status         string `json:"status,omitempty"`
+	Progress       string `json:"progress,omitempty"`
+	DesiredVersion string `json:"desiredVersion,omitempty"`
+}
+
+// MaintenanceGroupObservation are the observable fields of a
+// MaintenanceGroup.
+type MaintenanceGroupObservation struct {
+	Dn string `json:"dn,omitempty"`
+	// LastTrigger is the value of Trigger the upgrade was last started with.
+	LastTrigger string `json:"lastTrigger,omitempty"`
+	// Nodes is the upgrade progress of each node of the group.
+	Nodes []NodeUpgradeStatus `json:"nodes,omitempty"`
+	// Completed, InProgress and Failed count the nodes of the group per
+	// upgrade state.
+	Completed  int `json:"completed,omitempty"`
+	InProgress int `json:"inProgress,omitempty"`
+	Failed     int `json:"failed,omitempty"`
+}
+
+// A MaintenanceGroupSpec defines the desired state of a MaintenanceGroup.
+type MaintenanceGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MaintenanceGroupParameters `json:"forProvider"`
+}
+
+// A MaintenanceGroupStatus represents the observed state of a MaintenanceGroup.
+type MaintenanceGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MaintenanceGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MaintenanceGroup is a maintenance group (maintMaintGrp) with the
+// maintenance policy (maintMaintP) that upgrades its nodes.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:printcolumn:name="COMPLETED",type="integer",JSONPath=".status.atProvider.completed"
+// +kubebuilder:printcolumn:name="FAILED",type="integer",JSONPath=".status.atProvider.failed"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type MaintenanceGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MaintenanceGroupSpec   `json:"spec"`
+	Status MaintenanceGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MaintenanceGroupList contains a list of MaintenanceGroup
+type MaintenanceGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MaintenanceGroup `json:"items"`
+}
+
+// MaintenanceGroup type metadata.
+var (
+	MaintenanceGroupKind             = reflect.TypeOf(MaintenanceGroup{}).Name()
+	MaintenanceGroupGroupKind        = schema.GroupKind{Group: Group, Kind: MaintenanceGroupKind}.String()
+	MaintenanceGroupKindAPIVersion   = MaintenanceGroupKind + "." + SchemeGroupVersion.String()
+	MaintenanceGroupGroupVersionKind = SchemeGroupVersion.WithKind(MaintenanceGroupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&MaintenanceGroup{}, &MaintenanceGroupList{})
+}
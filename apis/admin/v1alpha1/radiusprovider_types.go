@@ -0,0 +1,120 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// RADIUSProviderParameters are the configurable fields of a RADIUSProvider.
+type RADIUSProviderParameters struct {
+	// Name is the hostname or IP address of the server.
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="1812"
+	AuthPort string `json:"authPort"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=pap;chap;mschap
+	// +kubebuilder:default=pap
+	AuthProtocol string `json:"authProtocol"`
+	// KeySecretRef references the secret key holding the shared key of the
+	// server. The key is only pushed when the secret changes.
+	KeySecretRef xpv1.SecretKeySelector `json:"keySecretRef"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="30"
+	Timeout string `json:"timeout"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="1"
+	Retries string `json:"retries"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +kubebuilder:default=disabled
+	MonitorServer string `json:"monitorServer"`
+	// MonitoringUser is the user used to check that the server is reachable
+	// when MonitorServer is enabled.
+	// +kubebuilder:validation:Optional
+	MonitoringUser string `json:"monitoringUser"`
+	// ManagementEPG is the management EPG the server is reached through,
+	// either oob-default or inb-<name>.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=oob-default
+	ManagementEPG string `json:"managementEpg"`
+}
+
+// RADIUSProviderObservation are the observable fields of a RADIUSProvider.
+type RADIUSProviderObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A RADIUSProviderSpec defines the desired state of a RADIUSProvider.
+type RADIUSProviderSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RADIUSProviderParameters `json:"forProvider"`
+}
+
+// A RADIUSProviderStatus represents the observed state of a RADIUSProvider.
+type RADIUSProviderStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RADIUSProviderObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RADIUSProvider is a RADIUS server (aaaRadiusProvider) users are
+// authenticated against.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type RADIUSProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RADIUSProviderSpec   `json:"spec"`
+	Status RADIUSProviderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RADIUSProviderList contains a list of RADIUSProvider
+type RADIUSProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RADIUSProvider `json:"items"`
+}
+
+// RADIUSProvider type metadata.
+var (
+	RADIUSProviderKind             = reflect.TypeOf(RADIUSProvider{}).Name()
+	RADIUSProviderGroupKind        = schema.GroupKind{Group: Group, Kind: RADIUSProviderKind}.String()
+	RADIUSProviderKindAPIVersion   = RADIUSProviderKind + "." + SchemeGroupVersion.String()
+	RADIUSProviderGroupVersionKind = SchemeGroupVersion.WithKind(RADIUSProviderKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&RADIUSProvider{}, &RADIUSProviderList{})
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// RBACRuleParameters are the configurable fields of a RBACRule.
+type RBACRuleParameters struct {
+	// ObjectDn is the DN of the object the rule grants access to, e.g.
+	// uni/tn-app1.
+	ObjectDn string `json:"objectDn"`
+	// SecurityDomain is the name of the security domain granted access.
+	SecurityDomain string `json:"securityDomain"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=no
+	AllowWrites string `json:"allowWrites"`
+}
+
+// RBACRuleObservation are the observable fields of a RBACRule.
+type RBACRuleObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A RBACRuleSpec defines the desired state of a RBACRule.
+type RBACRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RBACRuleParameters `json:"forProvider"`
+}
+
+// A RBACRuleStatus represents the observed state of a RBACRule.
+type RBACRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RBACRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An RBACRule grants a security domain access to an object (aaaRbacRule).
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type RBACRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RBACRuleSpec   `json:"spec"`
+	Status RBACRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RBACRuleList contains a list of RBACRule
+type RBACRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RBACRule `json:"items"`
+}
+
+// RBACRule type metadata.
+var (
+	RBACRuleKind             = reflect.TypeOf(RBACRule{}).Name()
+	RBACRuleGroupKind        = schema.GroupKind{Group: Group, Kind: RBACRuleKind}.String()
+	RBACRuleKindAPIVersion   = RBACRuleKind + "." + SchemeGroupVersion.String()
+	RBACRuleGroupVersionKind = SchemeGroupVersion.WithKind(RBACRuleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&RBACRule{}, &RBACRuleList{})
+}
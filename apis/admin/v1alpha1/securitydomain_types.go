@@ -0,0 +1,91 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// SecurityDomainParameters are the configurable fields of a SecurityDomain.
+type SecurityDomainParameters struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	NameAlias string `json:"nameAlias"`
+}
+
+// SecurityDomainObservation are the observable fields of a SecurityDomain.
+type SecurityDomainObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A SecurityDomainSpec defines the desired state of a SecurityDomain.
+type SecurityDomainSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SecurityDomainParameters `json:"forProvider"`
+}
+
+// A SecurityDomainStatus represents the observed state of a SecurityDomain.
+type SecurityDomainStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SecurityDomainObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SecurityDomain is a security domain (aaaDomain).
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type SecurityDomain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecurityDomainSpec   `json:"spec"`
+	Status SecurityDomainStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecurityDomainList contains a list of SecurityDomain
+type SecurityDomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecurityDomain `json:"items"`
+}
+
+// SecurityDomain type metadata.
+var (
+	SecurityDomainKind             = reflect.TypeOf(SecurityDomain{}).Name()
+	SecurityDomainGroupKind        = schema.GroupKind{Group: Group, Kind: SecurityDomainKind}.String()
+	SecurityDomainKindAPIVersion   = SecurityDomainKind + "." + SchemeGroupVersion.String()
+	SecurityDomainGroupVersionKind = SchemeGroupVersion.WithKind(SecurityDomainKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SecurityDomain{}, &SecurityDomainList{})
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TACACSPlusProviderParameters are the configurable fields of a TACACSPlusProvider.
+type TACACSPlusProviderParameters struct {
+	// Name is the hostname or IP address of the server.
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="49"
+	Port string `json:"port"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=pap;chap;mschap
+	// +kubebuilder:default=pap
+	AuthProtocol string `json:"authProtocol"`
+	// KeySecretRef references the secret key holding the shared key of the
+	// server. The key is only pushed when the secret changes.
+	KeySecretRef xpv1.SecretKeySelector `json:"keySecretRef"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="30"
+	Timeout string `json:"timeout"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="1"
+	Retries string `json:"retries"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +kubebuilder:default=disabled
+	MonitorServer string `json:"monitorServer"`
+	// MonitoringUser is the user used to check that the server is reachable
+	// when MonitorServer is enabled.
+	// +kubebuilder:validation:Optional
+	MonitoringUser string `json:"monitoringUser"`
+	// ManagementEPG is the management EPG the server is reached through,
+	// either oob-default or inb-<name>.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=oob-default
+	ManagementEPG string `json:"managementEpg"`
+}
+
+// TACACSPlusProviderObservation are the observable fields of a TACACSPlusProvider.
+type TACACSPlusProviderObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A TACACSPlusProviderSpec defines the desired state of a TACACSPlusProvider.
+type TACACSPlusProviderSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TACACSPlusProviderParameters `json:"forProvider"`
+}
+
+// A TACACSPlusProviderStatus represents the observed state of a TACACSPlusProvider.
+type TACACSPlusProviderStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TACACSPlusProviderObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TACACSPlusProvider is a TACACS+ server (aaaTacacsPlusProvider) users are
+// authenticated against.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type TACACSPlusProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TACACSPlusProviderSpec   `json:"spec"`
+	Status TACACSPlusProviderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TACACSPlusProviderList contains a list of TACACSPlusProvider
+type TACACSPlusProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TACACSPlusProvider `json:"items"`
+}
+
+// TACACSPlusProvider type metadata.
+var (
+	TACACSPlusProviderKind             = reflect.TypeOf(TACACSPlusProvider{}).Name()
+	TACACSPlusProviderGroupKind        = schema.GroupKind{Group: Group, Kind: TACACSPlusProviderKind}.String()
+	TACACSPlusProviderKindAPIVersion   = TACACSPlusProviderKind + "." + SchemeGroupVersion.String()
+	TACACSPlusProviderGroupVersionKind = SchemeGroupVersion.WithKind(TACACSPlusProviderKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&TACACSPlusProvider{}, &TACACSPlusProviderList{})
+}
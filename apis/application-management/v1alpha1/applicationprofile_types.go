@@ -30,11 +30,17 @@ type ApplicationProfileParameters struct {
 	Tenant string `json:"tenant"`
 	// +kubebuilder:validation:Optional
 	NameAlias string `json:"nameAlias"`
+	// SecurityDomains are the names of the security domains (aaaDomainRef)
+	// the object belongs to. They are reconciled as a whole.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	SecurityDomains []string `json:"securityDomains,omitempty"`
 }
 
 // ApplicationProfileObservation are the observable fields of a ApplicationProfile.
 type ApplicationProfileObservation struct {
 	ObservableField string `json:"observableField,omitempty"`
+	Dn              string `json:"dn,omitempty"`
 }
 
 // A ApplicationProfileSpec defines the desired state of a ApplicationProfile.
@@ -56,6 +62,7 @@ type ApplicationProfileStatus struct {
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
 type ApplicationProfile struct {
@@ -25,18 +25,154 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+// A USegIPAttribute matches endpoints by IP address (fvIpAttr).
+type USegIPAttribute struct {
+	Name string `json:"name"`
+	// IP is an IP address or a subnet, e.g. 10.0.0.0/24.
+	// +kubebuilder:validation:Optional
+	IP string `json:"ip"`
+	// UseEpgSubnet matches the subnets of the EPG instead of IP.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=no
+	UseEpgSubnet string `json:"useEpgSubnet"`
+}
+
+// A USegMACAttribute matches endpoints by MAC address (fvMacAttr).
+type USegMACAttribute struct {
+	Name string `json:"name"`
+	MAC  string `json:"mac"`
+}
+
+// A USegVMAttribute matches endpoints by an attribute of their VM
+// (fvVmAttr).
+type USegVMAttribute struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Enum=vm-name;guest-os;tag;hv;domain;vm;rootContName;vnic
+	Type string `json:"type"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=equals;contains;startsWith;endsWith
+	// +kubebuilder:default=equals
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+	// LabelName is the name of the tag category for the tag type.
+	// +kubebuilder:validation:Optional
+	LabelName string `json:"labelName"`
+}
+
+// A USegSubCriteria is a nested criteria (fvSCrtrn) of VM attributes.
+type USegSubCriteria struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=any;all
+	// +kubebuilder:default=any
+	Match string `json:"match"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	VMAttributes []USegVMAttribute `json:"vmAttributes,omitempty"`
+}
+
+// USegCriteria are the criteria (fvCrtrn) of a microsegment EPG.
+type USegCriteria struct {
+	// Match is whether an endpoint has to match any or all of the
+	// attributes.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=any;all
+	// +kubebuilder:default=any
+	Match string `json:"match"`
+	// Precedence breaks ties between uSeg EPGs an endpoint matches, the
+	// highest wins.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	Precedence int `json:"precedence"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	IPAttributes []USegIPAttribute `json:"ipAttributes,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	MACAttributes []USegMACAttribute `json:"macAttributes,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	VMAttributes []USegVMAttribute `json:"vmAttributes,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	SubCriteria []USegSubCriteria `json:"subCriteria,omitempty"`
+}
+
 // EndpointGroupParameters are the configurable fields of a EndpointGroup.
 type EndpointGroupParameters struct {
+	// Name is the name of the EPG. The name of the EndpointGroup is used if
+	// empty. Renaming the EPG creates a new one and deletes the former one.
+	// +kubebuilder:validation:Optional
+	Name               string `json:"name"`
 	Tenant             string `json:"tenant"`
 	ApplicationProfile string `json:"applicationProfile"`
 	BridgeDomain       string `json:"bridgeDomain"`
 	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	NameAlias string `json:"nameAlias"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=include;exclude
+	// +kubebuilder:default=exclude
 	PreferedGroup string `json:"preferedGroup"`
+	// PcEnfPref enables the isolation of the endpoints of the EPG from
+	// each other.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enforced;unenforced
+	// +kubebuilder:default=unenforced
+	PcEnfPref string `json:"pcEnfPref"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +kubebuilder:default=disabled
+	FloodOnEncap string `json:"floodOnEncap"`
+	// Prio is the QoS class of the EPG.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=unspecified;level1;level2;level3;level4;level5;level6
+	// +kubebuilder:default=unspecified
+	Prio string `json:"prio"`
+	// IsAttrBasedEPg makes the EPG a microsegment (uSeg) EPG.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=no
+	IsAttrBasedEPg string `json:"isAttrBasedEPg"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=no
+	Shutdown string `json:"shutdown"`
+	// FwdCtrl is either proxy-arp or empty.
+	// +kubebuilder:validation:Optional
+	FwdCtrl string `json:"fwdCtrl"`
+	// IntraEpgContracts are the names of the contracts (fvRsIntraEpg)
+	// applied to the traffic between the endpoints of the EPG.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	IntraEpgContracts []string `json:"intraEpgContracts,omitempty"`
+	// MonitoringPolicy is the name of the monitoring policy
+	// (fvRsAEPgMonPol). The default policy is used if empty.
+	// +kubebuilder:validation:Optional
+	MonitoringPolicy string `json:"monitoringPolicy"`
+	// USegCriteria are the criteria (fvCrtrn) endpoints are classified into
+	// the EPG by. They require IsAttrBasedEPg and are reconciled as a whole,
+	// the criteria of the EPG are deleted if none are set.
+	// +kubebuilder:validation:Optional
+	USegCriteria *USegCriteria `json:"usegCriteria,omitempty"`
+	// SecurityDomains are the names of the security domains (aaaDomainRef)
+	// the object belongs to. They are reconciled as a whole.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	SecurityDomains []string `json:"securityDomains,omitempty"`
 }
 
 // EndpointGroupObservation are the observable fields of a EndpointGroup.
 type EndpointGroupObservation struct {
 	ObservableField string `json:"observableField,omitempty"`
+	Dn              string `json:"dn,omitempty"`
 }
 
 // A EndpointGroupSpec defines the desired state of a EndpointGroup.
@@ -58,6 +194,7 @@ type EndpointGroupStatus struct {
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
 type EndpointGroup struct {
@@ -102,6 +102,11 @@ func (in *ApplicationProfileObservation) DeepCopy() *ApplicationProfileObservati
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationProfileParameters) DeepCopyInto(out *ApplicationProfileParameters) {
 	*out = *in
+	if in.SecurityDomains != nil {
+		in, out := &in.SecurityDomains, &out.SecurityDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationProfileParameters.
@@ -118,7 +123,7 @@ func (in *ApplicationProfileParameters) DeepCopy() *ApplicationProfileParameters
 func (in *ApplicationProfileSpec) DeepCopyInto(out *ApplicationProfileSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	out.ForProvider = in.ForProvider
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationProfileSpec.
@@ -225,6 +230,21 @@ func (in *EndpointGroupObservation) DeepCopy() *EndpointGroupObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EndpointGroupParameters) DeepCopyInto(out *EndpointGroupParameters) {
 	*out = *in
+	if in.IntraEpgContracts != nil {
+		in, out := &in.IntraEpgContracts, &out.IntraEpgContracts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.USegCriteria != nil {
+		in, out := &in.USegCriteria, &out.USegCriteria
+		*out = new(USegCriteria)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityDomains != nil {
+		in, out := &in.SecurityDomains, &out.SecurityDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointGroupParameters.
@@ -241,7 +261,7 @@ func (in *EndpointGroupParameters) DeepCopy() *EndpointGroupParameters {
 func (in *EndpointGroupSpec) DeepCopyInto(out *EndpointGroupSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	out.ForProvider = in.ForProvider
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointGroupSpec.
@@ -270,3 +290,105 @@ func (in *EndpointGroupStatus) DeepCopy() *EndpointGroupStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *USegCriteria) DeepCopyInto(out *USegCriteria) {
+	*out = *in
+	if in.IPAttributes != nil {
+		in, out := &in.IPAttributes, &out.IPAttributes
+		*out = make([]USegIPAttribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.MACAttributes != nil {
+		in, out := &in.MACAttributes, &out.MACAttributes
+		*out = make([]USegMACAttribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.VMAttributes != nil {
+		in, out := &in.VMAttributes, &out.VMAttributes
+		*out = make([]USegVMAttribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubCriteria != nil {
+		in, out := &in.SubCriteria, &out.SubCriteria
+		*out = make([]USegSubCriteria, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new USegCriteria.
+func (in *USegCriteria) DeepCopy() *USegCriteria {
+	if in == nil {
+		return nil
+	}
+	out := new(USegCriteria)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *USegIPAttribute) DeepCopyInto(out *USegIPAttribute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new USegIPAttribute.
+func (in *USegIPAttribute) DeepCopy() *USegIPAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(USegIPAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *USegMACAttribute) DeepCopyInto(out *USegMACAttribute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new USegMACAttribute.
+func (in *USegMACAttribute) DeepCopy() *USegMACAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(USegMACAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *USegSubCriteria) DeepCopyInto(out *USegSubCriteria) {
+	*out = *in
+	if in.VMAttributes != nil {
+		in, out := &in.VMAttributes, &out.VMAttributes
+		*out = make([]USegVMAttribute, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new USegSubCriteria.
+func (in *USegSubCriteria) DeepCopy() *USegSubCriteria {
+	if in == nil {
+		return nil
+	}
+	out := new(USegSubCriteria)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *USegVMAttribute) DeepCopyInto(out *USegVMAttribute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new USegVMAttribute.
+func (in *USegVMAttribute) DeepCopy() *USegVMAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(USegVMAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A DNSProvider is a DNS server (dnsProv) of a DNSProfile.
+type DNSProvider struct {
+	Address string `json:"address"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=no
+	Preferred string `json:"preferred"`
+}
+
+// A DNSDomain is a DNS domain (dnsDomain) of a DNSProfile.
+type DNSDomain struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=no
+	IsDefault string `json:"isDefault"`
+}
+
+// DNSProfileParameters are the configurable fields of a DNSProfile.
+type DNSProfileParameters struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	NameAlias string `json:"nameAlias"`
+	// ManagementEPG is the management EPG the providers are reached through,
+	// either oob-default or inb-<name>.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=oob-default
+	ManagementEPG string `json:"managementEpg"`
+	// Providers are reconciled as a whole: providers that are not listed
+	// here are removed from the profile.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=address
+	Providers []DNSProvider `json:"providers,omitempty"`
+	// Domains are reconciled as a whole: domains that are not listed here
+	// are removed from the profile.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	Domains []DNSDomain `json:"domains,omitempty"`
+}
+
+// DNSProfileObservation are the observable fields of a DNSProfile.
+type DNSProfileObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A DNSProfileSpec defines the desired state of a DNSProfile.
+type DNSProfileSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DNSProfileParameters `json:"forProvider"`
+}
+
+// A DNSProfileStatus represents the observed state of a DNSProfile.
+type DNSProfileStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DNSProfileObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DNSProfile is a fabric DNS profile (dnsProfile) with its providers and
+// domains.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type DNSProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DNSProfileSpec   `json:"spec"`
+	Status DNSProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DNSProfileList contains a list of DNSProfile
+type DNSProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DNSProfile `json:"items"`
+}
+
+// DNSProfile type metadata.
+var (
+	DNSProfileKind             = reflect.TypeOf(DNSProfile{}).Name()
+	DNSProfileGroupKind        = schema.GroupKind{Group: Group, Kind: DNSProfileKind}.String()
+	DNSProfileKindAPIVersion   = DNSProfileKind + "." + SchemeGroupVersion.String()
+	DNSProfileGroupVersionKind = SchemeGroupVersion.WithKind(DNSProfileKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&DNSProfile{}, &DNSProfileList{})
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// NodeManagementAddressParameters are the configurable fields of a
+// NodeManagementAddress.
+type NodeManagementAddressParameters struct {
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16000
+	NodeID int `json:"nodeId"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=255
+	// +kubebuilder:default=1
+	Pod int `json:"pod"`
+	// Type selects the out-of-band (oob) or in-band (inb) management EPG.
+	// +kubebuilder:validation:Enum=oob;inb
+	Type string `json:"type"`
+	// ManagementEPG is the name of the management EPG. The out-of-band EPG
+	// is named default.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=default
+	ManagementEPG string `json:"managementEpg"`
+	// Address is the IPv4 address of the node in CIDR notation.
+	// +kubebuilder:validation:Optional
+	Address string `json:"address"`
+	// Gateway is the IPv4 gateway, which must be in the subnet of Address.
+	// +kubebuilder:validation:Optional
+	Gateway string `json:"gateway"`
+	// IPv6Address is the IPv6 address of the node in CIDR notation.
+	// +kubebuilder:validation:Optional
+	IPv6Address string `json:"ipv6Address"`
+	// IPv6Gateway is the IPv6 gateway, which must be in the subnet of
+	// IPv6Address.
+	// +kubebuilder:validation:Optional
+	IPv6Gateway string `json:"ipv6Gateway"`
+}
+
+// NodeManagementAddressObservation are the observable fields of a
+// NodeManagementAddress.
+type NodeManagementAddressObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A NodeManagementAddressSpec defines the desired state of a NodeManagementAddress.
+type NodeManagementAddressSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NodeManagementAddressParameters `json:"forProvider"`
+}
+
+// A NodeManagementAddressStatus represents the observed state of a NodeManagementAddress.
+type NodeManagementAddressStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NodeManagementAddressObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NodeManagementAddress is the static management address of a fabric node
+// (mgmtRsOoBStNode or mgmtRsInBStNode).
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type NodeManagementAddress struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NodeManagementAddressSpec   `json:"spec"`
+	Status NodeManagementAddressStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NodeManagementAddressList contains a list of NodeManagementAddress
+type NodeManagementAddressList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodeManagementAddress `json:"items"`
+}
+
+// NodeManagementAddress type metadata.
+var (
+	NodeManagementAddressKind             = reflect.TypeOf(NodeManagementAddress{}).Name()
+	NodeManagementAddressGroupKind        = schema.GroupKind{Group: Group, Kind: NodeManagementAddressKind}.String()
+	NodeManagementAddressKindAPIVersion   = NodeManagementAddressKind + "." + SchemeGroupVersion.String()
+	NodeManagementAddressGroupVersionKind = SchemeGroupVersion.WithKind(NodeManagementAddressKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NodeManagementAddress{}, &NodeManagementAddressList{})
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// An NTPProvider is an NTP server (datetimeNtpProv) of an NTPPolicy.
+type NTPProvider struct {
+	// Name is the hostname or IP address of the NTP server.
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=no
+	Preferred string `json:"preferred"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="4"
+	MinPoll string `json:"minPoll"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="6"
+	MaxPoll string `json:"maxPoll"`
+	// ManagementEPG is the management EPG the server is reached through,
+	// either oob-default or inb-<name>.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=oob-default
+	ManagementEPG string `json:"managementEpg"`
+}
+
+// NTPPolicyParameters are the configurable fields of a NTPPolicy.
+type NTPPolicyParameters struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	NameAlias string `json:"nameAlias"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +kubebuilder:default=enabled
+	AdminState string `json:"adminState"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +kubebuilder:default=disabled
+	AuthState string `json:"authState"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +kubebuilder:default=disabled
+	ServerState string `json:"serverState"`
+	// Providers are reconciled as a whole: providers that are not listed
+	// here are removed from the policy.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	Providers []NTPProvider `json:"providers,omitempty"`
+}
+
+// NTPPolicyObservation are the observable fields of a NTPPolicy.
+type NTPPolicyObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A NTPPolicySpec defines the desired state of a NTPPolicy.
+type NTPPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NTPPolicyParameters `json:"forProvider"`
+}
+
+// A NTPPolicyStatus represents the observed state of a NTPPolicy.
+type NTPPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NTPPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An NTPPolicy is a fabric date and time policy (datetimePol) with its NTP
+// providers.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type NTPPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NTPPolicySpec   `json:"spec"`
+	Status NTPPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NTPPolicyList contains a list of NTPPolicy
+type NTPPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NTPPolicy `json:"items"`
+}
+
+// NTPPolicy type metadata.
+var (
+	NTPPolicyKind             = reflect.TypeOf(NTPPolicy{}).Name()
+	NTPPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: NTPPolicyKind}.String()
+	NTPPolicyKindAPIVersion   = NTPPolicyKind + "." + SchemeGroupVersion.String()
+	NTPPolicyGroupVersionKind = SchemeGroupVersion.WithKind(NTPPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NTPPolicy{}, &NTPPolicyList{})
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// An SNMPClient is a client entry (snmpClientP) of an SNMPClientGroup.
+type SNMPClient struct {
+	Address string `json:"address"`
+	// +kubebuilder:validation:Optional
+	Name string `json:"name"`
+}
+
+// An SNMPClientGroup is a client group profile (snmpClientGrpP) of an
+// SNMPPolicy.
+type SNMPClientGroup struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// ManagementEPG is the management EPG the clients are reached through,
+	// either oob-default or inb-<name>.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=oob-default
+	ManagementEPG string `json:"managementEpg"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=address
+	Clients []SNMPClient `json:"clients,omitempty"`
+}
+
+// SNMPPolicyParameters are the configurable fields of a SNMPPolicy.
+type SNMPPolicyParameters struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	NameAlias string `json:"nameAlias"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +kubebuilder:default=disabled
+	AdminState string `json:"adminState"`
+	// +kubebuilder:validation:Optional
+	Contact string `json:"contact"`
+	// +kubebuilder:validation:Optional
+	Location string `json:"location"`
+	// Communities are the names of the SNMP community policies
+	// (snmpCommunityP). They are reconciled as a whole.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Communities []string `json:"communities,omitempty"`
+	// ClientGroups are reconciled as a whole, including the clients of
+	// each group.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	ClientGroups []SNMPClientGroup `json:"clientGroups,omitempty"`
+}
+
+// SNMPPolicyObservation are the observable fields of a SNMPPolicy.
+type SNMPPolicyObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A SNMPPolicySpec defines the desired state of a SNMPPolicy.
+type SNMPPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SNMPPolicyParameters `json:"forProvider"`
+}
+
+// A SNMPPolicyStatus represents the observed state of a SNMPPolicy.
+type SNMPPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SNMPPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An SNMPPolicy is a fabric SNMP policy (snmpPol) with its communities and
+// client groups.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type SNMPPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SNMPPolicySpec   `json:"spec"`
+	Status SNMPPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SNMPPolicyList contains a list of SNMPPolicy
+type SNMPPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SNMPPolicy `json:"items"`
+}
+
+// SNMPPolicy type metadata.
+var (
+	SNMPPolicyKind             = reflect.TypeOf(SNMPPolicy{}).Name()
+	SNMPPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: SNMPPolicyKind}.String()
+	SNMPPolicyKindAPIVersion   = SNMPPolicyKind + "." + SchemeGroupVersion.String()
+	SNMPPolicyGroupVersionKind = SchemeGroupVersion.WithKind(SNMPPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SNMPPolicy{}, &SNMPPolicyList{})
+}
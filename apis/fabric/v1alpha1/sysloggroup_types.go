@@ -0,0 +1,135 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A SyslogRemoteDestination is a remote syslog server (syslogRemoteDest) of
+// a SyslogGroup.
+type SyslogRemoteDestination struct {
+	// Host is the hostname or IP address of the syslog server.
+	Host string `json:"host"`
+	// +kubebuilder:validation:Optional
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="514"
+	Port string `json:"port"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=emergencies;alerts;critical;errors;warnings;notifications;information;debugging
+	// +kubebuilder:default=warnings
+	Severity string `json:"severity"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=local0;local1;local2;local3;local4;local5;local6;local7
+	// +kubebuilder:default=local7
+	ForwardingFacility string `json:"forwardingFacility"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +kubebuilder:default=enabled
+	AdminState string `json:"adminState"`
+	// ManagementEPG is the management EPG the server is reached through,
+	// either oob-default or inb-<name>.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=oob-default
+	ManagementEPG string `json:"managementEpg"`
+}
+
+// SyslogGroupParameters are the configurable fields of a SyslogGroup.
+type SyslogGroupParameters struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	NameAlias string `json:"nameAlias"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=aci;nxos
+	// +kubebuilder:default=aci
+	Format string `json:"format"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=no
+	IncludeMilliseconds string `json:"includeMilliseconds"`
+	// RemoteDestinations are reconciled as a whole: destinations that are
+	// not listed here are removed from the group.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=host
+	RemoteDestinations []SyslogRemoteDestination `json:"remoteDestinations,omitempty"`
+}
+
+// SyslogGroupObservation are the observable fields of a SyslogGroup.
+type SyslogGroupObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A SyslogGroupSpec defines the desired state of a SyslogGroup.
+type SyslogGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SyslogGroupParameters `json:"forProvider"`
+}
+
+// A SyslogGroupStatus represents the observed state of a SyslogGroup.
+type SyslogGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SyslogGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SyslogGroup is a fabric syslog monitoring destination group (syslogGroup)
+// with its remote destinations.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type SyslogGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SyslogGroupSpec   `json:"spec"`
+	Status SyslogGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SyslogGroupList contains a list of SyslogGroup
+type SyslogGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SyslogGroup `json:"items"`
+}
+
+// SyslogGroup type metadata.
+var (
+	SyslogGroupKind             = reflect.TypeOf(SyslogGroup{}).Name()
+	SyslogGroupGroupKind        = schema.GroupKind{Group: Group, Kind: SyslogGroupKind}.String()
+	SyslogGroupKindAPIVersion   = SyslogGroupKind + "." + SchemeGroupVersion.String()
+	SyslogGroupGroupVersionKind = SchemeGroupVersion.WithKind(SyslogGroupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SyslogGroup{}, &SyslogGroupList{})
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// NetflowExporterPolicyParameters are the configurable fields of a
+// NetflowExporterPolicy.
+type NetflowExporterPolicyParameters struct {
+	Name   string `json:"name"`
+	Tenant string `json:"tenant"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// DestinationAddress is the IP address of the collector.
+	DestinationAddress string `json:"destinationAddress"`
+	DestinationPort    string `json:"destinationPort"`
+	// SourceAddress is the source IP address of the exported records.
+	// +kubebuilder:validation:Optional
+	SourceAddress string `json:"sourceAddress"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=netflow-v9;netflow-v5;cisco-v1
+	// +kubebuilder:default=cisco-v1
+	Version string `json:"version"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="44"
+	DSCP string `json:"dscp"`
+	// EndpointGroupDn is the DN of the EPG or external EPG
+	// (netflowRsExporterToEPg) the collector is reachable through.
+	// +kubebuilder:validation:Optional
+	EndpointGroupDn string `json:"endpointGroupDn"`
+	// Vrf is the name of the VRF of the tenant (netflowRsExporterToCtx) the
+	// collector is reachable through.
+	// +kubebuilder:validation:Optional
+	Vrf string `json:"vrf"`
+}
+
+// NetflowExporterPolicyObservation are the observable fields of a
+// NetflowExporterPolicy.
+type NetflowExporterPolicyObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A NetflowExporterPolicySpec defines the desired state of a NetflowExporterPolicy.
+type NetflowExporterPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NetflowExporterPolicyParameters `json:"forProvider"`
+}
+
+// A NetflowExporterPolicyStatus represents the observed state of a NetflowExporterPolicy.
+type NetflowExporterPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NetflowExporterPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetflowExporterPolicy is a tenant NetFlow exporter policy
+// (netflowExporterPol).
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type NetflowExporterPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetflowExporterPolicySpec   `json:"spec"`
+	Status NetflowExporterPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetflowExporterPolicyList contains a list of NetflowExporterPolicy
+type NetflowExporterPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetflowExporterPolicy `json:"items"`
+}
+
+// NetflowExporterPolicy type metadata.
+var (
+	NetflowExporterPolicyKind             = reflect.TypeOf(NetflowExporterPolicy{}).Name()
+	NetflowExporterPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: NetflowExporterPolicyKind}.String()
+	NetflowExporterPolicyKindAPIVersion   = NetflowExporterPolicyKind + "." + SchemeGroupVersion.String()
+	NetflowExporterPolicyGroupVersionKind = SchemeGroupVersion.WithKind(NetflowExporterPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NetflowExporterPolicy{}, &NetflowExporterPolicyList{})
+}
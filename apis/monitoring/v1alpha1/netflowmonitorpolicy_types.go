@@ -0,0 +1,102 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// NetflowMonitorPolicyParameters are the configurable fields of a
+// NetflowMonitorPolicy.
+type NetflowMonitorPolicyParameters struct {
+	Name   string `json:"name"`
+	Tenant string `json:"tenant"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// RecordPolicy is the name of the NetflowRecordPolicy of the tenant
+	// (netflowRsMonitorToRecord).
+	RecordPolicy string `json:"recordPolicy"`
+	// Exporters are the names of the NetflowExporterPolicies of the tenant
+	// (netflowRsMonitorToExporter).
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=2
+	// +listType=set
+	Exporters []string `json:"exporters,omitempty"`
+}
+
+// NetflowMonitorPolicyObservation are the observable fields of a
+// NetflowMonitorPolicy.
+type NetflowMonitorPolicyObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A NetflowMonitorPolicySpec defines the desired state of a NetflowMonitorPolicy.
+type NetflowMonitorPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NetflowMonitorPolicyParameters `json:"forProvider"`
+}
+
+// A NetflowMonitorPolicyStatus represents the observed state of a NetflowMonitorPolicy.
+type NetflowMonitorPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NetflowMonitorPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetflowMonitorPolicy is a tenant NetFlow monitor policy
+// (netflowMonitorPol).
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type NetflowMonitorPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetflowMonitorPolicySpec   `json:"spec"`
+	Status NetflowMonitorPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetflowMonitorPolicyList contains a list of NetflowMonitorPolicy
+type NetflowMonitorPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetflowMonitorPolicy `json:"items"`
+}
+
+// NetflowMonitorPolicy type metadata.
+var (
+	NetflowMonitorPolicyKind             = reflect.TypeOf(NetflowMonitorPolicy{}).Name()
+	NetflowMonitorPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: NetflowMonitorPolicyKind}.String()
+	NetflowMonitorPolicyKindAPIVersion   = NetflowMonitorPolicyKind + "." + SchemeGroupVersion.String()
+	NetflowMonitorPolicyGroupVersionKind = SchemeGroupVersion.WithKind(NetflowMonitorPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NetflowMonitorPolicy{}, &NetflowMonitorPolicyList{})
+}
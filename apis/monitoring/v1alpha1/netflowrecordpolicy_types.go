@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// NetflowRecordPolicyParameters are the configurable fields of a
+// NetflowRecordPolicy.
+type NetflowRecordPolicyParameters struct {
+	Name   string `json:"name"`
+	Tenant string `json:"tenant"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// Collect are the non-key fields of the records, e.g. count-bytes.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Collect []string `json:"collect,omitempty"`
+	// Match are the key fields of the records, e.g. src-ipv4.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Match []string `json:"match,omitempty"`
+}
+
+// NetflowRecordPolicyObservation are the observable fields of a
+// NetflowRecordPolicy.
+type NetflowRecordPolicyObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A NetflowRecordPolicySpec defines the desired state of a NetflowRecordPolicy.
+type NetflowRecordPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NetflowRecordPolicyParameters `json:"forProvider"`
+}
+
+// A NetflowRecordPolicyStatus represents the observed state of a NetflowRecordPolicy.
+type NetflowRecordPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NetflowRecordPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetflowRecordPolicy is a tenant NetFlow record policy (netflowRecordPol).
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type NetflowRecordPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetflowRecordPolicySpec   `json:"spec"`
+	Status NetflowRecordPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetflowRecordPolicyList contains a list of NetflowRecordPolicy
+type NetflowRecordPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetflowRecordPolicy `json:"items"`
+}
+
+// NetflowRecordPolicy type metadata.
+var (
+	NetflowRecordPolicyKind             = reflect.TypeOf(NetflowRecordPolicy{}).Name()
+	NetflowRecordPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: NetflowRecordPolicyKind}.String()
+	NetflowRecordPolicyKindAPIVersion   = NetflowRecordPolicyKind + "." + SchemeGroupVersion.String()
+	NetflowRecordPolicyGroupVersionKind = SchemeGroupVersion.WithKind(NetflowRecordPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NetflowRecordPolicy{}, &NetflowRecordPolicyList{})
+}
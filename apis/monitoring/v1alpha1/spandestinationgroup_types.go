@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A SpanERSPANDestination is the ERSPAN destination (spanRsDestEpg) of a
+// SpanDestinationGroup.
+type SpanERSPANDestination struct {
+	// EndpointGroupDn is the DN of the EPG the destination IP is in, e.g.
+	// uni/tn-common/ap-monitoring/epg-analyzers.
+	EndpointGroupDn string `json:"endpointGroupDn"`
+	// DestinationIP is the IP address of the ERSPAN destination.
+	DestinationIP string `json:"destinationIp"`
+	// SourceIPPrefix is the source IP prefix of the ERSPAN packets.
+	SourceIPPrefix string `json:"sourceIpPrefix"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=ver1;ver2
+	// +kubebuilder:default=ver2
+	Version string `json:"version"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=unspecified
+	DSCP string `json:"dscp"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="64"
+	TTL string `json:"ttl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="1518"
+	Mtu string `json:"mtu"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="1"
+	FlowID string `json:"flowId"`
+}
+
+// SpanDestinationGroupParameters are the configurable fields of a
+// SpanDestinationGroup.
+type SpanDestinationGroupParameters struct {
+	Name   string `json:"name"`
+	Tenant string `json:"tenant"`
+	// +kubebuilder:validation:Optional
+	Description string                `json:"description"`
+	Destination SpanERSPANDestination `json:"destination"`
+	// ExpireAfter is how long after its creation the SpanDestinationGroup is
+	// deleted. It never expires if empty.
+	// +optional
+	ExpireAfter *metav1.Duration `json:"expireAfter,omitempty"`
+}
+
+// SpanDestinationGroupObservation are the observable fields of a
+// SpanDestinationGroup.
+type SpanDestinationGroupObservation struct {
+	Dn string `json:"dn,omitempty"`
+	// ExpiresAt is when the SpanDestinationGroup is deleted.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// A SpanDestinationGroupSpec defines the desired state of a SpanDestinationGroup.
+type SpanDestinationGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SpanDestinationGroupParameters `json:"forProvider"`
+}
+
+// A SpanDestinationGroupStatus represents the observed state of a SpanDestinationGroup.
+type SpanDestinationGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SpanDestinationGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SpanDestinationGroup is a tenant SPAN destination group (spanDestGrp)
+// sending the mirrored traffic to an ERSPAN destination.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type SpanDestinationGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SpanDestinationGroupSpec   `json:"spec"`
+	Status SpanDestinationGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SpanDestinationGroupList contains a list of SpanDestinationGroup
+type SpanDestinationGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SpanDestinationGroup `json:"items"`
+}
+
+// SpanDestinationGroup type metadata.
+var (
+	SpanDestinationGroupKind             = reflect.TypeOf(SpanDestinationGroup{}).Name()
+	SpanDestinationGroupGroupKind        = schema.GroupKind{Group: Group, Kind: SpanDestinationGroupKind}.String()
+	SpanDestinationGroupKindAPIVersion   = SpanDestinationGroupKind + "." + SchemeGroupVersion.String()
+	SpanDestinationGroupGroupVersionKind = SchemeGroupVersion.WithKind(SpanDestinationGroupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SpanDestinationGroup{}, &SpanDestinationGroupList{})
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A SpanSource is an EPG (spanSrc) whose traffic is mirrored by a
+// SpanSourceGroup.
+type SpanSource struct {
+	Name               string `json:"name"`
+	ApplicationProfile string `json:"applicationProfile"`
+	EndpointGroup      string `json:"endpointGroup"`
+	// Direction is the direction of the mirrored traffic.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=in;out;both
+	// +kubebuilder:default=both
+	Direction string `json:"direction"`
+}
+
+// SpanSourceGroupParameters are the configurable fields of a SpanSourceGroup.
+type SpanSourceGroupParameters struct {
+	Name   string `json:"name"`
+	Tenant string `json:"tenant"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +kubebuilder:default=enabled
+	AdminState string `json:"adminState"`
+	// DestinationGroup is the name of the SPAN destination group
+	// (spanSpanLbl) of the tenant the traffic is mirrored to.
+	DestinationGroup string `json:"destinationGroup"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	Sources []SpanSource `json:"sources,omitempty"`
+	// ExpireAfter is how long after its creation the SpanSourceGroup is
+	// deleted, which stops the session. It never expires if empty.
+	// +optional
+	ExpireAfter *metav1.Duration `json:"expireAfter,omitempty"`
+}
+
+// SpanSourceGroupObservation are the observable fields of a SpanSourceGroup.
+type SpanSourceGroupObservation struct {
+	Dn string `json:"dn,omitempty"`
+	// ExpiresAt is when the SpanSourceGroup is deleted.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// A SpanSourceGroupSpec defines the desired state of a SpanSourceGroup.
+type SpanSourceGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SpanSourceGroupParameters `json:"forProvider"`
+}
+
+// A SpanSourceGroupStatus represents the observed state of a SpanSourceGroup.
+type SpanSourceGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SpanSourceGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SpanSourceGroup is a tenant SPAN session (spanSrcGrp) mirroring the
+// traffic of EPGs to a SpanDestinationGroup.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type SpanSourceGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SpanSourceGroupSpec   `json:"spec"`
+	Status SpanSourceGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SpanSourceGroupList contains a list of SpanSourceGroup
+type SpanSourceGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SpanSourceGroup `json:"items"`
+}
+
+// SpanSourceGroup type metadata.
+var (
+	SpanSourceGroupKind             = reflect.TypeOf(SpanSourceGroup{}).Name()
+	SpanSourceGroupGroupKind        = schema.GroupKind{Group: Group, Kind: SpanSourceGroupKind}.String()
+	SpanSourceGroupKindAPIVersion   = SpanSourceGroupKind + "." + SchemeGroupVersion.String()
+	SpanSourceGroupGroupVersionKind = SchemeGroupVersion.WithKind(SpanSourceGroupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SpanSourceGroup{}, &SpanSourceGroupList{})
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// BFDInterfacePolicyParameters are the configurable fields of a BFDInterfacePolicy.
+type BFDInterfacePolicyParameters struct {
+	TenantPolicyParameters `json:",inline"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +kubebuilder:default=enabled
+	AdminState string `json:"adminState" aci:"adminSt"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="3"
+	DetectMultiplier string `json:"detectMultiplier" aci:"detectMult"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="50"
+	MinTxInterval string `json:"minTxInterval" aci:"minTxIntvl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="50"
+	MinRxInterval string `json:"minRxInterval" aci:"minRxIntvl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +kubebuilder:default=enabled
+	EchoAdminState string `json:"echoAdminState" aci:"echoAdminSt"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="50"
+	EchoRxInterval string `json:"echoRxInterval" aci:"echoRxIntvl"`
+	// Control is opt-subif to enable BFD on sub-interfaces optimization, or
+	// empty.
+	// +kubebuilder:validation:Optional
+	Control string `json:"control" aci:"ctrl"`
+}
+
+// BFDInterfacePolicyObservation are the observable fields of a BFDInterfacePolicy.
+type BFDInterfacePolicyObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A BFDInterfacePolicySpec defines the desired state of a BFDInterfacePolicy.
+type BFDInterfacePolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BFDInterfacePolicyParameters `json:"forProvider"`
+}
+
+// A BFDInterfacePolicyStatus represents the observed state of a BFDInterfacePolicy.
+type BFDInterfacePolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BFDInterfacePolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BFDInterfacePolicy is a BFD interface policy (bfdIfPol) of a tenant.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type BFDInterfacePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BFDInterfacePolicySpec   `json:"spec"`
+	Status BFDInterfacePolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BFDInterfacePolicyList contains a list of BFDInterfacePolicy
+type BFDInterfacePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BFDInterfacePolicy `json:"items"`
+}
+
+// BFDInterfacePolicy type metadata.
+var (
+	BFDInterfacePolicyKind             = reflect.TypeOf(BFDInterfacePolicy{}).Name()
+	BFDInterfacePolicyGroupKind        = schema.GroupKind{Group: Group, Kind: BFDInterfacePolicyKind}.String()
+	BFDInterfacePolicyKindAPIVersion   = BFDInterfacePolicyKind + "." + SchemeGroupVersion.String()
+	BFDInterfacePolicyGroupVersionKind = SchemeGroupVersion.WithKind(BFDInterfacePolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BFDInterfacePolicy{}, &BFDInterfacePolicyList{})
+}
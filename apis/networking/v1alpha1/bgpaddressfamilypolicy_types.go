@@ -0,0 +1,106 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// BGPAddressFamilyPolicyParameters are the configurable fields of a BGPAddressFamilyPolicy.
+type BGPAddressFamilyPolicyParameters struct {
+	TenantPolicyParameters `json:",inline"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="20"
+	EBGPDistance string `json:"ebgpDistance" aci:"eDist"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="200"
+	IBGPDistance string `json:"ibgpDistance" aci:"iDist"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="220"
+	LocalDistance string `json:"localDistance" aci:"localDist"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="16"
+	EBGPMaxECMP string `json:"ebgpMaxEcmp" aci:"maxEcmp"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="16"
+	IBGPMaxECMP string `json:"ibgpMaxEcmp" aci:"maxEcmpIbgp"`
+	// Control is host-rt-leak to advertise host routes, or empty.
+	// +kubebuilder:validation:Optional
+	Control string `json:"control" aci:"ctrl"`
+}
+
+// BGPAddressFamilyPolicyObservation are the observable fields of a BGPAddressFamilyPolicy.
+type BGPAddressFamilyPolicyObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A BGPAddressFamilyPolicySpec defines the desired state of a BGPAddressFamilyPolicy.
+type BGPAddressFamilyPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BGPAddressFamilyPolicyParameters `json:"forProvider"`
+}
+
+// A BGPAddressFamilyPolicyStatus represents the observed state of a BGPAddressFamilyPolicy.
+type BGPAddressFamilyPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BGPAddressFamilyPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BGPAddressFamilyPolicy is a BGP address family context policy
+// (bgpCtxAfPol) of a tenant.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type BGPAddressFamilyPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BGPAddressFamilyPolicySpec   `json:"spec"`
+	Status BGPAddressFamilyPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BGPAddressFamilyPolicyList contains a list of BGPAddressFamilyPolicy
+type BGPAddressFamilyPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BGPAddressFamilyPolicy `json:"items"`
+}
+
+// BGPAddressFamilyPolicy type metadata.
+var (
+	BGPAddressFamilyPolicyKind             = reflect.TypeOf(BGPAddressFamilyPolicy{}).Name()
+	BGPAddressFamilyPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: BGPAddressFamilyPolicyKind}.String()
+	BGPAddressFamilyPolicyKindAPIVersion   = BGPAddressFamilyPolicyKind + "." + SchemeGroupVersion.String()
+	BGPAddressFamilyPolicyGroupVersionKind = SchemeGroupVersion.WithKind(BGPAddressFamilyPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BGPAddressFamilyPolicy{}, &BGPAddressFamilyPolicyList{})
+}
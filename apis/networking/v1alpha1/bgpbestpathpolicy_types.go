@@ -0,0 +1,92 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// BGPBestPathPolicyParameters are the configurable fields of a BGPBestPathPolicy.
+type BGPBestPathPolicyParameters struct {
+	TenantPolicyParameters `json:",inline"`
+	// Control is asPathMultipathRelax to relax the AS path restriction of
+	// multipath, or empty.
+	// +kubebuilder:validation:Optional
+	Control string `json:"control" aci:"ctrl"`
+}
+
+// BGPBestPathPolicyObservation are the observable fields of a BGPBestPathPolicy.
+type BGPBestPathPolicyObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A BGPBestPathPolicySpec defines the desired state of a BGPBestPathPolicy.
+type BGPBestPathPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BGPBestPathPolicyParameters `json:"forProvider"`
+}
+
+// A BGPBestPathPolicyStatus represents the observed state of a BGPBestPathPolicy.
+type BGPBestPathPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BGPBestPathPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BGPBestPathPolicy is a BGP best path control policy
+// (bgpBestPathCtrlPol) of a tenant.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type BGPBestPathPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BGPBestPathPolicySpec   `json:"spec"`
+	Status BGPBestPathPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BGPBestPathPolicyList contains a list of BGPBestPathPolicy
+type BGPBestPathPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BGPBestPathPolicy `json:"items"`
+}
+
+// BGPBestPathPolicy type metadata.
+var (
+	BGPBestPathPolicyKind             = reflect.TypeOf(BGPBestPathPolicy{}).Name()
+	BGPBestPathPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: BGPBestPathPolicyKind}.String()
+	BGPBestPathPolicyKindAPIVersion   = BGPBestPathPolicyKind + "." + SchemeGroupVersion.String()
+	BGPBestPathPolicyGroupVersionKind = SchemeGroupVersion.WithKind(BGPBestPathPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BGPBestPathPolicy{}, &BGPBestPathPolicyList{})
+}
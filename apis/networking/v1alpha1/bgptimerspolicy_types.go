@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// BGPTimersPolicyParameters are the configurable fields of a BGPTimersPolicy.
+type BGPTimersPolicyParameters struct {
+	TenantPolicyParameters `json:",inline"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="180"
+	HoldInterval string `json:"holdInterval" aci:"holdIntvl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="60"
+	KeepaliveInterval string `json:"keepaliveInterval" aci:"kaIntvl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="300"
+	StaleInterval string `json:"staleInterval" aci:"staleIntvl"`
+	// GracefulRestartControl is helper or empty to disable graceful restart
+	// helper mode.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=helper;""
+	// +kubebuilder:default=helper
+	GracefulRestartControl string `json:"gracefulRestartControl" aci:"grCtrl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="0"
+	MaxASLimit string `json:"maxAsLimit" aci:"maxAsLimit"`
+}
+
+// BGPTimersPolicyObservation are the observable fields of a BGPTimersPolicy.
+type BGPTimersPolicyObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A BGPTimersPolicySpec defines the desired state of a BGPTimersPolicy.
+type BGPTimersPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BGPTimersPolicyParameters `json:"forProvider"`
+}
+
+// A BGPTimersPolicyStatus represents the observed state of a BGPTimersPolicy.
+type BGPTimersPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BGPTimersPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BGPTimersPolicy is a BGP timers policy (bgpCtxPol) of a tenant.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type BGPTimersPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BGPTimersPolicySpec   `json:"spec"`
+	Status BGPTimersPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BGPTimersPolicyList contains a list of BGPTimersPolicy
+type BGPTimersPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BGPTimersPolicy `json:"items"`
+}
+
+// BGPTimersPolicy type metadata.
+var (
+	BGPTimersPolicyKind             = reflect.TypeOf(BGPTimersPolicy{}).Name()
+	BGPTimersPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: BGPTimersPolicyKind}.String()
+	BGPTimersPolicyKindAPIVersion   = BGPTimersPolicyKind + "." + SchemeGroupVersion.String()
+	BGPTimersPolicyGroupVersionKind = SchemeGroupVersion.WithKind(BGPTimersPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BGPTimersPolicy{}, &BGPTimersPolicyList{})
+}
@@ -25,18 +25,116 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+// A DHCPLabel relays the DHCP requests of the bridge domain with a DHCP
+// relay policy (dhcpLbl).
+type DHCPLabel struct {
+	// Name is the name of the DHCP relay policy.
+	Name string `json:"name"`
+	// Scope is tenant for a relay policy of the tenant, or infra for one of
+	// the infra tenant.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=tenant;infra
+	// +kubebuilder:default=tenant
+	Scope string `json:"scope"`
+	// OptionPolicy is the name of the DHCP option policy
+	// (dhcpRsDhcpOptionPol). The default policy is used if empty.
+	// +kubebuilder:validation:Optional
+	OptionPolicy string `json:"optionPolicy"`
+}
+
 // BridgeDomainParameters are the configurable fields of a BridgeDomain.
 type BridgeDomainParameters struct {
 	Name   string `json:"name"`
 	Tenant string `json:"tenant"`
 	Vrf    string `json:"vrf"`
 	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=no
 	ArpFlood string `json:"arpFlood"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=yes
+	UnicastRoute string `json:"unicastRoute"`
+	// UnkMacUcastAct is the forwarding of unknown unicast traffic.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=flood;proxy
+	// +kubebuilder:default=proxy
+	UnkMacUcastAct string `json:"unkMacUcastAct"`
+	// UnkMcastAct is the forwarding of unknown IPv4 multicast traffic.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=flood;opt-flood
+	// +kubebuilder:default=flood
+	UnkMcastAct string `json:"unkMcastAct"`
+	// MultiDstPktAct is the forwarding of multi-destination traffic.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=bd-flood;encap-flood;drop
+	// +kubebuilder:default=bd-flood
+	MultiDstPktAct string `json:"multiDstPktAct"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=yes
+	LimitIpLearnToSubnets string `json:"limitIpLearnToSubnets"`
+	// EpMoveDetectMode is either garp or empty to disable endpoint move
+	// detection.
+	// +kubebuilder:validation:Optional
+	EpMoveDetectMode string `json:"epMoveDetectMode"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=yes
+	IpLearning string `json:"ipLearning"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=no
+	HostBasedRouting string `json:"hostBasedRouting"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="00:22:BD:F8:19:FF"
+	Mac string `json:"mac"`
+	// Mtu is only pushed and compared when set, APIC reports inherit
+	// otherwise.
+	// +kubebuilder:validation:Optional
+	Mtu string `json:"mtu"`
+	// L3Outs are the names of the L3Outs (fvRsBDToOut) the subnets of the
+	// bridge domain are advertised through.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	L3Outs []string `json:"l3Outs,omitempty"`
+	// EpRetentionPolicy is the name of the endpoint retention policy
+	// (fvRsBdToEpRet). The default policy is used if empty.
+	// +kubebuilder:validation:Optional
+	EpRetentionPolicy string `json:"epRetentionPolicy"`
+	// IgmpSnoopPolicy is the name of the IGMP snooping policy (fvRsIgmpsn).
+	// The default policy is used if empty.
+	// +kubebuilder:validation:Optional
+	IgmpSnoopPolicy string `json:"igmpSnoopPolicy"`
+	// McastAllow enables PIM on the bridge domain. PIM must be enabled on
+	// its VRF with a VrfMulticast.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=no
+	McastAllow string `json:"mcastAllow"`
+	// IgmpInterfacePolicy is the name of the IGMP interface policy
+	// (igmpRsIfPol) of the bridge domain. The default policy is used if
+	// empty.
+	// +kubebuilder:validation:Optional
+	IgmpInterfacePolicy string `json:"igmpInterfacePolicy"`
+	// DHCPLabels are reconciled as a whole.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	DHCPLabels []DHCPLabel `json:"dhcpLabels,omitempty"`
+	// SecurityDomains are the names of the security domains (aaaDomainRef)
+	// the object belongs to. They are reconciled as a whole.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	SecurityDomains []string `json:"securityDomains,omitempty"`
 }
 
 // BridgeDomainObservation are the observable fields of a BridgeDomain.
 type BridgeDomainObservation struct {
 	ObservableField string `json:"observableField,omitempty"`
+	Dn              string `json:"dn,omitempty"`
 }
 
 // A BridgeDomainSpec defines the desired state of a BridgeDomain.
@@ -58,6 +156,7 @@ type BridgeDomainStatus struct {
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
 type BridgeDomain struct {
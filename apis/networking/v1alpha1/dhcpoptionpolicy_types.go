@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A DHCPOption is an option (dhcpOption) of a DHCP option policy.
+type DHCPOption struct {
+	Name string `json:"name"`
+	// ID is the DHCP option code.
+	ID string `json:"id"`
+	// +kubebuilder:validation:Optional
+	Data string `json:"data"`
+}
+
+// DHCPOptionPolicyParameters are the configurable fields of a DHCPOptionPolicy.
+type DHCPOptionPolicyParameters struct {
+	Name   string `json:"name"`
+	Tenant string `json:"tenant"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	Options []DHCPOption `json:"options,omitempty"`
+}
+
+// DHCPOptionPolicyObservation are the observable fields of a DHCPOptionPolicy.
+type DHCPOptionPolicyObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A DHCPOptionPolicySpec defines the desired state of a DHCPOptionPolicy.
+type DHCPOptionPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DHCPOptionPolicyParameters `json:"forProvider"`
+}
+
+// A DHCPOptionPolicyStatus represents the observed state of a DHCPOptionPolicy.
+type DHCPOptionPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DHCPOptionPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DHCPOptionPolicy is a DHCP option policy (dhcpOptionPol) of a tenant.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type DHCPOptionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DHCPOptionPolicySpec   `json:"spec"`
+	Status DHCPOptionPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DHCPOptionPolicyList contains a list of DHCPOptionPolicy
+type DHCPOptionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DHCPOptionPolicy `json:"items"`
+}
+
+// DHCPOptionPolicy type metadata.
+var (
+	DHCPOptionPolicyKind             = reflect.TypeOf(DHCPOptionPolicy{}).Name()
+	DHCPOptionPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: DHCPOptionPolicyKind}.String()
+	DHCPOptionPolicyKindAPIVersion   = DHCPOptionPolicyKind + "." + SchemeGroupVersion.String()
+	DHCPOptionPolicyGroupVersionKind = SchemeGroupVersion.WithKind(DHCPOptionPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&DHCPOptionPolicy{}, &DHCPOptionPolicyList{})
+}
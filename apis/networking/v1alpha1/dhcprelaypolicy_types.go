@@ -0,0 +1,107 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A DHCPProvider is a DHCP server (dhcpRsProv) of a relay policy.
+type DHCPProvider struct {
+	// Dn is the DN of the EPG or external EPG the DHCP server is in, e.g.
+	// uni/tn-common/ap-infra/epg-dhcp.
+	Dn string `json:"dn"`
+	// ServerIP is the IP address of the DHCP server.
+	ServerIP string `json:"serverIp"`
+}
+
+// DHCPRelayPolicyParameters are the configurable fields of a DHCPRelayPolicy.
+type DHCPRelayPolicyParameters struct {
+	Name   string `json:"name"`
+	Tenant string `json:"tenant"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=tenant;infra
+	// +kubebuilder:default=tenant
+	Owner string `json:"owner"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=dn
+	Providers []DHCPProvider `json:"providers,omitempty"`
+}
+
+// DHCPRelayPolicyObservation are the observable fields of a DHCPRelayPolicy.
+type DHCPRelayPolicyObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A DHCPRelayPolicySpec defines the desired state of a DHCPRelayPolicy.
+type DHCPRelayPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DHCPRelayPolicyParameters `json:"forProvider"`
+}
+
+// A DHCPRelayPolicyStatus represents the observed state of a DHCPRelayPolicy.
+type DHCPRelayPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DHCPRelayPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DHCPRelayPolicy is a DHCP relay policy (dhcpRelayP) of a tenant.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type DHCPRelayPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DHCPRelayPolicySpec   `json:"spec"`
+	Status DHCPRelayPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DHCPRelayPolicyList contains a list of DHCPRelayPolicy
+type DHCPRelayPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DHCPRelayPolicy `json:"items"`
+}
+
+// DHCPRelayPolicy type metadata.
+var (
+	DHCPRelayPolicyKind             = reflect.TypeOf(DHCPRelayPolicy{}).Name()
+	DHCPRelayPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: DHCPRelayPolicyKind}.String()
+	DHCPRelayPolicyKindAPIVersion   = DHCPRelayPolicyKind + "." + SchemeGroupVersion.String()
+	DHCPRelayPolicyGroupVersionKind = SchemeGroupVersion.WithKind(DHCPRelayPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&DHCPRelayPolicy{}, &DHCPRelayPolicyList{})
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// EndpointRetentionPolicyParameters are the configurable fields of a EndpointRetentionPolicy.
+type EndpointRetentionPolicyParameters struct {
+	TenantPolicyParameters `json:",inline"`
+	// HoldInterval is the time in seconds a moved endpoint is held.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="300"
+	HoldInterval string `json:"holdInterval" aci:"holdIntvl"`
+	// BounceAgeInterval is the age in seconds of bounce entries.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="630"
+	BounceAgeInterval string `json:"bounceAgeInterval" aci:"bounceAgeIntvl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=protocol;rarp-flood
+	// +kubebuilder:default=protocol
+	BounceTrigger string `json:"bounceTrigger" aci:"bounceTrig"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="900"
+	LocalEndpointAgeInterval string `json:"localEndpointAgeInterval" aci:"localEpAgeIntvl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="300"
+	RemoteEndpointAgeInterval string `json:"remoteEndpointAgeInterval" aci:"remoteEpAgeIntvl"`
+	// MoveFrequency is the number of moves per second that triggers
+	// endpoint move dampening.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="256"
+	MoveFrequency string `json:"moveFrequency" aci:"moveFreq"`
+}
+
+// EndpointRetentionPolicyObservation are the observable fields of a EndpointRetentionPolicy.
+type EndpointRetentionPolicyObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A EndpointRetentionPolicySpec defines the desired state of a EndpointRetentionPolicy.
+type EndpointRetentionPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EndpointRetentionPolicyParameters `json:"forProvider"`
+}
+
+// A EndpointRetentionPolicyStatus represents the observed state of a EndpointRetentionPolicy.
+type EndpointRetentionPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EndpointRetentionPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EndpointRetentionPolicy is an endpoint retention policy (fvEpRetPol) of
+// a tenant.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type EndpointRetentionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EndpointRetentionPolicySpec   `json:"spec"`
+	Status EndpointRetentionPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EndpointRetentionPolicyList contains a list of EndpointRetentionPolicy
+type EndpointRetentionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EndpointRetentionPolicy `json:"items"`
+}
+
+// EndpointRetentionPolicy type metadata.
+var (
+	EndpointRetentionPolicyKind             = reflect.TypeOf(EndpointRetentionPolicy{}).Name()
+	EndpointRetentionPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: EndpointRetentionPolicyKind}.String()
+	EndpointRetentionPolicyKindAPIVersion   = EndpointRetentionPolicyKind + "." + SchemeGroupVersion.String()
+	EndpointRetentionPolicyGroupVersionKind = SchemeGroupVersion.WithKind(EndpointRetentionPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&EndpointRetentionPolicy{}, &EndpointRetentionPolicyList{})
+}
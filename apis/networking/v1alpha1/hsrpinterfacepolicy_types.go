@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// HSRPInterfacePolicyParameters are the configurable fields of a HSRPInterfacePolicy.
+type HSRPInterfacePolicyParameters struct {
+	TenantPolicyParameters `json:",inline"`
+	// Control are the interface controls, e.g. bia and bfd.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Control []string `json:"control,omitempty" aci:"ctrl"`
+	// Delay is the minimum delay in seconds before the interface comes up.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="0"
+	Delay string `json:"delay" aci:"delay"`
+	// ReloadDelay is the delay in seconds after a reload before the
+	// interface comes up.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="0"
+	ReloadDelay string `json:"reloadDelay" aci:"reloadDelay"`
+}
+
+// HSRPInterfacePolicyObservation are the observable fields of a HSRPInterfacePolicy.
+type HSRPInterfacePolicyObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A HSRPInterfacePolicySpec defines the desired state of a HSRPInterfacePolicy.
+type HSRPInterfacePolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       HSRPInterfacePolicyParameters `json:"forProvider"`
+}
+
+// A HSRPInterfacePolicyStatus represents the observed state of a HSRPInterfacePolicy.
+type HSRPInterfacePolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          HSRPInterfacePolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An HSRPInterfacePolicy is an HSRP interface policy (hsrpIfPol) of a tenant.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type HSRPInterfacePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HSRPInterfacePolicySpec   `json:"spec"`
+	Status HSRPInterfacePolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HSRPInterfacePolicyList contains a list of HSRPInterfacePolicy
+type HSRPInterfacePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HSRPInterfacePolicy `json:"items"`
+}
+
+// HSRPInterfacePolicy type metadata.
+var (
+	HSRPInterfacePolicyKind             = reflect.TypeOf(HSRPInterfacePolicy{}).Name()
+	HSRPInterfacePolicyGroupKind        = schema.GroupKind{Group: Group, Kind: HSRPInterfacePolicyKind}.String()
+	HSRPInterfacePolicyKindAPIVersion   = HSRPInterfacePolicyKind + "." + SchemeGroupVersion.String()
+	HSRPInterfacePolicyGroupVersionKind = SchemeGroupVersion.WithKind(HSRPInterfacePolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&HSRPInterfacePolicy{}, &HSRPInterfacePolicyList{})
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A PrefixMatch matches routes by prefix (rtctrlMatchRtDest).
+type PrefixMatch struct {
+	IP string `json:"ip"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// Aggregate matches the prefixes between FromPrefixLength and
+	// ToPrefixLength.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=no
+	Aggregate string `json:"aggregate"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="0"
+	FromPrefixLength string `json:"fromPrefixLength"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="0"
+	ToPrefixLength string `json:"toPrefixLength"`
+}
+
+// A CommunityFactor is a community (rtctrlMatchCommFactor) of a community
+// term.
+type CommunityFactor struct {
+	// Community is e.g. regular:as2-nn2:65000:100.
+	Community string `json:"community"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=transitive;non-transitive
+	// +kubebuilder:default=transitive
+	Scope string `json:"scope"`
+}
+
+// A CommunityTerm matches routes carrying all its communities
+// (rtctrlMatchCommTerm).
+type CommunityTerm struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=community
+	Factors []CommunityFactor `json:"factors,omitempty"`
+}
+
+// A CommunityRegex matches routes by a regular expression on their
+// communities (rtctrlMatchCommRegexTerm).
+type CommunityRegex struct {
+	// +kubebuilder:validation:Enum=regular;extended
+	Type  string `json:"type"`
+	Regex string `json:"regex"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+}
+
+// MatchRuleParameters are the configurable fields of a MatchRule.
+type MatchRuleParameters struct {
+	Name   string `json:"name"`
+	Tenant string `json:"tenant"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=ip
+	Prefixes []PrefixMatch `json:"prefixes,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	CommunityTerms []CommunityTerm `json:"communityTerms,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=type
+	CommunityRegexes []CommunityRegex `json:"communityRegexes,omitempty"`
+}
+
+// MatchRuleObservation are the observable fields of a MatchRule.
+type MatchRuleObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A MatchRuleSpec defines the desired state of a MatchRule.
+type MatchRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MatchRuleParameters `json:"forProvider"`
+}
+
+// A MatchRuleStatus represents the observed state of a MatchRule.
+type MatchRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MatchRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MatchRule is a route control match rule (rtctrlSubjP) of a tenant.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type MatchRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MatchRuleSpec   `json:"spec"`
+	Status MatchRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MatchRuleList contains a list of MatchRule
+type MatchRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MatchRule `json:"items"`
+}
+
+// MatchRule type metadata.
+var (
+	MatchRuleKind             = reflect.TypeOf(MatchRule{}).Name()
+	MatchRuleGroupKind        = schema.GroupKind{Group: Group, Kind: MatchRuleKind}.String()
+	MatchRuleKindAPIVersion   = MatchRuleKind + "." + SchemeGroupVersion.String()
+	MatchRuleGroupVersionKind = SchemeGroupVersion.WithKind(MatchRuleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&MatchRule{}, &MatchRuleList{})
+}
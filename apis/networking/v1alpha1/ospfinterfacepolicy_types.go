@@ -0,0 +1,114 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// OSPFInterfacePolicyParameters are the configurable fields of a OSPFInterfacePolicy.
+type OSPFInterfacePolicyParameters struct {
+	TenantPolicyParameters `json:",inline"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=unspecified;p2p;bcast
+	// +kubebuilder:default=unspecified
+	NetworkType string `json:"networkType" aci:"nwT"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=unspecified
+	Cost string `json:"cost" aci:"cost"`
+	// Control are the interface controls, e.g. mtu-ignore, passive,
+	// advert-subnet and bfd.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Control []string `json:"control,omitempty" aci:"ctrl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="1"
+	Priority string `json:"priority" aci:"prio"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="10"
+	HelloInterval string `json:"helloInterval" aci:"helloIntvl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="40"
+	DeadInterval string `json:"deadInterval" aci:"deadIntvl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="5"
+	RetransmitInterval string `json:"retransmitInterval" aci:"rexmitIntvl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="1"
+	TransmitDelay string `json:"transmitDelay" aci:"xmitDelay"`
+}
+
+// OSPFInterfacePolicyObservation are the observable fields of a OSPFInterfacePolicy.
+type OSPFInterfacePolicyObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A OSPFInterfacePolicySpec defines the desired state of a OSPFInterfacePolicy.
+type OSPFInterfacePolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OSPFInterfacePolicyParameters `json:"forProvider"`
+}
+
+// A OSPFInterfacePolicyStatus represents the observed state of a OSPFInterfacePolicy.
+type OSPFInterfacePolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OSPFInterfacePolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OSPFInterfacePolicy is an OSPF interface policy (ospfIfPol) of a tenant.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type OSPFInterfacePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OSPFInterfacePolicySpec   `json:"spec"`
+	Status OSPFInterfacePolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OSPFInterfacePolicyList contains a list of OSPFInterfacePolicy
+type OSPFInterfacePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OSPFInterfacePolicy `json:"items"`
+}
+
+// OSPFInterfacePolicy type metadata.
+var (
+	OSPFInterfacePolicyKind             = reflect.TypeOf(OSPFInterfacePolicy{}).Name()
+	OSPFInterfacePolicyGroupKind        = schema.GroupKind{Group: Group, Kind: OSPFInterfacePolicyKind}.String()
+	OSPFInterfacePolicyKindAPIVersion   = OSPFInterfacePolicyKind + "." + SchemeGroupVersion.String()
+	OSPFInterfacePolicyGroupVersionKind = SchemeGroupVersion.WithKind(OSPFInterfacePolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OSPFInterfacePolicy{}, &OSPFInterfacePolicyList{})
+}
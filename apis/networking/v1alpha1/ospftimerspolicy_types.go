@@ -0,0 +1,127 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// OSPFTimersPolicyParameters are the configurable fields of a OSPFTimersPolicy.
+type OSPFTimersPolicyParameters struct {
+	TenantPolicyParameters `json:",inline"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="40000"
+	BandwidthReference string `json:"bandwidthReference" aci:"bwRef"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="110"
+	Distance string `json:"distance" aci:"dist"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="8"
+	MaxECMP string `json:"maxEcmp" aci:"maxEcmp"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="200"
+	SPFInitInterval string `json:"spfInitInterval" aci:"spfInitIntvl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="1000"
+	SPFHoldInterval string `json:"spfHoldInterval" aci:"spfHoldIntvl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="5000"
+	SPFMaxInterval string `json:"spfMaxInterval" aci:"spfMaxIntvl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="0"
+	LSAStartInterval string `json:"lsaStartInterval" aci:"lsaStartIntvl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="5000"
+	LSAHoldInterval string `json:"lsaHoldInterval" aci:"lsaHoldIntvl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="5000"
+	LSAMaxInterval string `json:"lsaMaxInterval" aci:"lsaMaxIntvl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="1000"
+	LSAArrivalInterval string `json:"lsaArrivalInterval" aci:"lsaArrivalIntvl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="10"
+	LSAGroupPacingInterval string `json:"lsaGroupPacingInterval" aci:"lsaGpPacingIntvl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="20000"
+	MaxLSANumber string `json:"maxLsaNumber" aci:"maxLsaNum"`
+	// Control are the VRF controls, e.g. name-lookup and pfx-suppress.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Control []string `json:"control,omitempty" aci:"ctrl"`
+}
+
+// OSPFTimersPolicyObservation are the observable fields of a OSPFTimersPolicy.
+type OSPFTimersPolicyObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A OSPFTimersPolicySpec defines the desired state of a OSPFTimersPolicy.
+type OSPFTimersPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OSPFTimersPolicyParameters `json:"forProvider"`
+}
+
+// A OSPFTimersPolicyStatus represents the observed state of a OSPFTimersPolicy.
+type OSPFTimersPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OSPFTimersPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OSPFTimersPolicy is an OSPF timers policy (ospfCtxPol) of a tenant.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type OSPFTimersPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OSPFTimersPolicySpec   `json:"spec"`
+	Status OSPFTimersPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OSPFTimersPolicyList contains a list of OSPFTimersPolicy
+type OSPFTimersPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OSPFTimersPolicy `json:"items"`
+}
+
+// OSPFTimersPolicy type metadata.
+var (
+	OSPFTimersPolicyKind             = reflect.TypeOf(OSPFTimersPolicy{}).Name()
+	OSPFTimersPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: OSPFTimersPolicyKind}.String()
+	OSPFTimersPolicyKindAPIVersion   = OSPFTimersPolicyKind + "." + SchemeGroupVersion.String()
+	OSPFTimersPolicyGroupVersionKind = SchemeGroupVersion.WithKind(OSPFTimersPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OSPFTimersPolicy{}, &OSPFTimersPolicyList{})
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A RouteControlContext is an entry (rtctrlCtxP) of a route map.
+type RouteControlContext struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=permit;deny
+	// +kubebuilder:default=permit
+	Action string `json:"action"`
+	// MatchRules are the names of the MatchRules of the tenant the context
+	// matches routes with (rtctrlRsCtxPToSubjP).
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	MatchRules []string `json:"matchRules,omitempty"`
+	// SetRule is the name of the SetRule of the tenant applied to the
+	// matched routes (rtctrlRsScopeToAttrP).
+	// +kubebuilder:validation:Optional
+	SetRule string `json:"setRule"`
+}
+
+// RouteControlProfileParameters are the configurable fields of a RouteControlProfile.
+type RouteControlProfileParameters struct {
+	Name   string `json:"name"`
+	Tenant string `json:"tenant"`
+	// L3Out is the name of the L3Out of the tenant the route map belongs to.
+	// The route map is a tenant one if empty.
+	// +kubebuilder:validation:Optional
+	L3Out string `json:"l3Out"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// Type is combinable to merge the route map with the prefixes of the
+	// L3Out subnets, or global to use the route map alone.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=combinable;global
+	// +kubebuilder:default=combinable
+	Type string `json:"type"`
+	// Contexts are the entries of the route map. Their order in the list is
+	// their order (0-9) in the route map.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=10
+	Contexts []RouteControlContext `json:"contexts,omitempty"`
+}
+
+// RouteControlProfileObservation are the observable fields of a RouteControlProfile.
+type RouteControlProfileObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A RouteControlProfileSpec defines the desired state of a RouteControlProfile.
+type RouteControlProfileSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RouteControlProfileParameters `json:"forProvider"`
+}
+
+// A RouteControlProfileStatus represents the observed state of a RouteControlProfile.
+type RouteControlProfileStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RouteControlProfileObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RouteControlProfile is a route map (rtctrlProfile) of a tenant or an
+// L3Out.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type RouteControlProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RouteControlProfileSpec   `json:"spec"`
+	Status RouteControlProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RouteControlProfileList contains a list of RouteControlProfile
+type RouteControlProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RouteControlProfile `json:"items"`
+}
+
+// RouteControlProfile type metadata.
+var (
+	RouteControlProfileKind             = reflect.TypeOf(RouteControlProfile{}).Name()
+	RouteControlProfileGroupKind        = schema.GroupKind{Group: Group, Kind: RouteControlProfileKind}.String()
+	RouteControlProfileKindAPIVersion   = RouteControlProfileKind + "." + SchemeGroupVersion.String()
+	RouteControlProfileGroupVersionKind = SchemeGroupVersion.WithKind(RouteControlProfileKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&RouteControlProfile{}, &RouteControlProfileList{})
+}
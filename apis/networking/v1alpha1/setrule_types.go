@@ -0,0 +1,112 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A SetCommunity sets the community of the matched routes (rtctrlSetComm).
+type SetCommunity struct {
+	// Community is e.g. regular:as2-nn2:65000:100.
+	Community string `json:"community"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=append;replace;none
+	// +kubebuilder:default=append
+	Criteria string `json:"criteria"`
+}
+
+// SetRuleParameters are the configurable fields of a SetRule.
+type SetRuleParameters struct {
+	Name   string `json:"name"`
+	Tenant string `json:"tenant"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	Community *SetCommunity `json:"community,omitempty"`
+	// LocalPreference is the BGP local preference set (rtctrlSetPref).
+	// +kubebuilder:validation:Optional
+	LocalPreference string `json:"localPreference"`
+	// Metric is the MED set (rtctrlSetRtMetric).
+	// +kubebuilder:validation:Optional
+	Metric string `json:"metric"`
+	// ASPathPrepend are the AS numbers prepended to the AS path, in order
+	// (rtctrlSetASPathASN).
+	// +kubebuilder:validation:Optional
+	ASPathPrepend []string `json:"asPathPrepend,omitempty"`
+}
+
+// SetRuleObservation are the observable fields of a SetRule.
+type SetRuleObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A SetRuleSpec defines the desired state of a SetRule.
+type SetRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SetRuleParameters `json:"forProvider"`
+}
+
+// A SetRuleStatus represents the observed state of a SetRule.
+type SetRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SetRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SetRule is a route control set rule (rtctrlAttrP) of a tenant.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type SetRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SetRuleSpec   `json:"spec"`
+	Status SetRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SetRuleList contains a list of SetRule
+type SetRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SetRule `json:"items"`
+}
+
+// SetRule type metadata.
+var (
+	SetRuleKind             = reflect.TypeOf(SetRule{}).Name()
+	SetRuleGroupKind        = schema.GroupKind{Group: Group, Kind: SetRuleKind}.String()
+	SetRuleKindAPIVersion   = SetRuleKind + "." + SchemeGroupVersion.String()
+	SetRuleGroupVersionKind = SchemeGroupVersion.WithKind(SetRuleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SetRule{}, &SetRuleList{})
+}
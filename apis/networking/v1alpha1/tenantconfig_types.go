@@ -0,0 +1,177 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A TenantConfigVrf is a VRF (fvCtx) of a TenantConfig.
+type TenantConfigVrf struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// PcEnfPref is the policy control enforcement of the VRF.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enforced;unenforced
+	// +kubebuilder:default=enforced
+	PcEnfPref string `json:"pcEnfPref"`
+}
+
+// A TenantConfigSubnet is a subnet (fvSubnet) of a bridge domain of a
+// TenantConfig.
+type TenantConfigSubnet struct {
+	// IP is the gateway IP address and mask of the subnet, e.g. 10.0.0.1/24.
+	IP string `json:"ip"`
+	// Scope is where the subnet is advertised, e.g. private or
+	// public,shared.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=private
+	Scope string `json:"scope"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+}
+
+// A TenantConfigBridgeDomain is a bridge domain (fvBD) of a TenantConfig.
+type TenantConfigBridgeDomain struct {
+	Name string `json:"name"`
+	// Vrf is the name of the VRF of the tenant the bridge domain is
+	// associated with (fvRsCtx).
+	Vrf string `json:"vrf"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=no
+	ArpFlood string `json:"arpFlood"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=yes
+	UnicastRoute string `json:"unicastRoute"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=flood;proxy
+	// +kubebuilder:default=proxy
+	UnkMacUcastAct string `json:"unkMacUcastAct"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=ip
+	Subnets []TenantConfigSubnet `json:"subnets,omitempty"`
+}
+
+// A TenantConfigEndpointGroup is an EPG (fvAEPg) of an application profile
+// of a TenantConfig.
+type TenantConfigEndpointGroup struct {
+	Name string `json:"name"`
+	// BridgeDomain is the name of the bridge domain of the tenant the EPG is
+	// associated with (fvRsBd).
+	BridgeDomain string `json:"bridgeDomain"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+}
+
+// A TenantConfigApplicationProfile is an application profile (fvAp) of a
+// TenantConfig.
+type TenantConfigApplicationProfile struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	EndpointGroups []TenantConfigEndpointGroup `json:"endpointGroups,omitempty"`
+}
+
+// TenantConfigParameters are the configurable fields of a TenantConfig.
+type TenantConfigParameters struct {
+	// Tenant is the name of the existing tenant the objects are created in.
+	Tenant string `json:"tenant"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	Vrfs []TenantConfigVrf `json:"vrfs,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	BridgeDomains []TenantConfigBridgeDomain `json:"bridgeDomains,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	ApplicationProfiles []TenantConfigApplicationProfile `json:"applicationProfiles,omitempty"`
+}
+
+// TenantConfigObservation are the observable fields of a TenantConfig.
+type TenantConfigObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A TenantConfigSpec defines the desired state of a TenantConfig.
+type TenantConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TenantConfigParameters `json:"forProvider"`
+}
+
+// A TenantConfigStatus represents the observed state of a TenantConfig.
+type TenantConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TenantConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TenantConfig is a tree of VRFs, bridge domains, subnets, application
+// profiles and EPGs of a tenant, applied in a single transaction. The tenant
+// must already exist; a TenantConfig neither creates nor deletes it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type TenantConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TenantConfigSpec   `json:"spec"`
+	Status TenantConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TenantConfigList contains a list of TenantConfig
+type TenantConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantConfig `json:"items"`
+}
+
+// TenantConfig type metadata.
+var (
+	TenantConfigKind             = reflect.TypeOf(TenantConfig{}).Name()
+	TenantConfigGroupKind        = schema.GroupKind{Group: Group, Kind: TenantConfigKind}.String()
+	TenantConfigKindAPIVersion   = TenantConfigKind + "." + SchemeGroupVersion.String()
+	TenantConfigGroupVersionKind = SchemeGroupVersion.WithKind(TenantConfigKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&TenantConfig{}, &TenantConfigList{})
+}
@@ -25,12 +25,102 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+// A VrfEigrpAddressFamilyPolicy is the EIGRP address family policy of a Vrf
+// for one address family.
+type VrfEigrpAddressFamilyPolicy struct {
+	// +kubebuilder:validation:Enum=ipv4-ucast;ipv6-ucast
+	AddressFamily string `json:"addressFamily"`
+	Policy        string `json:"policy"`
+}
+
+// VrfVzAny is the vzAny of a Vrf, standing for all the EPGs of the VRF.
+type VrfVzAny struct {
+	// ProvidedContracts are the names of the contracts (vzRsAnyToProv)
+	// provided by all the EPGs of the VRF.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	ProvidedContracts []string `json:"providedContracts,omitempty"`
+	// ConsumedContracts are the names of the contracts (vzRsAnyToCons)
+	// consumed by all the EPGs of the VRF.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	ConsumedContracts []string `json:"consumedContracts,omitempty"`
+	// PreferredGroup enables the preferred group of the VRF.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +kubebuilder:default=disabled
+	PreferredGroup string `json:"preferredGroup,omitempty"`
+}
+
 // VrfParameters are the configurable fields of a Vrf.
 type VrfParameters struct {
 	Name   string `json:"name"`
 	Tenant string `json:"tenant"`
 	// +kubebuilder:validation:Optional
 	NameAlias string `json:"nameAlias"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// PcEnfPref is the policy control enforcement of the VRF.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enforced;unenforced
+	// +kubebuilder:default=enforced
+	PcEnfPref string `json:"pcEnfPref"`
+	// PcEnfDir is where policy is enforced for traffic of L3Outs.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=ingress;egress
+	// +kubebuilder:default=ingress
+	PcEnfDir string `json:"pcEnfDir"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +kubebuilder:default=enabled
+	IpDataPlaneLearning string `json:"ipDataPlaneLearning"`
+	// KnwMcastAct is the forwarding of known multicast traffic.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=permit;deny
+	// +kubebuilder:default=permit
+	KnwMcastAct string `json:"knwMcastAct"`
+	// BdEnforcedEnable restricts endpoints to reach the bridge domain
+	// subnets of the VRF other than their own.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=no
+	BdEnforcedEnable string `json:"bdEnforcedEnable"`
+	// BgpTimersPolicy is the name of the BGP timers policy
+	// (fvRsBgpCtxPol). The default policy is used if empty.
+	// +kubebuilder:validation:Optional
+	BgpTimersPolicy string `json:"bgpTimersPolicy"`
+	// OspfTimersPolicy is the name of the OSPF timers policy
+	// (fvRsOspfCtxPol). The default policy is used if empty.
+	// +kubebuilder:validation:Optional
+	OspfTimersPolicy string `json:"ospfTimersPolicy"`
+	// EigrpAddressFamilyPolicies are the EIGRP address family policies
+	// (fvRsCtxToEigrpCtxAfPol) of the VRF.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=addressFamily
+	EigrpAddressFamilyPolicies []VrfEigrpAddressFamilyPolicy `json:"eigrpAddressFamilyPolicies,omitempty"`
+	// RouteTagPolicy is the name of the route tag policy
+	// (fvRsCtxToExtRouteTagPol). The default policy is used if empty.
+	// +kubebuilder:validation:Optional
+	RouteTagPolicy string `json:"routeTagPolicy"`
+	// EpRetentionPolicy is the name of the endpoint retention policy
+	// (fvRsCtxToEpRet). The default policy is used if empty.
+	// +kubebuilder:validation:Optional
+	EpRetentionPolicy string `json:"epRetentionPolicy"`
+	// MonitoringPolicy is the name of the monitoring policy
+	// (fvRsCtxMonPol). The default policy is used if empty.
+	// +kubebuilder:validation:Optional
+	MonitoringPolicy string `json:"monitoringPolicy"`
+	// VzAny configures the contracts and the preferred group of all the
+	// EPGs of the VRF.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default={}
+	VzAny VrfVzAny `json:"vzAny,omitempty"`
+	// SecurityDomains are the names of the security domains (aaaDomainRef)
+	// the object belongs to. They are reconciled as a whole.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	SecurityDomains []string `json:"securityDomains,omitempty"`
 }
 
 // VrfObservation are the observable fields of a Vrf.
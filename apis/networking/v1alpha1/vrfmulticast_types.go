@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A MulticastRP is a rendezvous point of a VrfMulticast.
+type MulticastRP struct {
+	// Address is the IP address of the rendezvous point.
+	Address string `json:"address"`
+	// GroupRouteMap is the name of the PIM route map (pimRouteMapPol) of the
+	// tenant with the multicast groups the rendezvous point serves. All the
+	// groups are served if empty.
+	// +kubebuilder:validation:Optional
+	GroupRouteMap string `json:"groupRouteMap"`
+}
+
+// VrfMulticastParameters are the configurable fields of a VrfMulticast.
+type VrfMulticastParameters struct {
+	Tenant string `json:"tenant"`
+	Vrf    string `json:"vrf"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="1500"
+	Mtu string `json:"mtu"`
+	// Control are the PIM flags of the VRF.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Control []string `json:"control,omitempty"`
+	// StaticRPs are the static rendezvous points (pimStaticRPEntryPol) of
+	// the VRF.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=address
+	StaticRPs []MulticastRP `json:"staticRps,omitempty"`
+	// FabricRPs are the anycast rendezvous points of the fabric
+	// (pimFabricRPPol).
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=address
+	FabricRPs []MulticastRP `json:"fabricRps,omitempty"`
+	// AutoRPForwarding forwards the Auto-RP messages (pimAutoRPPol).
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +kubebuilder:default=disabled
+	AutoRPForwarding string `json:"autoRpForwarding"`
+	// BSRForwarding forwards the bootstrap router messages (pimBSRPPol).
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +kubebuilder:default=disabled
+	BSRForwarding string `json:"bsrForwarding"`
+	// ASMSharedRangeRouteMap is the name of the PIM route map of the tenant
+	// with the groups of the ASM shared range (pimSharedRangePol).
+	// +kubebuilder:validation:Optional
+	ASMSharedRangeRouteMap string `json:"asmSharedRangeRouteMap"`
+	// SSMRangeRouteMap is the name of the PIM route map of the tenant with
+	// the groups of the SSM range (pimSSMRangePol). The default 232.0.0.0/8
+	// range is used if empty.
+	// +kubebuilder:validation:Optional
+	SSMRangeRouteMap string `json:"ssmRangeRouteMap"`
+}
+
+// VrfMulticastObservation are the observable fields of a VrfMulticast.
+type VrfMulticastObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A VrfMulticastSpec defines the desired state of a VrfMulticast.
+type VrfMulticastSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VrfMulticastParameters `json:"forProvider"`
+}
+
+// A VrfMulticastStatus represents the observed state of a VrfMulticast.
+type VrfMulticastStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VrfMulticastObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VrfMulticast is the PIM configuration (pimCtxP) of a Vrf.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type VrfMulticast struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VrfMulticastSpec   `json:"spec"`
+	Status VrfMulticastStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VrfMulticastList contains a list of VrfMulticast
+type VrfMulticastList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VrfMulticast `json:"items"`
+}
+
+// VrfMulticast type metadata.
+var (
+	VrfMulticastKind             = reflect.TypeOf(VrfMulticast{}).Name()
+	VrfMulticastGroupKind        = schema.GroupKind{Group: Group, Kind: VrfMulticastKind}.String()
+	VrfMulticastKindAPIVersion   = VrfMulticastKind + "." + SchemeGroupVersion.String()
+	VrfMulticastGroupVersionKind = SchemeGroupVersion.WithKind(VrfMulticastKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&VrfMulticast{}, &VrfMulticastList{})
+}
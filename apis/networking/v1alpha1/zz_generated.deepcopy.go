@@ -25,6 +25,502 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BFDInterfacePolicy) DeepCopyInto(out *BFDInterfacePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BFDInterfacePolicy.
+func (in *BFDInterfacePolicy) DeepCopy() *BFDInterfacePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BFDInterfacePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BFDInterfacePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BFDInterfacePolicyList) DeepCopyInto(out *BFDInterfacePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BFDInterfacePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BFDInterfacePolicyList.
+func (in *BFDInterfacePolicyList) DeepCopy() *BFDInterfacePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(BFDInterfacePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BFDInterfacePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BFDInterfacePolicyObservation) DeepCopyInto(out *BFDInterfacePolicyObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BFDInterfacePolicyObservation.
+func (in *BFDInterfacePolicyObservation) DeepCopy() *BFDInterfacePolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BFDInterfacePolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BFDInterfacePolicyParameters) DeepCopyInto(out *BFDInterfacePolicyParameters) {
+	*out = *in
+	out.TenantPolicyParameters = in.TenantPolicyParameters
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BFDInterfacePolicyParameters.
+func (in *BFDInterfacePolicyParameters) DeepCopy() *BFDInterfacePolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BFDInterfacePolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BFDInterfacePolicySpec) DeepCopyInto(out *BFDInterfacePolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BFDInterfacePolicySpec.
+func (in *BFDInterfacePolicySpec) DeepCopy() *BFDInterfacePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BFDInterfacePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BFDInterfacePolicyStatus) DeepCopyInto(out *BFDInterfacePolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BFDInterfacePolicyStatus.
+func (in *BFDInterfacePolicyStatus) DeepCopy() *BFDInterfacePolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BFDInterfacePolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPAddressFamilyPolicy) DeepCopyInto(out *BGPAddressFamilyPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPAddressFamilyPolicy.
+func (in *BGPAddressFamilyPolicy) DeepCopy() *BGPAddressFamilyPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPAddressFamilyPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BGPAddressFamilyPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPAddressFamilyPolicyList) DeepCopyInto(out *BGPAddressFamilyPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BGPAddressFamilyPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPAddressFamilyPolicyList.
+func (in *BGPAddressFamilyPolicyList) DeepCopy() *BGPAddressFamilyPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPAddressFamilyPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BGPAddressFamilyPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPAddressFamilyPolicyObservation) DeepCopyInto(out *BGPAddressFamilyPolicyObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPAddressFamilyPolicyObservation.
+func (in *BGPAddressFamilyPolicyObservation) DeepCopy() *BGPAddressFamilyPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPAddressFamilyPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPAddressFamilyPolicyParameters) DeepCopyInto(out *BGPAddressFamilyPolicyParameters) {
+	*out = *in
+	out.TenantPolicyParameters = in.TenantPolicyParameters
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPAddressFamilyPolicyParameters.
+func (in *BGPAddressFamilyPolicyParameters) DeepCopy() *BGPAddressFamilyPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPAddressFamilyPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPAddressFamilyPolicySpec) DeepCopyInto(out *BGPAddressFamilyPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPAddressFamilyPolicySpec.
+func (in *BGPAddressFamilyPolicySpec) DeepCopy() *BGPAddressFamilyPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPAddressFamilyPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPAddressFamilyPolicyStatus) DeepCopyInto(out *BGPAddressFamilyPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPAddressFamilyPolicyStatus.
+func (in *BGPAddressFamilyPolicyStatus) DeepCopy() *BGPAddressFamilyPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPAddressFamilyPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPBestPathPolicy) DeepCopyInto(out *BGPBestPathPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPBestPathPolicy.
+func (in *BGPBestPathPolicy) DeepCopy() *BGPBestPathPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPBestPathPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BGPBestPathPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPBestPathPolicyList) DeepCopyInto(out *BGPBestPathPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BGPBestPathPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPBestPathPolicyList.
+func (in *BGPBestPathPolicyList) DeepCopy() *BGPBestPathPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPBestPathPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BGPBestPathPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPBestPathPolicyObservation) DeepCopyInto(out *BGPBestPathPolicyObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPBestPathPolicyObservation.
+func (in *BGPBestPathPolicyObservation) DeepCopy() *BGPBestPathPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPBestPathPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPBestPathPolicyParameters) DeepCopyInto(out *BGPBestPathPolicyParameters) {
+	*out = *in
+	out.TenantPolicyParameters = in.TenantPolicyParameters
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPBestPathPolicyParameters.
+func (in *BGPBestPathPolicyParameters) DeepCopy() *BGPBestPathPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPBestPathPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPBestPathPolicySpec) DeepCopyInto(out *BGPBestPathPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPBestPathPolicySpec.
+func (in *BGPBestPathPolicySpec) DeepCopy() *BGPBestPathPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPBestPathPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPBestPathPolicyStatus) DeepCopyInto(out *BGPBestPathPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPBestPathPolicyStatus.
+func (in *BGPBestPathPolicyStatus) DeepCopy() *BGPBestPathPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPBestPathPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPTimersPolicy) DeepCopyInto(out *BGPTimersPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPTimersPolicy.
+func (in *BGPTimersPolicy) DeepCopy() *BGPTimersPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPTimersPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BGPTimersPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPTimersPolicyList) DeepCopyInto(out *BGPTimersPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BGPTimersPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPTimersPolicyList.
+func (in *BGPTimersPolicyList) DeepCopy() *BGPTimersPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPTimersPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BGPTimersPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPTimersPolicyObservation) DeepCopyInto(out *BGPTimersPolicyObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPTimersPolicyObservation.
+func (in *BGPTimersPolicyObservation) DeepCopy() *BGPTimersPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPTimersPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPTimersPolicyParameters) DeepCopyInto(out *BGPTimersPolicyParameters) {
+	*out = *in
+	out.TenantPolicyParameters = in.TenantPolicyParameters
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPTimersPolicyParameters.
+func (in *BGPTimersPolicyParameters) DeepCopy() *BGPTimersPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPTimersPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPTimersPolicySpec) DeepCopyInto(out *BGPTimersPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPTimersPolicySpec.
+func (in *BGPTimersPolicySpec) DeepCopy() *BGPTimersPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPTimersPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPTimersPolicyStatus) DeepCopyInto(out *BGPTimersPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPTimersPolicyStatus.
+func (in *BGPTimersPolicyStatus) DeepCopy() *BGPTimersPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPTimersPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BridgeDomain) DeepCopyInto(out *BridgeDomain) {
 	*out = *in
@@ -34,18 +530,1486 @@ func (in *BridgeDomain) DeepCopyInto(out *BridgeDomain) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomain.
-func (in *BridgeDomain) DeepCopy() *BridgeDomain {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomain.
+func (in *BridgeDomain) DeepCopy() *BridgeDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BridgeDomain) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeDomainList) DeepCopyInto(out *BridgeDomainList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BridgeDomain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomainList.
+func (in *BridgeDomainList) DeepCopy() *BridgeDomainList {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeDomainList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BridgeDomainList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeDomainObservation) DeepCopyInto(out *BridgeDomainObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomainObservation.
+func (in *BridgeDomainObservation) DeepCopy() *BridgeDomainObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeDomainObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeDomainParameters) DeepCopyInto(out *BridgeDomainParameters) {
+	*out = *in
+	if in.L3Outs != nil {
+		in, out := &in.L3Outs, &out.L3Outs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DHCPLabels != nil {
+		in, out := &in.DHCPLabels, &out.DHCPLabels
+		*out = make([]DHCPLabel, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityDomains != nil {
+		in, out := &in.SecurityDomains, &out.SecurityDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomainParameters.
+func (in *BridgeDomainParameters) DeepCopy() *BridgeDomainParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeDomainParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeDomainSpec) DeepCopyInto(out *BridgeDomainSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomainSpec.
+func (in *BridgeDomainSpec) DeepCopy() *BridgeDomainSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeDomainSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeDomainStatus) DeepCopyInto(out *BridgeDomainStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomainStatus.
+func (in *BridgeDomainStatus) DeepCopy() *BridgeDomainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeDomainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommunityFactor) DeepCopyInto(out *CommunityFactor) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommunityFactor.
+func (in *CommunityFactor) DeepCopy() *CommunityFactor {
+	if in == nil {
+		return nil
+	}
+	out := new(CommunityFactor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommunityRegex) DeepCopyInto(out *CommunityRegex) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommunityRegex.
+func (in *CommunityRegex) DeepCopy() *CommunityRegex {
+	if in == nil {
+		return nil
+	}
+	out := new(CommunityRegex)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommunityTerm) DeepCopyInto(out *CommunityTerm) {
+	*out = *in
+	if in.Factors != nil {
+		in, out := &in.Factors, &out.Factors
+		*out = make([]CommunityFactor, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommunityTerm.
+func (in *CommunityTerm) DeepCopy() *CommunityTerm {
+	if in == nil {
+		return nil
+	}
+	out := new(CommunityTerm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPLabel) DeepCopyInto(out *DHCPLabel) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPLabel.
+func (in *DHCPLabel) DeepCopy() *DHCPLabel {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPLabel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPOption) DeepCopyInto(out *DHCPOption) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOption.
+func (in *DHCPOption) DeepCopy() *DHCPOption {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPOption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPOptionPolicy) DeepCopyInto(out *DHCPOptionPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionPolicy.
+func (in *DHCPOptionPolicy) DeepCopy() *DHCPOptionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPOptionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DHCPOptionPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPOptionPolicyList) DeepCopyInto(out *DHCPOptionPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DHCPOptionPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionPolicyList.
+func (in *DHCPOptionPolicyList) DeepCopy() *DHCPOptionPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPOptionPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DHCPOptionPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPOptionPolicyObservation) DeepCopyInto(out *DHCPOptionPolicyObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionPolicyObservation.
+func (in *DHCPOptionPolicyObservation) DeepCopy() *DHCPOptionPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPOptionPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPOptionPolicyParameters) DeepCopyInto(out *DHCPOptionPolicyParameters) {
+	*out = *in
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]DHCPOption, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionPolicyParameters.
+func (in *DHCPOptionPolicyParameters) DeepCopy() *DHCPOptionPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPOptionPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPOptionPolicySpec) DeepCopyInto(out *DHCPOptionPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionPolicySpec.
+func (in *DHCPOptionPolicySpec) DeepCopy() *DHCPOptionPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPOptionPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPOptionPolicyStatus) DeepCopyInto(out *DHCPOptionPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionPolicyStatus.
+func (in *DHCPOptionPolicyStatus) DeepCopy() *DHCPOptionPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPOptionPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPProvider) DeepCopyInto(out *DHCPProvider) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPProvider.
+func (in *DHCPProvider) DeepCopy() *DHCPProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPRelayPolicy) DeepCopyInto(out *DHCPRelayPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPRelayPolicy.
+func (in *DHCPRelayPolicy) DeepCopy() *DHCPRelayPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPRelayPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DHCPRelayPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPRelayPolicyList) DeepCopyInto(out *DHCPRelayPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DHCPRelayPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPRelayPolicyList.
+func (in *DHCPRelayPolicyList) DeepCopy() *DHCPRelayPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPRelayPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DHCPRelayPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPRelayPolicyObservation) DeepCopyInto(out *DHCPRelayPolicyObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPRelayPolicyObservation.
+func (in *DHCPRelayPolicyObservation) DeepCopy() *DHCPRelayPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPRelayPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPRelayPolicyParameters) DeepCopyInto(out *DHCPRelayPolicyParameters) {
+	*out = *in
+	if in.Providers != nil {
+		in, out := &in.Providers, &out.Providers
+		*out = make([]DHCPProvider, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPRelayPolicyParameters.
+func (in *DHCPRelayPolicyParameters) DeepCopy() *DHCPRelayPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPRelayPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPRelayPolicySpec) DeepCopyInto(out *DHCPRelayPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPRelayPolicySpec.
+func (in *DHCPRelayPolicySpec) DeepCopy() *DHCPRelayPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPRelayPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPRelayPolicyStatus) DeepCopyInto(out *DHCPRelayPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPRelayPolicyStatus.
+func (in *DHCPRelayPolicyStatus) DeepCopy() *DHCPRelayPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPRelayPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointRetentionPolicy) DeepCopyInto(out *EndpointRetentionPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointRetentionPolicy.
+func (in *EndpointRetentionPolicy) DeepCopy() *EndpointRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EndpointRetentionPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointRetentionPolicyList) DeepCopyInto(out *EndpointRetentionPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EndpointRetentionPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointRetentionPolicyList.
+func (in *EndpointRetentionPolicyList) DeepCopy() *EndpointRetentionPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointRetentionPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EndpointRetentionPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointRetentionPolicyObservation) DeepCopyInto(out *EndpointRetentionPolicyObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointRetentionPolicyObservation.
+func (in *EndpointRetentionPolicyObservation) DeepCopy() *EndpointRetentionPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointRetentionPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointRetentionPolicyParameters) DeepCopyInto(out *EndpointRetentionPolicyParameters) {
+	*out = *in
+	out.TenantPolicyParameters = in.TenantPolicyParameters
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointRetentionPolicyParameters.
+func (in *EndpointRetentionPolicyParameters) DeepCopy() *EndpointRetentionPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointRetentionPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointRetentionPolicySpec) DeepCopyInto(out *EndpointRetentionPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointRetentionPolicySpec.
+func (in *EndpointRetentionPolicySpec) DeepCopy() *EndpointRetentionPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointRetentionPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointRetentionPolicyStatus) DeepCopyInto(out *EndpointRetentionPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointRetentionPolicyStatus.
+func (in *EndpointRetentionPolicyStatus) DeepCopy() *EndpointRetentionPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointRetentionPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HSRPInterfacePolicy) DeepCopyInto(out *HSRPInterfacePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HSRPInterfacePolicy.
+func (in *HSRPInterfacePolicy) DeepCopy() *HSRPInterfacePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(HSRPInterfacePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HSRPInterfacePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HSRPInterfacePolicyList) DeepCopyInto(out *HSRPInterfacePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HSRPInterfacePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HSRPInterfacePolicyList.
+func (in *HSRPInterfacePolicyList) DeepCopy() *HSRPInterfacePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(HSRPInterfacePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HSRPInterfacePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HSRPInterfacePolicyObservation) DeepCopyInto(out *HSRPInterfacePolicyObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HSRPInterfacePolicyObservation.
+func (in *HSRPInterfacePolicyObservation) DeepCopy() *HSRPInterfacePolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(HSRPInterfacePolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HSRPInterfacePolicyParameters) DeepCopyInto(out *HSRPInterfacePolicyParameters) {
+	*out = *in
+	out.TenantPolicyParameters = in.TenantPolicyParameters
+	if in.Control != nil {
+		in, out := &in.Control, &out.Control
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HSRPInterfacePolicyParameters.
+func (in *HSRPInterfacePolicyParameters) DeepCopy() *HSRPInterfacePolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(HSRPInterfacePolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HSRPInterfacePolicySpec) DeepCopyInto(out *HSRPInterfacePolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HSRPInterfacePolicySpec.
+func (in *HSRPInterfacePolicySpec) DeepCopy() *HSRPInterfacePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HSRPInterfacePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HSRPInterfacePolicyStatus) DeepCopyInto(out *HSRPInterfacePolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HSRPInterfacePolicyStatus.
+func (in *HSRPInterfacePolicyStatus) DeepCopy() *HSRPInterfacePolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HSRPInterfacePolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchRule) DeepCopyInto(out *MatchRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchRule.
+func (in *MatchRule) DeepCopy() *MatchRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MatchRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchRuleList) DeepCopyInto(out *MatchRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MatchRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchRuleList.
+func (in *MatchRuleList) DeepCopy() *MatchRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MatchRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchRuleObservation) DeepCopyInto(out *MatchRuleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchRuleObservation.
+func (in *MatchRuleObservation) DeepCopy() *MatchRuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchRuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchRuleParameters) DeepCopyInto(out *MatchRuleParameters) {
+	*out = *in
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]PrefixMatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.CommunityTerms != nil {
+		in, out := &in.CommunityTerms, &out.CommunityTerms
+		*out = make([]CommunityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CommunityRegexes != nil {
+		in, out := &in.CommunityRegexes, &out.CommunityRegexes
+		*out = make([]CommunityRegex, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchRuleParameters.
+func (in *MatchRuleParameters) DeepCopy() *MatchRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchRuleSpec) DeepCopyInto(out *MatchRuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchRuleSpec.
+func (in *MatchRuleSpec) DeepCopy() *MatchRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchRuleStatus) DeepCopyInto(out *MatchRuleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchRuleStatus.
+func (in *MatchRuleStatus) DeepCopy() *MatchRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MulticastRP) DeepCopyInto(out *MulticastRP) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MulticastRP.
+func (in *MulticastRP) DeepCopy() *MulticastRP {
+	if in == nil {
+		return nil
+	}
+	out := new(MulticastRP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSPFInterfacePolicy) DeepCopyInto(out *OSPFInterfacePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSPFInterfacePolicy.
+func (in *OSPFInterfacePolicy) DeepCopy() *OSPFInterfacePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OSPFInterfacePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OSPFInterfacePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSPFInterfacePolicyList) DeepCopyInto(out *OSPFInterfacePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OSPFInterfacePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSPFInterfacePolicyList.
+func (in *OSPFInterfacePolicyList) DeepCopy() *OSPFInterfacePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(OSPFInterfacePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OSPFInterfacePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSPFInterfacePolicyObservation) DeepCopyInto(out *OSPFInterfacePolicyObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSPFInterfacePolicyObservation.
+func (in *OSPFInterfacePolicyObservation) DeepCopy() *OSPFInterfacePolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OSPFInterfacePolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSPFInterfacePolicyParameters) DeepCopyInto(out *OSPFInterfacePolicyParameters) {
+	*out = *in
+	out.TenantPolicyParameters = in.TenantPolicyParameters
+	if in.Control != nil {
+		in, out := &in.Control, &out.Control
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSPFInterfacePolicyParameters.
+func (in *OSPFInterfacePolicyParameters) DeepCopy() *OSPFInterfacePolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OSPFInterfacePolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSPFInterfacePolicySpec) DeepCopyInto(out *OSPFInterfacePolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSPFInterfacePolicySpec.
+func (in *OSPFInterfacePolicySpec) DeepCopy() *OSPFInterfacePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OSPFInterfacePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSPFInterfacePolicyStatus) DeepCopyInto(out *OSPFInterfacePolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSPFInterfacePolicyStatus.
+func (in *OSPFInterfacePolicyStatus) DeepCopy() *OSPFInterfacePolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OSPFInterfacePolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSPFTimersPolicy) DeepCopyInto(out *OSPFTimersPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSPFTimersPolicy.
+func (in *OSPFTimersPolicy) DeepCopy() *OSPFTimersPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OSPFTimersPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OSPFTimersPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSPFTimersPolicyList) DeepCopyInto(out *OSPFTimersPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OSPFTimersPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSPFTimersPolicyList.
+func (in *OSPFTimersPolicyList) DeepCopy() *OSPFTimersPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(OSPFTimersPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OSPFTimersPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSPFTimersPolicyObservation) DeepCopyInto(out *OSPFTimersPolicyObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSPFTimersPolicyObservation.
+func (in *OSPFTimersPolicyObservation) DeepCopy() *OSPFTimersPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OSPFTimersPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSPFTimersPolicyParameters) DeepCopyInto(out *OSPFTimersPolicyParameters) {
+	*out = *in
+	out.TenantPolicyParameters = in.TenantPolicyParameters
+	if in.Control != nil {
+		in, out := &in.Control, &out.Control
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSPFTimersPolicyParameters.
+func (in *OSPFTimersPolicyParameters) DeepCopy() *OSPFTimersPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OSPFTimersPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSPFTimersPolicySpec) DeepCopyInto(out *OSPFTimersPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSPFTimersPolicySpec.
+func (in *OSPFTimersPolicySpec) DeepCopy() *OSPFTimersPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OSPFTimersPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSPFTimersPolicyStatus) DeepCopyInto(out *OSPFTimersPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSPFTimersPolicyStatus.
+func (in *OSPFTimersPolicyStatus) DeepCopy() *OSPFTimersPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OSPFTimersPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrefixMatch) DeepCopyInto(out *PrefixMatch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrefixMatch.
+func (in *PrefixMatch) DeepCopy() *PrefixMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(PrefixMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteControlContext) DeepCopyInto(out *RouteControlContext) {
+	*out = *in
+	if in.MatchRules != nil {
+		in, out := &in.MatchRules, &out.MatchRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteControlContext.
+func (in *RouteControlContext) DeepCopy() *RouteControlContext {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteControlContext)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteControlProfile) DeepCopyInto(out *RouteControlProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteControlProfile.
+func (in *RouteControlProfile) DeepCopy() *RouteControlProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteControlProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouteControlProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteControlProfileList) DeepCopyInto(out *RouteControlProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RouteControlProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteControlProfileList.
+func (in *RouteControlProfileList) DeepCopy() *RouteControlProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteControlProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouteControlProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteControlProfileObservation) DeepCopyInto(out *RouteControlProfileObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteControlProfileObservation.
+func (in *RouteControlProfileObservation) DeepCopy() *RouteControlProfileObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteControlProfileObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteControlProfileParameters) DeepCopyInto(out *RouteControlProfileParameters) {
+	*out = *in
+	if in.Contexts != nil {
+		in, out := &in.Contexts, &out.Contexts
+		*out = make([]RouteControlContext, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteControlProfileParameters.
+func (in *RouteControlProfileParameters) DeepCopy() *RouteControlProfileParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteControlProfileParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteControlProfileSpec) DeepCopyInto(out *RouteControlProfileSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteControlProfileSpec.
+func (in *RouteControlProfileSpec) DeepCopy() *RouteControlProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteControlProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteControlProfileStatus) DeepCopyInto(out *RouteControlProfileStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteControlProfileStatus.
+func (in *RouteControlProfileStatus) DeepCopy() *RouteControlProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteControlProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetCommunity) DeepCopyInto(out *SetCommunity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetCommunity.
+func (in *SetCommunity) DeepCopy() *SetCommunity {
+	if in == nil {
+		return nil
+	}
+	out := new(SetCommunity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetRule) DeepCopyInto(out *SetRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetRule.
+func (in *SetRule) DeepCopy() *SetRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SetRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SetRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetRuleList) DeepCopyInto(out *SetRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SetRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetRuleList.
+func (in *SetRuleList) DeepCopy() *SetRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(SetRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SetRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetRuleObservation) DeepCopyInto(out *SetRuleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetRuleObservation.
+func (in *SetRuleObservation) DeepCopy() *SetRuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SetRuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetRuleParameters) DeepCopyInto(out *SetRuleParameters) {
+	*out = *in
+	if in.Community != nil {
+		in, out := &in.Community, &out.Community
+		*out = new(SetCommunity)
+		**out = **in
+	}
+	if in.ASPathPrepend != nil {
+		in, out := &in.ASPathPrepend, &out.ASPathPrepend
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetRuleParameters.
+func (in *SetRuleParameters) DeepCopy() *SetRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SetRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetRuleSpec) DeepCopyInto(out *SetRuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetRuleSpec.
+func (in *SetRuleSpec) DeepCopy() *SetRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SetRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetRuleStatus) DeepCopyInto(out *SetRuleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetRuleStatus.
+func (in *SetRuleStatus) DeepCopy() *SetRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SetRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantConfig) DeepCopyInto(out *TenantConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantConfig.
+func (in *TenantConfig) DeepCopy() *TenantConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(BridgeDomain)
+	out := new(TenantConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *BridgeDomain) DeepCopyObject() runtime.Object {
+func (in *TenantConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -53,31 +2017,86 @@ func (in *BridgeDomain) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BridgeDomainList) DeepCopyInto(out *BridgeDomainList) {
+func (in *TenantConfigApplicationProfile) DeepCopyInto(out *TenantConfigApplicationProfile) {
+	*out = *in
+	if in.EndpointGroups != nil {
+		in, out := &in.EndpointGroups, &out.EndpointGroups
+		*out = make([]TenantConfigEndpointGroup, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantConfigApplicationProfile.
+func (in *TenantConfigApplicationProfile) DeepCopy() *TenantConfigApplicationProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantConfigApplicationProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantConfigBridgeDomain) DeepCopyInto(out *TenantConfigBridgeDomain) {
+	*out = *in
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]TenantConfigSubnet, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantConfigBridgeDomain.
+func (in *TenantConfigBridgeDomain) DeepCopy() *TenantConfigBridgeDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantConfigBridgeDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantConfigEndpointGroup) DeepCopyInto(out *TenantConfigEndpointGroup) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantConfigEndpointGroup.
+func (in *TenantConfigEndpointGroup) DeepCopy() *TenantConfigEndpointGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantConfigEndpointGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantConfigList) DeepCopyInto(out *TenantConfigList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]BridgeDomain, len(*in))
+		*out = make([]TenantConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomainList.
-func (in *BridgeDomainList) DeepCopy() *BridgeDomainList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantConfigList.
+func (in *TenantConfigList) DeepCopy() *TenantConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(BridgeDomainList)
+	out := new(TenantConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *BridgeDomainList) DeepCopyObject() runtime.Object {
+func (in *TenantConfigList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -85,65 +2104,129 @@ func (in *BridgeDomainList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BridgeDomainObservation) DeepCopyInto(out *BridgeDomainObservation) {
+func (in *TenantConfigObservation) DeepCopyInto(out *TenantConfigObservation) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomainObservation.
-func (in *BridgeDomainObservation) DeepCopy() *BridgeDomainObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantConfigObservation.
+func (in *TenantConfigObservation) DeepCopy() *TenantConfigObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(BridgeDomainObservation)
+	out := new(TenantConfigObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BridgeDomainParameters) DeepCopyInto(out *BridgeDomainParameters) {
+func (in *TenantConfigParameters) DeepCopyInto(out *TenantConfigParameters) {
 	*out = *in
+	if in.Vrfs != nil {
+		in, out := &in.Vrfs, &out.Vrfs
+		*out = make([]TenantConfigVrf, len(*in))
+		copy(*out, *in)
+	}
+	if in.BridgeDomains != nil {
+		in, out := &in.BridgeDomains, &out.BridgeDomains
+		*out = make([]TenantConfigBridgeDomain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ApplicationProfiles != nil {
+		in, out := &in.ApplicationProfiles, &out.ApplicationProfiles
+		*out = make([]TenantConfigApplicationProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomainParameters.
-func (in *BridgeDomainParameters) DeepCopy() *BridgeDomainParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantConfigParameters.
+func (in *TenantConfigParameters) DeepCopy() *TenantConfigParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(BridgeDomainParameters)
+	out := new(TenantConfigParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BridgeDomainSpec) DeepCopyInto(out *BridgeDomainSpec) {
+func (in *TenantConfigSpec) DeepCopyInto(out *TenantConfigSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	out.ForProvider = in.ForProvider
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomainSpec.
-func (in *BridgeDomainSpec) DeepCopy() *BridgeDomainSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantConfigSpec.
+func (in *TenantConfigSpec) DeepCopy() *TenantConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(BridgeDomainSpec)
+	out := new(TenantConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BridgeDomainStatus) DeepCopyInto(out *BridgeDomainStatus) {
+func (in *TenantConfigStatus) DeepCopyInto(out *TenantConfigStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomainStatus.
-func (in *BridgeDomainStatus) DeepCopy() *BridgeDomainStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantConfigStatus.
+func (in *TenantConfigStatus) DeepCopy() *TenantConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(BridgeDomainStatus)
+	out := new(TenantConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantConfigSubnet) DeepCopyInto(out *TenantConfigSubnet) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantConfigSubnet.
+func (in *TenantConfigSubnet) DeepCopy() *TenantConfigSubnet {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantConfigSubnet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantConfigVrf) DeepCopyInto(out *TenantConfigVrf) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantConfigVrf.
+func (in *TenantConfigVrf) DeepCopy() *TenantConfigVrf {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantConfigVrf)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantPolicyParameters) DeepCopyInto(out *TenantPolicyParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantPolicyParameters.
+func (in *TenantPolicyParameters) DeepCopy() *TenantPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantPolicyParameters)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -175,6 +2258,21 @@ func (in *Vrf) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VrfEigrpAddressFamilyPolicy) DeepCopyInto(out *VrfEigrpAddressFamilyPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VrfEigrpAddressFamilyPolicy.
+func (in *VrfEigrpAddressFamilyPolicy) DeepCopy() *VrfEigrpAddressFamilyPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VrfEigrpAddressFamilyPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VrfList) DeepCopyInto(out *VrfList) {
 	*out = *in
@@ -207,6 +2305,144 @@ func (in *VrfList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VrfMulticast) DeepCopyInto(out *VrfMulticast) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VrfMulticast.
+func (in *VrfMulticast) DeepCopy() *VrfMulticast {
+	if in == nil {
+		return nil
+	}
+	out := new(VrfMulticast)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VrfMulticast) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VrfMulticastList) DeepCopyInto(out *VrfMulticastList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VrfMulticast, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VrfMulticastList.
+func (in *VrfMulticastList) DeepCopy() *VrfMulticastList {
+	if in == nil {
+		return nil
+	}
+	out := new(VrfMulticastList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VrfMulticastList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VrfMulticastObservation) DeepCopyInto(out *VrfMulticastObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VrfMulticastObservation.
+func (in *VrfMulticastObservation) DeepCopy() *VrfMulticastObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VrfMulticastObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VrfMulticastParameters) DeepCopyInto(out *VrfMulticastParameters) {
+	*out = *in
+	if in.Control != nil {
+		in, out := &in.Control, &out.Control
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StaticRPs != nil {
+		in, out := &in.StaticRPs, &out.StaticRPs
+		*out = make([]MulticastRP, len(*in))
+		copy(*out, *in)
+	}
+	if in.FabricRPs != nil {
+		in, out := &in.FabricRPs, &out.FabricRPs
+		*out = make([]MulticastRP, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VrfMulticastParameters.
+func (in *VrfMulticastParameters) DeepCopy() *VrfMulticastParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(VrfMulticastParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VrfMulticastSpec) DeepCopyInto(out *VrfMulticastSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VrfMulticastSpec.
+func (in *VrfMulticastSpec) DeepCopy() *VrfMulticastSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VrfMulticastSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VrfMulticastStatus) DeepCopyInto(out *VrfMulticastStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VrfMulticastStatus.
+func (in *VrfMulticastStatus) DeepCopy() *VrfMulticastStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VrfMulticastStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VrfObservation) DeepCopyInto(out *VrfObservation) {
 	*out = *in
@@ -225,6 +2461,17 @@ func (in *VrfObservation) DeepCopy() *VrfObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VrfParameters) DeepCopyInto(out *VrfParameters) {
 	*out = *in
+	if in.EigrpAddressFamilyPolicies != nil {
+		in, out := &in.EigrpAddressFamilyPolicies, &out.EigrpAddressFamilyPolicies
+		*out = make([]VrfEigrpAddressFamilyPolicy, len(*in))
+		copy(*out, *in)
+	}
+	in.VzAny.DeepCopyInto(&out.VzAny)
+	if in.SecurityDomains != nil {
+		in, out := &in.SecurityDomains, &out.SecurityDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VrfParameters.
@@ -241,7 +2488,7 @@ func (in *VrfParameters) DeepCopy() *VrfParameters {
 func (in *VrfSpec) DeepCopyInto(out *VrfSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	out.ForProvider = in.ForProvider
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VrfSpec.
@@ -270,3 +2517,28 @@ func (in *VrfStatus) DeepCopy() *VrfStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VrfVzAny) DeepCopyInto(out *VrfVzAny) {
+	*out = *in
+	if in.ProvidedContracts != nil {
+		in, out := &in.ProvidedContracts, &out.ProvidedContracts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConsumedContracts != nil {
+		in, out := &in.ConsumedContracts, &out.ConsumedContracts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VrfVzAny.
+func (in *VrfVzAny) DeepCopy() *VrfVzAny {
+	if in == nil {
+		return nil
+	}
+	out := new(VrfVzAny)
+	in.DeepCopyInto(out)
+	return out
+}
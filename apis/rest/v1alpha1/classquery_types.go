@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ClassQueryParameters are the configurable fields of a ClassQuery.
+type ClassQueryParameters struct {
+	ClassName string `json:"className"`
+	// Filter is the query-target-filter of the query, e.g.
+	// eq(fabricNode.role,"leaf").
+	// +kubebuilder:validation:Optional
+	Filter string `json:"filter"`
+	// QueryTarget is the scope of the query relative to the objects of the
+	// class.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=self;children;subtree
+	// +kubebuilder:default=self
+	QueryTarget string `json:"queryTarget"`
+	// TargetSubtreeClass are the classes of the children or subtree objects
+	// returned by the query.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	TargetSubtreeClass []string `json:"targetSubtreeClass,omitempty"`
+	// Attributes are the attributes of the objects surfaced in the results.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Attributes []string `json:"attributes"`
+	// MaxResults is the maximum number of objects surfaced in the results.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=500
+	// +kubebuilder:default=100
+	MaxResults int `json:"maxResults"`
+}
+
+// ClassQueryObservation are the observable fields of a ClassQuery.
+type ClassQueryObservation struct {
+	// TotalCount is the number of objects matching the query, including
+	// the ones beyond MaxResults.
+	TotalCount int `json:"totalCount,omitempty"`
+	// Results are the attributes of the objects returned by the query,
+	// sorted by DN.
+	Results []map[string]string `json:"results,omitempty"`
+}
+
+// A ClassQuerySpec defines the desired state of a ClassQuery.
+type ClassQuerySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClassQueryParameters `json:"forProvider"`
+}
+
+// A ClassQueryStatus represents the observed state of a ClassQuery.
+type ClassQueryStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ClassQueryObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ClassQuery is an observe-only query of the objects of an APIC class. Its
+// results are surfaced in its status and connection details.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="COUNT",type="integer",JSONPath=".status.atProvider.totalCount",description="Number of matching objects"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type ClassQuery struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClassQuerySpec   `json:"spec"`
+	Status ClassQueryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClassQueryList contains a list of ClassQuery
+type ClassQueryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClassQuery `json:"items"`
+}
+
+// ClassQuery type metadata.
+var (
+	ClassQueryKind             = reflect.TypeOf(ClassQuery{}).Name()
+	ClassQueryGroupKind        = schema.GroupKind{Group: Group, Kind: ClassQueryKind}.String()
+	ClassQueryKindAPIVersion   = ClassQueryKind + "." + SchemeGroupVersion.String()
+	ClassQueryGroupVersionKind = SchemeGroupVersion.WithKind(ClassQueryKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ClassQuery{}, &ClassQueryList{})
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A ManagedObjectChild is a child object of a ManagedObject. Children have
+// no children of their own: only one level of nesting is supported.
+type ManagedObjectChild struct {
+	ClassName string `json:"className"`
+	// Rn is the RN of the child, relative to the DN of the ManagedObject.
+	Rn string `json:"rn"`
+	// +kubebuilder:validation:Optional
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// ManagedObjectParameters are the configurable fields of a ManagedObject.
+// The object is identified either by its DN or by the DN of its parent and
+// its RN.
+type ManagedObjectParameters struct {
+	ClassName string `json:"className"`
+	// +kubebuilder:validation:Optional
+	Dn string `json:"dn"`
+	// +kubebuilder:validation:Optional
+	ParentDn string `json:"parentDn"`
+	// +kubebuilder:validation:Optional
+	Rn string `json:"rn"`
+	// Attributes are the attributes of the object. Only these attributes
+	// are compared with the ones of the APIC, the attributes populated by
+	// the APIC are ignored.
+	// +kubebuilder:validation:Optional
+	Attributes map[string]string `json:"attributes,omitempty"`
+	// Children are created or updated with the object. A child removed
+	// from the list is not deleted from the APIC, it is deleted with the
+	// object.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=rn
+	Children []ManagedObjectChild `json:"children,omitempty"`
+}
+
+// ManagedObjectObservation are the observable fields of a ManagedObject.
+type ManagedObjectObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A ManagedObjectSpec defines the desired state of a ManagedObject.
+type ManagedObjectSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ManagedObjectParameters `json:"forProvider"`
+}
+
+// A ManagedObjectStatus represents the observed state of a ManagedObject.
+type ManagedObjectStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ManagedObjectObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ManagedObject is an object of an arbitrary APIC class, for the classes
+// without a dedicated kind.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type ManagedObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ManagedObjectSpec   `json:"spec"`
+	Status ManagedObjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ManagedObjectList contains a list of ManagedObject
+type ManagedObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ManagedObject `json:"items"`
+}
+
+// ManagedObject type metadata.
+var (
+	ManagedObjectKind             = reflect.TypeOf(ManagedObject{}).Name()
+	ManagedObjectGroupKind        = schema.GroupKind{Group: Group, Kind: ManagedObjectKind}.String()
+	ManagedObjectKindAPIVersion   = ManagedObjectKind + "." + SchemeGroupVersion.String()
+	ManagedObjectGroupVersionKind = SchemeGroupVersion.WithKind(ManagedObjectKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ManagedObject{}, &ManagedObjectList{})
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A DeviceSelectionInterface selects the logical interface and bridge
+// domain of a connector of the node (vnsLIfCtx).
+type DeviceSelectionInterface struct {
+	// +kubebuilder:validation:Enum=consumer;provider
+	Connector string `json:"connector"`
+	// LogicalInterface is the name of the logical interface of the device
+	// (vnsRsLIfCtxToLIf).
+	LogicalInterface string `json:"logicalInterface"`
+	// BridgeDomain is the name of the bridge domain of the tenant the
+	// connector is attached to (vnsRsLIfCtxToBD).
+	// +kubebuilder:validation:Optional
+	BridgeDomain string `json:"bridgeDomain"`
+	// RedirectPolicy is the name of the policy-based redirect policy of the
+	// tenant (vnsRsLIfCtxToSvcRedirectPol).
+	// +kubebuilder:validation:Optional
+	RedirectPolicy string `json:"redirectPolicy"`
+}
+
+// DeviceSelectionPolicyParameters are the configurable fields of a DeviceSelectionPolicy.
+type DeviceSelectionPolicyParameters struct {
+	Tenant string `json:"tenant"`
+	// Contract is the name of the contract of the tenant, or any.
+	Contract string `json:"contract"`
+	// Graph is the name of the service graph template, or any.
+	Graph string `json:"graph"`
+	// Node is the name of the function node of the graph, or any.
+	Node string `json:"node"`
+	// Device is the name of the L4L7Device of the tenant
+	// (vnsRsLDevCtxToLDev).
+	Device string `json:"device"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=connector
+	Interfaces []DeviceSelectionInterface `json:"interfaces,omitempty"`
+}
+
+// DeviceSelectionPolicyObservation are the observable fields of a DeviceSelectionPolicy.
+type DeviceSelectionPolicyObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A DeviceSelectionPolicySpec defines the desired state of a DeviceSelectionPolicy.
+type DeviceSelectionPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DeviceSelectionPolicyParameters `json:"forProvider"`
+}
+
+// A DeviceSelectionPolicyStatus represents the observed state of a DeviceSelectionPolicy.
+type DeviceSelectionPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DeviceSelectionPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DeviceSelectionPolicy selects the L4L7Device rendering a node of a
+// service graph for a contract (vnsLDevCtx).
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type DeviceSelectionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DeviceSelectionPolicySpec   `json:"spec"`
+	Status DeviceSelectionPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DeviceSelectionPolicyList contains a list of DeviceSelectionPolicy
+type DeviceSelectionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeviceSelectionPolicy `json:"items"`
+}
+
+// DeviceSelectionPolicy type metadata.
+var (
+	DeviceSelectionPolicyKind             = reflect.TypeOf(DeviceSelectionPolicy{}).Name()
+	DeviceSelectionPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: DeviceSelectionPolicyKind}.String()
+	DeviceSelectionPolicyKindAPIVersion   = DeviceSelectionPolicyKind + "." + SchemeGroupVersion.String()
+	DeviceSelectionPolicyGroupVersionKind = SchemeGroupVersion.WithKind(DeviceSelectionPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&DeviceSelectionPolicy{}, &DeviceSelectionPolicyList{})
+}
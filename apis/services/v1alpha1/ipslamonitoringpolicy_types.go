@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// IPSLAMonitoringPolicyParameters are the configurable fields of a IPSLAMonitoringPolicy.
+type IPSLAMonitoringPolicyParameters struct {
+	Name   string `json:"name"`
+	Tenant string `json:"tenant"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=icmp;tcp;l2ping;http
+	// +kubebuilder:default=icmp
+	SlaType string `json:"slaType"`
+	// SlaPort is the destination port of tcp probes.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="0"
+	SlaPort string `json:"slaPort"`
+	// SlaFrequency is the interval between probes in seconds.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="60"
+	SlaFrequency string `json:"slaFrequency"`
+	// DetectMultiplier is the number of missed probes after which the
+	// destination is down.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="3"
+	DetectMultiplier string `json:"detectMultiplier"`
+	// Threshold is the round-trip time threshold in milliseconds.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="900"
+	Threshold string `json:"threshold"`
+	// Timeout is the time to wait for a response in milliseconds.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="900"
+	Timeout string `json:"timeout"`
+}
+
+// IPSLAMonitoringPolicyObservation are the observable fields of a IPSLAMonitoringPolicy.
+type IPSLAMonitoringPolicyObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A IPSLAMonitoringPolicySpec defines the desired state of a IPSLAMonitoringPolicy.
+type IPSLAMonitoringPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IPSLAMonitoringPolicyParameters `json:"forProvider"`
+}
+
+// A IPSLAMonitoringPolicyStatus represents the observed state of a IPSLAMonitoringPolicy.
+type IPSLAMonitoringPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IPSLAMonitoringPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IPSLAMonitoringPolicy is an IP SLA monitoring policy
+// (fvIPSLAMonitoringPol).
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type IPSLAMonitoringPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IPSLAMonitoringPolicySpec   `json:"spec"`
+	Status IPSLAMonitoringPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IPSLAMonitoringPolicyList contains a list of IPSLAMonitoringPolicy
+type IPSLAMonitoringPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IPSLAMonitoringPolicy `json:"items"`
+}
+
+// IPSLAMonitoringPolicy type metadata.
+var (
+	IPSLAMonitoringPolicyKind             = reflect.TypeOf(IPSLAMonitoringPolicy{}).Name()
+	IPSLAMonitoringPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: IPSLAMonitoringPolicyKind}.String()
+	IPSLAMonitoringPolicyKindAPIVersion   = IPSLAMonitoringPolicyKind + "." + SchemeGroupVersion.String()
+	IPSLAMonitoringPolicyGroupVersionKind = SchemeGroupVersion.WithKind(IPSLAMonitoringPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&IPSLAMonitoringPolicy{}, &IPSLAMonitoringPolicyList{})
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A ConcreteInterface is an interface (vnsCIf) of a concrete device.
+type ConcreteInterface struct {
+	Name string `json:"name"`
+	// PathDn is the DN of the fabric path the interface is connected to
+	// (vnsRsCIfPathAtt), e.g. topology/pod-1/paths-101/pathep-[eth1/1].
+	// +kubebuilder:validation:Optional
+	PathDn string `json:"pathDn"`
+	// VnicName is the name of the VM vNIC of a virtual device.
+	// +kubebuilder:validation:Optional
+	VnicName string `json:"vnicName"`
+}
+
+// A ConcreteDevice is a member (vnsCDev) of a logical device.
+type ConcreteDevice struct {
+	Name string `json:"name"`
+	// VMName is the name of the VM of a virtual device.
+	// +kubebuilder:validation:Optional
+	VMName string `json:"vmName"`
+	// VCenterName is the name of the vCenter hosting the VM of a virtual
+	// device.
+	// +kubebuilder:validation:Optional
+	VCenterName string `json:"vcenterName"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	Interfaces []ConcreteInterface `json:"interfaces,omitempty"`
+}
+
+// A LogicalInterface is a cluster interface (vnsLIf) of a logical device.
+type LogicalInterface struct {
+	Name string `json:"name"`
+	// Encap is the encapsulation of the interface, e.g. vlan-100.
+	// +kubebuilder:validation:Optional
+	Encap string `json:"encap"`
+	// ConcreteInterfaces are the concrete interfaces (vnsRsCIfAttN) of the
+	// logical interface, in the device/interface form.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	ConcreteInterfaces []string `json:"concreteInterfaces,omitempty"`
+}
+
+// L4L7DeviceParameters are the configurable fields of a L4L7Device.
+type L4L7DeviceParameters struct {
+	Name   string `json:"name"`
+	Tenant string `json:"tenant"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=PHYSICAL;VIRTUAL
+	// +kubebuilder:default=PHYSICAL
+	DeviceType string `json:"deviceType"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=FW;ADC;OTHERS
+	// +kubebuilder:default=OTHERS
+	ServiceType string `json:"serviceType"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=GoTo;GoThrough;L1;L2
+	// +kubebuilder:default=GoTo
+	FunctionType string `json:"functionType"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=single-Context;multi-Context
+	// +kubebuilder:default=single-Context
+	ContextAware string `json:"contextAware"`
+	// Domain is the name of the physical domain of a PHYSICAL device, or of
+	// the VMware VMM domain of a VIRTUAL device.
+	Domain string `json:"domain"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	ConcreteDevices []ConcreteDevice `json:"concreteDevices,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	LogicalInterfaces []LogicalInterface `json:"logicalInterfaces,omitempty"`
+}
+
+// L4L7DeviceObservation are the observable fields of a L4L7Device.
+type L4L7DeviceObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A L4L7DeviceSpec defines the desired state of a L4L7Device.
+type L4L7DeviceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       L4L7DeviceParameters `json:"forProvider"`
+}
+
+// A L4L7DeviceStatus represents the observed state of a L4L7Device.
+type L4L7DeviceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          L4L7DeviceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A L4L7Device is an unmanaged L4-L7 logical device (vnsLDevVip).
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type L4L7Device struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   L4L7DeviceSpec   `json:"spec"`
+	Status L4L7DeviceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// L4L7DeviceList contains a list of L4L7Device
+type L4L7DeviceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []L4L7Device `json:"items"`
+}
+
+// L4L7Device type metadata.
+var (
+	L4L7DeviceKind             = reflect.TypeOf(L4L7Device{}).Name()
+	L4L7DeviceGroupKind        = schema.GroupKind{Group: Group, Kind: L4L7DeviceKind}.String()
+	L4L7DeviceKindAPIVersion   = L4L7DeviceKind + "." + SchemeGroupVersion.String()
+	L4L7DeviceGroupVersionKind = SchemeGroupVersion.WithKind(L4L7DeviceKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&L4L7Device{}, &L4L7DeviceList{})
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A RedirectDestination is a L3 destination (vnsRedirectDest) of a redirect
+// policy.
+type RedirectDestination struct {
+	IP  string `json:"ip"`
+	MAC string `json:"mac"`
+	// IP2 is the secondary IP address of the destination.
+	// +kubebuilder:validation:Optional
+	IP2 string `json:"ip2"`
+	// +kubebuilder:validation:Optional
+	DestinationName string `json:"destinationName"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="1"
+	PodID string `json:"podId"`
+}
+
+// RedirectPolicyParameters are the configurable fields of a RedirectPolicy.
+type RedirectPolicyParameters struct {
+	Name   string `json:"name"`
+	Tenant string `json:"tenant"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=sip-dip-prototype;sip;dip
+	// +kubebuilder:default=sip-dip-prototype
+	HashingAlgorithm string `json:"hashingAlgorithm"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=no
+	ThresholdEnable string `json:"thresholdEnable"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="0"
+	MinThresholdPercent string `json:"minThresholdPercent"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="0"
+	MaxThresholdPercent string `json:"maxThresholdPercent"`
+	// ThresholdDownAction is the action taken when the threshold is crossed.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=permit;deny;bypass
+	// +kubebuilder:default=permit
+	ThresholdDownAction string `json:"thresholdDownAction"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=yes;no
+	// +kubebuilder:default=no
+	ResilientHashEnabled string `json:"resilientHashEnabled"`
+	// IPSLAMonitoringPolicy is the name of the IP SLA monitoring policy of
+	// the tenant tracking the destinations (vnsRsIPSLAMonitoringPol).
+	// +kubebuilder:validation:Optional
+	IPSLAMonitoringPolicy string `json:"ipslaMonitoringPolicy"`
+	// Destinations are reconciled as a set keyed by IP.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=ip
+	Destinations []RedirectDestination `json:"destinations,omitempty"`
+}
+
+// RedirectPolicyObservation are the observable fields of a RedirectPolicy.
+type RedirectPolicyObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A RedirectPolicySpec defines the desired state of a RedirectPolicy.
+type RedirectPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RedirectPolicyParameters `json:"forProvider"`
+}
+
+// A RedirectPolicyStatus represents the observed state of a RedirectPolicy.
+type RedirectPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RedirectPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RedirectPolicy is a policy-based redirect policy (vnsSvcRedirectPol).
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type RedirectPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RedirectPolicySpec   `json:"spec"`
+	Status RedirectPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RedirectPolicyList contains a list of RedirectPolicy
+type RedirectPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RedirectPolicy `json:"items"`
+}
+
+// RedirectPolicy type metadata.
+var (
+	RedirectPolicyKind             = reflect.TypeOf(RedirectPolicy{}).Name()
+	RedirectPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: RedirectPolicyKind}.String()
+	RedirectPolicyKindAPIVersion   = RedirectPolicyKind + "." + SchemeGroupVersion.String()
+	RedirectPolicyGroupVersionKind = SchemeGroupVersion.WithKind(RedirectPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&RedirectPolicy{}, &RedirectPolicyList{})
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A ServiceNode is a function node (vnsAbsNode) of a service graph
+// template.
+type ServiceNode struct {
+	Name string `json:"name"`
+	// Device is the name of the L4L7Device of the tenant rendering the node
+	// (vnsRsNodeToLDev).
+	Device string `json:"device"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=GoTo;GoThrough;L1;L2
+	// +kubebuilder:default=GoTo
+	FunctionType string `json:"functionType"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=FW_ROUTED;FW_TRANS;ADC_ONE_ARM;ADC_TWO_ARM;OTHER
+	// +kubebuilder:default=OTHER
+	FunctionTemplateType string `json:"functionTemplateType"`
+	// RoutingMode is Redirect for policy-based redirect.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Redirect;unspecified
+	// +kubebuilder:default=unspecified
+	RoutingMode string `json:"routingMode"`
+}
+
+// A ContractSubjectRef identifies a subject of a contract of the tenant.
+type ContractSubjectRef struct {
+	Contract string `json:"contract"`
+	Subject  string `json:"subject"`
+}
+
+// ServiceGraphTemplateParameters are the configurable fields of a ServiceGraphTemplate.
+type ServiceGraphTemplateParameters struct {
+	Name   string `json:"name"`
+	Tenant string `json:"tenant"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// Nodes are the function nodes of the graph, in the order traffic from
+	// the consumer traverses them. The connections (vnsAbsConnection) between
+	// the consumer, the nodes and the provider are derived from this order.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=2
+	Nodes []ServiceNode `json:"nodes"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=L2;L3
+	// +kubebuilder:default=L2
+	AdjacencyType string `json:"adjacencyType"`
+	// ContractSubjects are the contract subjects the graph is attached to
+	// (vzRsSubjGraphAtt). They are reconciled as a whole.
+	// +kubebuilder:validation:Optional
+	ContractSubjects []ContractSubjectRef `json:"contractSubjects,omitempty"`
+}
+
+// ServiceGraphTemplateObservation are the observable fields of a ServiceGraphTemplate.
+type ServiceGraphTemplateObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A ServiceGraphTemplateSpec defines the desired state of a ServiceGraphTemplate.
+type ServiceGraphTemplateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceGraphTemplateParameters `json:"forProvider"`
+}
+
+// A ServiceGraphTemplateStatus represents the observed state of a ServiceGraphTemplate.
+type ServiceGraphTemplateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceGraphTemplateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceGraphTemplate is a one-node or two-node L4-L7 service graph
+// template (vnsAbsGraph).
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type ServiceGraphTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceGraphTemplateSpec   `json:"spec"`
+	Status ServiceGraphTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceGraphTemplateList contains a list of ServiceGraphTemplate
+type ServiceGraphTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceGraphTemplate `json:"items"`
+}
+
+// ServiceGraphTemplate type metadata.
+var (
+	ServiceGraphTemplateKind             = reflect.TypeOf(ServiceGraphTemplate{}).Name()
+	ServiceGraphTemplateGroupKind        = schema.GroupKind{Group: Group, Kind: ServiceGraphTemplateKind}.String()
+	ServiceGraphTemplateKindAPIVersion   = ServiceGraphTemplateKind + "." + SchemeGroupVersion.String()
+	ServiceGraphTemplateGroupVersionKind = SchemeGroupVersion.WithKind(ServiceGraphTemplateKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ServiceGraphTemplate{}, &ServiceGraphTemplateList{})
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TrackListParameters are the configurable fields of a TrackList.
+type TrackListParameters struct {
+	Name   string `json:"name"`
+	Tenant string `json:"tenant"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=percentage;weight
+	// +kubebuilder:default=percentage
+	Type string `json:"type"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="1"
+	PercentageUp string `json:"percentageUp"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="0"
+	PercentageDown string `json:"percentageDown"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="1"
+	WeightUp string `json:"weightUp"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="0"
+	WeightDown string `json:"weightDown"`
+	// Members are the names of the TrackMembers of the tenant in the list
+	// (fvRsOtmListMember).
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Members []string `json:"members,omitempty"`
+}
+
+// TrackListObservation are the observable fields of a TrackList.
+type TrackListObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A TrackListSpec defines the desired state of a TrackList.
+type TrackListSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TrackListParameters `json:"forProvider"`
+}
+
+// A TrackListStatus represents the observed state of a TrackList.
+type TrackListStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TrackListObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TrackList groups track members into a single up or down state
+// (fvTrackList).
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type TrackList struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TrackListSpec   `json:"spec"`
+	Status TrackListStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TrackListList contains a list of TrackList
+type TrackListList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TrackList `json:"items"`
+}
+
+// TrackList type metadata.
+var (
+	TrackListKind             = reflect.TypeOf(TrackList{}).Name()
+	TrackListGroupKind        = schema.GroupKind{Group: Group, Kind: TrackListKind}.String()
+	TrackListKindAPIVersion   = TrackListKind + "." + SchemeGroupVersion.String()
+	TrackListGroupVersionKind = SchemeGroupVersion.WithKind(TrackListKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&TrackList{}, &TrackListList{})
+}
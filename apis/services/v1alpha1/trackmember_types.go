@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TrackMemberParameters are the configurable fields of a TrackMember.
+type TrackMemberParameters struct {
+	Name   string `json:"name"`
+	Tenant string `json:"tenant"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// DestinationIP is the IP address tracked.
+	DestinationIP string `json:"destinationIp"`
+	// BridgeDomain is the name of the bridge domain of the tenant the IP
+	// address is reached through. Exactly one of BridgeDomain and L3Out is
+	// required.
+	// +kubebuilder:validation:Optional
+	BridgeDomain string `json:"bridgeDomain"`
+	// L3Out is the name of the L3Out of the tenant the IP address is
+	// reached through.
+	// +kubebuilder:validation:Optional
+	L3Out string `json:"l3Out"`
+	// IPSLAMonitoringPolicy is the name of the IP SLA monitoring policy of
+	// the tenant (fvRsIpslaMonPol).
+	IPSLAMonitoringPolicy string `json:"ipslaMonitoringPolicy"`
+}
+
+// TrackMemberObservation are the observable fields of a TrackMember.
+type TrackMemberObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A TrackMemberSpec defines the desired state of a TrackMember.
+type TrackMemberSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TrackMemberParameters `json:"forProvider"`
+}
+
+// A TrackMemberStatus represents the observed state of a TrackMember.
+type TrackMemberStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TrackMemberObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TrackMember is an IP address tracked with an IP SLA policy
+// (fvTrackMember).
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type TrackMember struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TrackMemberSpec   `json:"spec"`
+	Status TrackMemberStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TrackMemberList contains a list of TrackMember
+type TrackMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TrackMember `json:"items"`
+}
+
+// TrackMember type metadata.
+var (
+	TrackMemberKind             = reflect.TypeOf(TrackMember{}).Name()
+	TrackMemberGroupKind        = schema.GroupKind{Group: Group, Kind: TrackMemberKind}.String()
+	TrackMemberKindAPIVersion   = TrackMemberKind + "." + SchemeGroupVersion.String()
+	TrackMemberGroupVersionKind = SchemeGroupVersion.WithKind(TrackMemberKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&TrackMember{}, &TrackMemberList{})
+}
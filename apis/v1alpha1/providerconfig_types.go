@@ -29,6 +29,28 @@ import (
 type ProviderConfigSpec struct {
 	// Credentials required to authenticate to this provider.
 	Credentials ProviderCredentials `json:"credentials"`
+
+	// Snapshot configures the tenant snapshots taken before destructive
+	// changes.
+	// +optional
+	Snapshot *SnapshotConfig `json:"snapshot,omitempty"`
+}
+
+// SnapshotConfig configures the tenant snapshots taken before destructive
+// changes.
+type SnapshotConfig struct {
+	// BeforeChanges takes a snapshot of the tenant before a tenant-scoped
+	// resource is deleted, or updated in a way that changes one of its
+	// relations. The change is only made once the snapshot succeeded.
+	// +optional
+	BeforeChanges bool `json:"beforeChanges,omitempty"`
+
+	// Timeout is how long to wait for a snapshot to complete. The change is
+	// retried by each reconcile while the snapshot is in progress, without
+	// triggering another snapshot.
+	// +kubebuilder:default="5m"
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
@@ -0,0 +1,145 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// KubernetesVMMController is the controller (vmmCtrlrP) that represents the
+// cluster inside a KubernetesVMMDomain.
+type KubernetesVMMController struct {
+	Name string `json:"name"`
+	// HostOrIP is the address of the Kubernetes API server.
+	HostOrIP string `json:"hostOrIp"`
+	// ClusterName is the name of the cluster as known by the ACI CNI
+	// (rootContName).
+	ClusterName string `json:"clusterName"`
+}
+
+// A ClusterSubnet is a gateway subnet (fvSubnet) of a bridge domain used by
+// the cluster.
+type ClusterSubnet struct {
+	Tenant       string `json:"tenant"`
+	BridgeDomain string `json:"bridgeDomain"`
+	// Gateway is the gateway address of the subnet in CIDR notation,
+	// e.g. 10.1.0.1/16.
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F:.]+/[0-9]{1,3}$`
+	Gateway string `json:"gateway"`
+}
+
+// KubernetesVMMDomainParameters are the configurable fields of a
+// KubernetesVMMDomain.
+type KubernetesVMMDomainParameters struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	NameAlias string `json:"nameAlias"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=vxlan;vlan
+	// +kubebuilder:default=vxlan
+	EncapMode string `json:"encapMode"`
+	// VlanPool is the name of the VLAN pool (fvnsVlanInstP) the domain
+	// allocates encapsulations from.
+	// +kubebuilder:validation:Optional
+	VlanPool string `json:"vlanPool"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=dynamic;static
+	// +kubebuilder:default=dynamic
+	VlanPoolAllocationMode string `json:"vlanPoolAllocationMode"`
+	// MulticastPool is the name of the multicast address pool
+	// (fvnsMcastAddrInstP) used for the VXLAN encapsulation.
+	// +kubebuilder:validation:Optional
+	MulticastPool string `json:"multicastPool"`
+	// MulticastAddress is the multicast address of the domain.
+	// +kubebuilder:validation:Optional
+	MulticastAddress string                  `json:"multicastAddress"`
+	Controller       KubernetesVMMController `json:"controller"`
+	// NodeSubnet is the subnet the cluster nodes are addressed from.
+	// +kubebuilder:validation:Optional
+	NodeSubnet *ClusterSubnet `json:"nodeSubnet,omitempty"`
+	// ServiceSubnet is the subnet used for the service graph of the
+	// cluster load balancer.
+	// +kubebuilder:validation:Optional
+	ServiceSubnet *ClusterSubnet `json:"serviceSubnet,omitempty"`
+}
+
+// KubernetesVMMDomainObservation are the observable fields of a
+// KubernetesVMMDomain.
+type KubernetesVMMDomainObservation struct {
+	Dn string `json:"dn,omitempty"`
+	// NodeSubnetDn and ServiceSubnetDn are the DNs of the subnets last
+	// configured, so that they can be removed when the spec changes.
+	NodeSubnetDn    string `json:"nodeSubnetDn,omitempty"`
+	ServiceSubnetDn string `json:"serviceSubnetDn,omitempty"`
+}
+
+// A KubernetesVMMDomainSpec defines the desired state of a KubernetesVMMDomain.
+type KubernetesVMMDomainSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KubernetesVMMDomainParameters `json:"forProvider"`
+}
+
+// A KubernetesVMMDomainStatus represents the observed state of a KubernetesVMMDomain.
+type KubernetesVMMDomainStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KubernetesVMMDomainObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A KubernetesVMMDomain is a Kubernetes VMM domain (vmmDomP) together with the
+// controller and the fabric subnets of the cluster it integrates.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type KubernetesVMMDomain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KubernetesVMMDomainSpec   `json:"spec"`
+	Status KubernetesVMMDomainStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KubernetesVMMDomainList contains a list of KubernetesVMMDomain
+type KubernetesVMMDomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KubernetesVMMDomain `json:"items"`
+}
+
+// KubernetesVMMDomain type metadata.
+var (
+	KubernetesVMMDomainKind             = reflect.TypeOf(KubernetesVMMDomain{}).Name()
+	KubernetesVMMDomainGroupKind        = schema.GroupKind{Group: Group, Kind: KubernetesVMMDomainKind}.String()
+	KubernetesVMMDomainKindAPIVersion   = KubernetesVMMDomainKind + "." + SchemeGroupVersion.String()
+	KubernetesVMMDomainGroupVersionKind = SchemeGroupVersion.WithKind(KubernetesVMMDomainKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&KubernetesVMMDomain{}, &KubernetesVMMDomainList{})
+}
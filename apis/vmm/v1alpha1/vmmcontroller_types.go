@@ -0,0 +1,106 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// VMMControllerParameters are the configurable fields of a VMMController.
+type VMMControllerParameters struct {
+	Name string `json:"name"`
+	// VMMDomain is the name of the VMware VMM domain the controller belongs to.
+	VMMDomain string `json:"vmmDomain"`
+	// HostOrIP is the hostname or IP address of the vCenter.
+	HostOrIP string `json:"hostOrIp"`
+	// Datacenter is the name of the vCenter datacenter (rootContName).
+	Datacenter string `json:"datacenter"`
+	// Credential is the name of the VMMCredential used to log in to vCenter.
+	// +kubebuilder:validation:Optional
+	Credential string `json:"credential"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=unmanaged;"5.1";"5.5";"6.0";"6.5";"6.6";"7.0"
+	// +kubebuilder:default=unmanaged
+	DvsVersion string `json:"dvsVersion"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=enabled;disabled;unknown
+	// +kubebuilder:default=disabled
+	StatsCollection string `json:"statsCollection"`
+	// +kubebuilder:validation:Optional
+	NameAlias string `json:"nameAlias"`
+}
+
+// VMMControllerObservation are the observable fields of a VMMController.
+type VMMControllerObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A VMMControllerSpec defines the desired state of a VMMController.
+type VMMControllerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VMMControllerParameters `json:"forProvider"`
+}
+
+// A VMMControllerStatus represents the observed state of a VMMController.
+type VMMControllerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VMMControllerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VMMController is a vCenter controller (vmmCtrlrP) of a VMMDomain.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type VMMController struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VMMControllerSpec   `json:"spec"`
+	Status VMMControllerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VMMControllerList contains a list of VMMController
+type VMMControllerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VMMController `json:"items"`
+}
+
+// VMMController type metadata.
+var (
+	VMMControllerKind             = reflect.TypeOf(VMMController{}).Name()
+	VMMControllerGroupKind        = schema.GroupKind{Group: Group, Kind: VMMControllerKind}.String()
+	VMMControllerKindAPIVersion   = VMMControllerKind + "." + SchemeGroupVersion.String()
+	VMMControllerGroupVersionKind = SchemeGroupVersion.WithKind(VMMControllerKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&VMMController{}, &VMMControllerList{})
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// VMMCredentialParameters are the configurable fields of a VMMCredential.
+type VMMCredentialParameters struct {
+	Name string `json:"name"`
+	// VMMDomain is the name of the VMware VMM domain the credential belongs to.
+	VMMDomain string `json:"vmmDomain"`
+	Username  string `json:"username"`
+	// PasswordSecretRef references the key of a Secret that holds the vCenter
+	// password. APIC never returns the password, so the version of the Secret
+	// is recorded and the password is pushed again when the Secret changes.
+	PasswordSecretRef xpv1.SecretKeySelector `json:"passwordSecretRef"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description"`
+	// +kubebuilder:validation:Optional
+	NameAlias string `json:"nameAlias"`
+}
+
+// VMMCredentialObservation are the observable fields of a VMMCredential.
+type VMMCredentialObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A VMMCredentialSpec defines the desired state of a VMMCredential.
+type VMMCredentialSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VMMCredentialParameters `json:"forProvider"`
+}
+
+// A VMMCredentialStatus represents the observed state of a VMMCredential.
+type VMMCredentialStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VMMCredentialObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VMMCredential is a vCenter account (vmmUsrAccP) of a VMMDomain.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type VMMCredential struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VMMCredentialSpec   `json:"spec"`
+	Status VMMCredentialStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VMMCredentialList contains a list of VMMCredential
+type VMMCredentialList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VMMCredential `json:"items"`
+}
+
+// VMMCredential type metadata.
+var (
+	VMMCredentialKind             = reflect.TypeOf(VMMCredential{}).Name()
+	VMMCredentialGroupKind        = schema.GroupKind{Group: Group, Kind: VMMCredentialKind}.String()
+	VMMCredentialKindAPIVersion   = VMMCredentialKind + "." + SchemeGroupVersion.String()
+	VMMCredentialGroupVersionKind = SchemeGroupVersion.WithKind(VMMCredentialKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&VMMCredential{}, &VMMCredentialList{})
+}
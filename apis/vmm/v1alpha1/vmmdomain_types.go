@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// EnhancedLagPolicy is an enhanced LACP policy (lacpEnhancedLagPol) of the
+// distributed switch managed through a VMMDomain.
+type EnhancedLagPolicy struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=active;passive
+	// +kubebuilder:default=active
+	Mode string `json:"mode"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=src-dst-ip
+	LoadBalancingMode string `json:"loadBalancingMode"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="2"
+	NumberOfLinks string `json:"numberOfLinks"`
+}
+
+// VMMDomainParameters are the configurable fields of a VMMDomain.
+type VMMDomainParameters struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	NameAlias string `json:"nameAlias"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=read-write;read-only
+	// +kubebuilder:default=read-write
+	AccessMode string `json:"accessMode"`
+	// VlanPool is the name of the VLAN pool (fvnsVlanInstP) the domain
+	// allocates port group encapsulations from.
+	// +kubebuilder:validation:Optional
+	VlanPool string `json:"vlanPool"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=dynamic;static
+	// +kubebuilder:default=dynamic
+	VlanPoolAllocationMode string `json:"vlanPoolAllocationMode"`
+	// EnhancedLagPolicies are reconciled as a whole: policies that are not
+	// listed here are removed from the domain.
+	// +kubebuilder:validation:Optional
+	EnhancedLagPolicies []EnhancedLagPolicy `json:"enhancedLagPolicies,omitempty"`
+}
+
+// VMMDomainObservation are the observable fields of a VMMDomain.
+type VMMDomainObservation struct {
+	Dn string `json:"dn,omitempty"`
+}
+
+// A VMMDomainSpec defines the desired state of a VMMDomain.
+type VMMDomainSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VMMDomainParameters `json:"forProvider"`
+}
+
+// A VMMDomainStatus represents the observed state of a VMMDomain.
+type VMMDomainStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VMMDomainObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VMMDomain is a VMware vSphere VMM domain (vmmDomP).
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="DN",type="string",JSONPath=".status.atProvider.dn",description="Distinguished Name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aci}
+type VMMDomain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VMMDomainSpec   `json:"spec"`
+	Status VMMDomainStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VMMDomainList contains a list of VMMDomain
+type VMMDomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VMMDomain `json:"items"`
+}
+
+// VMMDomain type metadata.
+var (
+	VMMDomainKind             = reflect.TypeOf(VMMDomain{}).Name()
+	VMMDomainGroupKind        = schema.GroupKind{Group: Group, Kind: VMMDomainKind}.String()
+	VMMDomainKindAPIVersion   = VMMDomainKind + "." + SchemeGroupVersion.String()
+	VMMDomainGroupVersionKind = SchemeGroupVersion.WithKind(VMMDomainKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&VMMDomain{}, &VMMDomainList{})
+}
@@ -40,6 +40,7 @@ import (
 	"github.com/jgomezve/provider-aci/apis/v1alpha1"
 	aci "github.com/jgomezve/provider-aci/internal/controller"
 	"github.com/jgomezve/provider-aci/internal/features"
+	"github.com/jgomezve/provider-aci/internal/subscription"
 )
 
 func main() {
@@ -55,6 +56,8 @@ func main() {
 		namespace                  = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
 		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
 		enableManagementPolicies   = app.Flag("enable-management-policies", "Enable support for Management Policies.").Default("false").Envar("ENABLE_MANAGEMENT_POLICIES").Bool()
+		enableSubscriptions        = app.Flag("enable-subscriptions", "Enable reconciling resources when their APIC objects change, using APIC websocket subscriptions.").Default("false").Envar("ENABLE_SUBSCRIPTIONS").Bool()
+		subscriptionPollInterval   = app.Flag("subscription-poll", "How often individual resources with a healthy APIC subscription will be checked for drift from the desired state.").Default("30m").Duration()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -121,6 +124,12 @@ func main() {
 		log.Info("Alpha feature enabled", "flag", features.EnableAlphaManagementPolicies)
 	}
 
+	if *enableSubscriptions {
+		o.Features.Enable(features.EnableAlphaSubscriptions)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaSubscriptions)
+		kingpin.FatalIfError(subscription.Setup(mgr, o, *subscriptionPollInterval), "Cannot setup APIC subscriptions")
+	}
+
 	kingpin.FatalIfError(aci.Setup(mgr, o), "Cannot setup Aci controllers")
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 }
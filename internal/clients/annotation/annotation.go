@@ -0,0 +1,27 @@
+package annotation
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Persist adds the supplied annotations to mg and updates mg right away,
+// along with the annotations already removed from mg, like the critical
+// annotations of the managed reconciler, so that they are not lost if the
+// status of mg is. The update is made on a copy of mg, so that the status of
+// mg that is yet to be persisted is kept.
+func Persist(ctx context.Context, kube client.Client, mg resource.Managed, annotations map[string]string) error {
+	meta.AddAnnotations(mg, annotations)
+	u, ok := mg.DeepCopyObject().(client.Object)
+	if !ok {
+		return nil
+	}
+	if err := kube.Update(ctx, u); err != nil {
+		return err
+	}
+	mg.SetResourceVersion(u.GetResourceVersion())
+	return nil
+}
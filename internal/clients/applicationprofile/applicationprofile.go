@@ -3,13 +3,15 @@ package applicationprofile
 import (
 	"github.com/ciscoecosystem/aci-go-client/v2/models"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/jgomezve/provider-aci/apis/application-management/v1alpha1"
 )
 
-func IsUptoDate(s v1alpha1.ApplicationProfileParameters, t models.ApplicationProfileAttributes) bool {
+func IsUptoDate(s v1alpha1.ApplicationProfileParameters, t models.ApplicationProfileAttributes, securityDomains []string) bool {
 	observed := &v1alpha1.ApplicationProfileParameters{
-		Tenant:    s.Tenant,
-		NameAlias: t.NameAlias,
+		Tenant:          s.Tenant,
+		NameAlias:       t.NameAlias,
+		SecurityDomains: securityDomains,
 	}
-	return cmp.Equal(observed, &s)
+	return cmp.Equal(observed, &s, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(x, y string) bool { return x < y }))
 }
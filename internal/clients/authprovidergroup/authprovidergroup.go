@@ -0,0 +1,82 @@
+package authprovidergroup
+
+import (
+	"fmt"
+	"strconv"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const aaaProviderRefClassName = "aaaProviderRef"
+
+// ClassName returns the class of the provider group of the supplied
+// protocol.
+func ClassName(protocol string) string {
+	switch protocol {
+	case "ldap":
+		return "aaaLdapProviderGroup"
+	case "radius":
+		return "aaaRadiusProviderGroup"
+	default:
+		return "aaaTacacsPlusProviderGroup"
+	}
+}
+
+// GroupDn returns the DN of the provider group of the supplied protocol with
+// the supplied name.
+func GroupDn(protocol, name string) string {
+	switch protocol {
+	case "ldap":
+		return fmt.Sprintf("uni/userext/ldapext/ldapprovidergroup-%s", name)
+	case "radius":
+		return fmt.Sprintf("uni/userext/radiusext/radiusprovidergroup-%s", name)
+	default:
+		return fmt.Sprintf("uni/userext/tacacsext/tacacsplusprovidergroup-%s", name)
+	}
+}
+
+// NewProviderRef returns the aaaProviderRef r of the group with the supplied
+// DN.
+func NewProviderRef(groupDn string, r v1alpha1.ProviderRef) *mo.Object {
+	return mo.NewObject(aaaProviderRefClassName, fmt.Sprintf("%s/providerref-%s", groupDn, r.Name), map[string]string{
+		"name":  r.Name,
+		"order": strconv.Itoa(r.Order),
+	})
+}
+
+// ReconcileProviders converges the members of the group with the supplied
+// DN.
+func ReconcileProviders(a *aciclient.Client, groupDn string, p v1alpha1.AuthProviderGroupParameters) error {
+	refs := make([]*mo.Object, 0, len(p.Providers))
+	for _, r := range p.Providers {
+		refs = append(refs, NewProviderRef(groupDn, r))
+	}
+	return mo.ReconcileChildren(a, groupDn, aaaProviderRefClassName, refs)
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.AuthProviderGroup, t map[string]string) bool {
+
+	refs, err := mo.ReadChildren(a, t["dn"], aaaProviderRefClassName)
+	if err != nil {
+		return false
+	}
+	var providers []v1alpha1.ProviderRef
+	for _, r := range refs {
+		order, _ := strconv.Atoi(r["order"])
+		providers = append(providers, v1alpha1.ProviderRef{Name: r["name"], Order: order})
+	}
+
+	observed := &v1alpha1.AuthProviderGroupParameters{
+		Name:        t["name"],
+		Description: t["descr"],
+		Protocol:    s.Spec.ForProvider.Protocol,
+		Providers:   providers,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(x, y v1alpha1.ProviderRef) bool { return x.Name < y.Name }))
+}
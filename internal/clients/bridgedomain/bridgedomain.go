@@ -5,26 +5,322 @@ import (
 	"strings"
 
 	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
-	"github.com/ciscoecosystem/aci-go-client/v2/models"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+	securitydomainutil "github.com/jgomezve/provider-aci/internal/clients/securitydomain"
+	vrfmulticastutil "github.com/jgomezve/provider-aci/internal/clients/vrfmulticast"
+	"github.com/pkg/errors"
 )
 
-func IsUptoDate(a *aciclient.Client, s *v1alpha1.BridgeDomain, t models.BridgeDomainAttributes) bool {
+const (
+	FvBDClassName                = "fvBD"
+	fvRsCtxClassName             = "fvRsCtx"
+	fvRsBDToOutClassName         = "fvRsBDToOut"
+	fvRsBdToEpRetClassName       = "fvRsBdToEpRet"
+	fvRsIgmpsnClassName          = "fvRsIgmpsn"
+	dhcpLblClassName             = "dhcpLbl"
+	dhcpRsDhcpOptionPolClassName = "dhcpRsDhcpOptionPol"
+	igmpIfPClassName             = "igmpIfP"
+	igmpRsIfPolClassName         = "igmpRsIfPol"
 
+	mcastAllowed = "yes"
+)
+
+// BridgeDomainDn returns the DN of the bridge domain with the supplied name
+// of the supplied tenant.
+func BridgeDomainDn(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/BD-%s", tenant, name)
+}
+
+// VrfName returns the name of the VRF the bridge domain with the supplied DN
+// is associated with.
+func VrfName(a *aciclient.Client, dn string) string {
 	vrfName := ""
-	dn := fmt.Sprintf("uni/tn-%s/BD-%s", s.Spec.ForProvider.Tenant, s.Spec.ForProvider.Name)
 	fvRsCtxData, err := a.ReadRelationfvRsCtxFromBridgeDomain(dn)
 	if err == nil && fvRsCtxData != "" {
 		vrfName = strings.TrimPrefix(strings.Split(fvRsCtxData.(string), "/")[2], "ctx-")
 	}
+	return vrfName
+}
+
+// NewBridgeDomain returns the fvBD of the supplied bridge domain.
+func NewBridgeDomain(p v1alpha1.BridgeDomainParameters) *mo.Object {
+	attrs := map[string]string{
+		"name":                  p.Name,
+		"descr":                 p.Description,
+		"arpFlood":              p.ArpFlood,
+		"unicastRoute":          p.UnicastRoute,
+		"unkMacUcastAct":        p.UnkMacUcastAct,
+		"unkMcastAct":           p.UnkMcastAct,
+		"multiDstPktAct":        p.MultiDstPktAct,
+		"limitIpLearnToSubnets": p.LimitIpLearnToSubnets,
+		"epMoveDetectMode":      p.EpMoveDetectMode,
+		"ipLearning":            p.IpLearning,
+		"hostBasedRouting":      p.HostBasedRouting,
+		"mac":                   p.Mac,
+		"mcastAllow":            p.McastAllow,
+	}
+	if p.Mtu != "" {
+		attrs["mtu"] = p.Mtu
+	}
+	return mo.NewObject(FvBDClassName, BridgeDomainDn(p.Tenant, p.Name), attrs)
+}
+
+// NewVrfRef returns the fvRsCtx associating the bridge domain with the
+// supplied DN to the VRF with the supplied name.
+func NewVrfRef(bdDn, vrf string) *mo.Object {
+	return mo.NewObject(fvRsCtxClassName, fmt.Sprintf("%s/rsctx", bdDn), map[string]string{
+		"tnFvCtxName": vrf,
+	})
+}
+
+// ValidateMulticast returns an error if multicast is enabled on the supplied
+// bridge domain but PIM is not enabled on its VRF.
+func ValidateMulticast(a *aciclient.Client, p v1alpha1.BridgeDomainParameters) error {
+	if p.McastAllow != mcastAllowed {
+		return nil
+	}
+	enabled, err := vrfmulticastutil.PIMEnabled(a, p.Tenant, p.Vrf)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return errors.Errorf("PIM is not enabled on VRF %s", p.Vrf)
+	}
+	return nil
+}
+
+func igmpInterfacePolicyDn(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/igmpIfPol-%s", tenant, name)
+}
+
+// NewL3OutRef returns the fvRsBDToOut associating the bridge domain with the
+// supplied DN to the L3Out with the supplied name.
+func NewL3OutRef(bdDn, l3Out string) *mo.Object {
+	return mo.NewObject(fvRsBDToOutClassName, fmt.Sprintf("%s/rsBDToOut-%s", bdDn, l3Out), map[string]string{
+		"tnL3extOutName": l3Out,
+	})
+}
+
+func newEpRetentionRef(bdDn, policy string) *mo.Object {
+	return mo.NewObject(fvRsBdToEpRetClassName, fmt.Sprintf("%s/rsbdToEpRet", bdDn), map[string]string{
+		"tnFvEpRetPolName": policy,
+	})
+}
+
+func newIgmpSnoopRef(bdDn, policy string) *mo.Object {
+	return mo.NewObject(fvRsIgmpsnClassName, fmt.Sprintf("%s/rsigmpsn", bdDn), map[string]string{
+		"tnIgmpSnoopPolName": policy,
+	})
+}
+
+func igmpInterfaceDn(bdDn string) string {
+	return fmt.Sprintf("%s/igmpIfP", bdDn)
+}
+
+// newIgmpInterface returns the igmpIfP of the bridge domain with the
+// supplied DN and its relation to the supplied IGMP interface policy.
+func newIgmpInterface(bdDn string, p v1alpha1.BridgeDomainParameters) (*mo.Object, *mo.Object) {
+	dn := igmpInterfaceDn(bdDn)
+	igmpIfP := mo.NewObject(igmpIfPClassName, dn, map[string]string{})
+	rsIfPol := mo.NewObject(igmpRsIfPolClassName, fmt.Sprintf("%s/rsIfPol", dn), map[string]string{
+		"tDn": igmpInterfacePolicyDn(p.Tenant, p.IgmpInterfacePolicy),
+	})
+	return igmpIfP, rsIfPol
+}
+
+// newDHCPLabel returns the dhcpLbl of the bridge domain with the supplied DN
+// and its relation to the DHCP option policy of the supplied label.
+func newDHCPLabel(bdDn string, l v1alpha1.DHCPLabel) (*mo.Object, *mo.Object) {
+	dn := fmt.Sprintf("%s/dhcplbl-%s", bdDn, l.Name)
+	lbl := mo.NewObject(dhcpLblClassName, dn, map[string]string{
+		"name":  l.Name,
+		"owner": l.Scope,
+	})
+	rsOptionPol := mo.NewObject(dhcpRsDhcpOptionPolClassName, fmt.Sprintf("%s/rsdhcpOptionPol", dn), map[string]string{
+		"tnDhcpOptionPolName": l.OptionPolicy,
+	})
+	return lbl, rsOptionPol
+}
+
+// NewTree returns the tree of the supplied bridge domain with its VRF
+// association, its relations, its DHCP labels and its security domains, so
+// that they are all created in a single transaction.
+func NewTree(p v1alpha1.BridgeDomainParameters) *mo.Tree {
+	fvBd := NewBridgeDomain(p)
+	children := []*mo.Tree{
+		NewVrfRef(fvBd.Dn, p.Vrf).Tree(),
+		newEpRetentionRef(fvBd.Dn, p.EpRetentionPolicy).Tree(),
+		newIgmpSnoopRef(fvBd.Dn, p.IgmpSnoopPolicy).Tree(),
+	}
+	for _, o := range p.L3Outs {
+		children = append(children, NewL3OutRef(fvBd.Dn, o).Tree())
+	}
+	if p.IgmpInterfacePolicy != "" {
+		igmpIfP, rsIfPol := newIgmpInterface(fvBd.Dn, p)
+		children = append(children, igmpIfP.Tree(rsIfPol.Tree()))
+	}
+	for _, l := range p.DHCPLabels {
+		lbl, rsOptionPol := newDHCPLabel(fvBd.Dn, l)
+		children = append(children, lbl.Tree(rsOptionPol.Tree()))
+	}
+	for _, d := range p.SecurityDomains {
+		children = append(children, securitydomainutil.NewDomainRef(fvBd.Dn, d).Tree())
+	}
+	return fvBd.Tree(children...)
+}
+
+// ReconcileRelations converges the L3Outs, the endpoint retention policy and
+// the IGMP snooping and interface policies of the bridge domain with the
+// supplied DN.
+func ReconcileRelations(a *aciclient.Client, bdDn string, p v1alpha1.BridgeDomainParameters) error {
+	l3Outs := make([]*mo.Object, 0, len(p.L3Outs))
+	for _, o := range p.L3Outs {
+		l3Outs = append(l3Outs, NewL3OutRef(bdDn, o))
+	}
+	if err := mo.ReconcileChildren(a, bdDn, fvRsBDToOutClassName, l3Outs); err != nil {
+		return err
+	}
+	if err := a.Save(newEpRetentionRef(bdDn, p.EpRetentionPolicy)); err != nil {
+		return err
+	}
+	if err := a.Save(newIgmpSnoopRef(bdDn, p.IgmpSnoopPolicy)); err != nil {
+		return err
+	}
+	if p.IgmpInterfacePolicy == "" {
+		return a.DeleteByDn(igmpInterfaceDn(bdDn), igmpIfPClassName)
+	}
+	igmpIfP, rsIfPol := newIgmpInterface(bdDn, p)
+	if err := a.Save(igmpIfP); err != nil {
+		return err
+	}
+	return a.Save(rsIfPol)
+}
+
+// ReconcileDHCPLabels converges the DHCP relay labels of the bridge domain
+// with the supplied DN.
+func ReconcileDHCPLabels(a *aciclient.Client, bdDn string, p v1alpha1.BridgeDomainParameters) error {
+	labels := make([]*mo.Object, 0, len(p.DHCPLabels))
+	options := make([]*mo.Object, 0, len(p.DHCPLabels))
+	for _, l := range p.DHCPLabels {
+		lbl, rsOptionPol := newDHCPLabel(bdDn, l)
+		labels = append(labels, lbl)
+		options = append(options, rsOptionPol)
+	}
+	if err := mo.ReconcileChildren(a, bdDn, dhcpLblClassName, labels); err != nil {
+		return err
+	}
+	for _, o := range options {
+		if err := a.Save(o); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readDHCPLabels(a *aciclient.Client, bdDn string) ([]v1alpha1.DHCPLabel, error) {
+	lbls, err := mo.ReadChildren(a, bdDn, dhcpLblClassName)
+	if err != nil {
+		return nil, err
+	}
+	var labels []v1alpha1.DHCPLabel
+	for _, l := range lbls {
+		opt, err := mo.ReadAttribute(a, fmt.Sprintf("%s/rsdhcpOptionPol", l["dn"]), dhcpRsDhcpOptionPolClassName, "tnDhcpOptionPolName")
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, v1alpha1.DHCPLabel{Name: l["name"], Scope: l["owner"], OptionPolicy: opt})
+	}
+	return labels, nil
+}
+
+// readRelations reads the L3Outs, the endpoint retention policy and the IGMP
+// snooping and interface policies of the bridge domain with the supplied DN
+// into the supplied parameters, whose tenant has to be set.
+func readRelations(a *aciclient.Client, bdDn string, observed *v1alpha1.BridgeDomainParameters) error {
+	refs, err := mo.ReadChildren(a, bdDn, fvRsBDToOutClassName)
+	if err != nil {
+		return err
+	}
+	for _, r := range refs {
+		observed.L3Outs = append(observed.L3Outs, r["tnL3extOutName"])
+	}
+	if observed.EpRetentionPolicy, err = mo.ReadAttribute(a, fmt.Sprintf("%s/rsbdToEpRet", bdDn), fvRsBdToEpRetClassName, "tnFvEpRetPolName"); err != nil {
+		return err
+	}
+	if observed.IgmpSnoopPolicy, err = mo.ReadAttribute(a, fmt.Sprintf("%s/rsigmpsn", bdDn), fvRsIgmpsnClassName, "tnIgmpSnoopPolName"); err != nil {
+		return err
+	}
+	igmpIf, err := mo.ReadRelation(a, fmt.Sprintf("%s/igmpIfP/rsIfPol", bdDn), igmpRsIfPolClassName)
+	if err != nil {
+		return err
+	}
+	observed.IgmpInterfacePolicy = strings.TrimPrefix(igmpIf, igmpInterfacePolicyDn(observed.Tenant, ""))
+	return nil
+}
+
+// RelationsUpToDate returns whether the relations of the bridge domain with
+// the supplied DN match the supplied parameters, that is whether
+// ReconcileRelations would change nothing.
+func RelationsUpToDate(a *aciclient.Client, bdDn string, p v1alpha1.BridgeDomainParameters) bool {
+	observed := &v1alpha1.BridgeDomainParameters{Tenant: p.Tenant}
+	if err := readRelations(a, bdDn, observed); err != nil {
+		return false
+	}
+	desired := &v1alpha1.BridgeDomainParameters{
+		Tenant:              p.Tenant,
+		L3Outs:              p.L3Outs,
+		EpRetentionPolicy:   p.EpRetentionPolicy,
+		IgmpSnoopPolicy:     p.IgmpSnoopPolicy,
+		IgmpInterfacePolicy: p.IgmpInterfacePolicy,
+	}
+	return cmp.Equal(observed, desired, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(x, y string) bool { return x < y }))
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.BridgeDomain, t map[string]string) bool {
+
+	dn := BridgeDomainDn(s.Spec.ForProvider.Tenant, s.Spec.ForProvider.Name)
+	securityDomains, err := securitydomainutil.DomainRefs(a, dn)
+	if err != nil {
+		return false
+	}
+	dhcpLabels, err := readDHCPLabels(a, dn)
+	if err != nil {
+		return false
+	}
 
 	observed := &v1alpha1.BridgeDomainParameters{
-		ArpFlood: t.ArpFlood,
-		Vrf:      vrfName,
-		Name:     t.Name,
-		Tenant:   s.Spec.ForProvider.Tenant,
+		Name:                  t["name"],
+		Tenant:                s.Spec.ForProvider.Tenant,
+		Vrf:                   VrfName(a, dn),
+		Description:           t["descr"],
+		ArpFlood:              t["arpFlood"],
+		UnicastRoute:          t["unicastRoute"],
+		UnkMacUcastAct:        t["unkMacUcastAct"],
+		UnkMcastAct:           t["unkMcastAct"],
+		MultiDstPktAct:        t["multiDstPktAct"],
+		LimitIpLearnToSubnets: t["limitIpLearnToSubnets"],
+		EpMoveDetectMode:      t["epMoveDetectMode"],
+		IpLearning:            t["ipLearning"],
+		HostBasedRouting:      t["hostBasedRouting"],
+		Mac:                   t["mac"],
+		Mtu:                   t["mtu"],
+		McastAllow:            t["mcastAllow"],
+		DHCPLabels:            dhcpLabels,
+		SecurityDomains:       securityDomains,
+	}
+	if err := readRelations(a, dn, observed); err != nil {
+		return false
+	}
+	if s.Spec.ForProvider.Mtu == "" {
+		observed.Mtu = ""
+	}
+	if strings.EqualFold(observed.Mac, s.Spec.ForProvider.Mac) {
+		observed.Mac = s.Spec.ForProvider.Mac
 	}
 
-	return cmp.Equal(observed, &s.Spec.ForProvider)
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(x, y string) bool { return x < y }),
+		cmpopts.SortSlices(func(x, y v1alpha1.DHCPLabel) bool { return x.Name < y.Name }))
 }
@@ -0,0 +1,103 @@
+package classquery
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/ciscoecosystem/aci-go-client/v2/models"
+	"github.com/jgomezve/provider-aci/apis/rest/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+	"github.com/pkg/errors"
+)
+
+const keyTotalCount = "totalCount"
+
+// URL returns the URL of the supplied class query. At most MaxResults
+// objects are requested.
+func URL(p v1alpha1.ClassQueryParameters) string {
+	q := url.Values{}
+	if p.Filter != "" {
+		q.Set("query-target-filter", p.Filter)
+	}
+	if p.QueryTarget != "" {
+		q.Set("query-target", p.QueryTarget)
+	}
+	if len(p.TargetSubtreeClass) > 0 {
+		q.Set("target-subtree-class", strings.Join(p.TargetSubtreeClass, ","))
+	}
+	q.Set("order-by", fmt.Sprintf("%s.dn", p.ClassName))
+	q.Set("page", "0")
+	q.Set("page-size", strconv.Itoa(p.MaxResults))
+	return fmt.Sprintf("/api/node/class/%s.json?%s", p.ClassName, q.Encode())
+}
+
+// Query runs the supplied class query and returns the projected attributes
+// of the returned objects, sorted by DN, and the number of objects matching
+// the query.
+func Query(a *aciclient.Client, p v1alpha1.ClassQueryParameters) ([]map[string]string, int, error) {
+	cont, err := a.GetViaURL(URL(p))
+	if err != nil {
+		if mo.IsNotFound(err) {
+			return nil, 0, nil
+		}
+		return nil, 0, err
+	}
+	total, err := strconv.Atoi(models.StripQuotes(cont.S(keyTotalCount).String()))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "cannot parse the total count of the query")
+	}
+
+	imdata, err := cont.S("imdata").Children()
+	if err != nil {
+		return nil, 0, err
+	}
+	var objs []map[string]string
+	for _, c := range imdata {
+		classes, err := c.ChildrenMap()
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, o := range classes {
+			attrs, ok := o.S("attributes").Data().(map[string]interface{})
+			if !ok {
+				continue
+			}
+			obj := map[string]string{}
+			for k, v := range attrs {
+				obj[k] = models.StripQuotes(fmt.Sprintf("%v", v))
+			}
+			objs = append(objs, obj)
+		}
+	}
+	sort.Slice(objs, func(i, j int) bool { return objs[i]["dn"] < objs[j]["dn"] })
+	if len(objs) > p.MaxResults {
+		objs = objs[:p.MaxResults]
+	}
+
+	results := make([]map[string]string, 0, len(objs))
+	for _, o := range objs {
+		r := map[string]string{}
+		for _, attr := range p.Attributes {
+			r[attr] = o[attr]
+		}
+		results = append(results, r)
+	}
+	return results, total, nil
+}
+
+// ConnectionDetails returns the supplied results of a class query as
+// connection details, with the keys <index>.<attribute>, and their total
+// count.
+func ConnectionDetails(results []map[string]string, total int) map[string][]byte {
+	details := map[string][]byte{keyTotalCount: []byte(strconv.Itoa(total))}
+	for i, r := range results {
+		for attr, v := range r {
+			details[fmt.Sprintf("%d.%s", i, attr)] = []byte(v)
+		}
+	}
+	return details
+}
@@ -0,0 +1,97 @@
+package configexportpolicy
+
+import (
+	"fmt"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	ConfigExportPClassName           = "configExportP"
+	configRsExportSchedulerClassName = "configRsExportScheduler"
+	configRsRemotePathClassName      = "configRsRemotePath"
+	configJobClassName               = "configJob"
+)
+
+// PolicyDn returns the DN of the export policy with the supplied name.
+func PolicyDn(name string) string {
+	return fmt.Sprintf("uni/fabric/configexp-%s", name)
+}
+
+// ReadLastJob returns the status of the last job run by the export or import
+// policy with the supplied DN.
+func ReadLastJob(a *aciclient.Client, policyDn string) (v1alpha1.ConfigJobStatus, error) {
+	jobs, err := mo.ReadChildren(a, fmt.Sprintf("uni/backupst/jobs-[%s]", policyDn), configJobClassName)
+	if err != nil {
+		return v1alpha1.ConfigJobStatus{}, err
+	}
+	var last map[string]string
+	for _, j := range jobs {
+		if last == nil || j["executeTime"] > last["executeTime"] {
+			last = j
+		}
+	}
+	if last == nil {
+		return v1alpha1.ConfigJobStatus{}, nil
+	}
+	return v1alpha1.ConfigJobStatus{
+		Name:                last["name"],
+		Status:              last["operSt"],
+		Details:             last["details"],
+		FileName:            last["fileName"],
+		ExecuteTime:         last["executeTime"],
+		LastStepDescription: last["lastStepDescr"],
+	}, nil
+}
+
+// SaveRelations points the export policy with the supplied DN to its
+// scheduler and remote location.
+func SaveRelations(a *aciclient.Client, policyDn string, p v1alpha1.ConfigExportPolicyParameters) error {
+	err := a.Save(mo.NewObject(configRsExportSchedulerClassName, policyDn+"/rsexportScheduler", map[string]string{
+		"tnTrigSchedPName": p.Scheduler,
+	}))
+	if err != nil {
+		return err
+	}
+	return a.Save(mo.NewObject(configRsRemotePathClassName, policyDn+"/rsremotePath", map[string]string{
+		"tnFileRemotePathName": p.RemoteLocation,
+	}))
+}
+
+func readRelationName(a *aciclient.Client, dn, className, attr string) (string, error) {
+	rel, err := mo.Read(a, dn, className)
+	if err != nil || rel == nil {
+		return "", err
+	}
+	return rel[attr], nil
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.ConfigExportPolicy, t map[string]string) bool {
+
+	dn := PolicyDn(s.Spec.ForProvider.Name)
+	scheduler, err := readRelationName(a, dn+"/rsexportScheduler", configRsExportSchedulerClassName, "tnTrigSchedPName")
+	if err != nil {
+		return false
+	}
+	remoteLocation, err := readRelationName(a, dn+"/rsremotePath", configRsRemotePathClassName, "tnFileRemotePathName")
+	if err != nil {
+		return false
+	}
+
+	observed := &v1alpha1.ConfigExportPolicyParameters{
+		Name:                t["name"],
+		Description:         t["descr"],
+		Format:              t["format"],
+		TargetDn:            t["targetDn"],
+		Snapshot:            t["snapshot"],
+		IncludeSecureFields: t["includeSecureFields"],
+		MaxSnapshotCount:    t["maxSnapshotCount"],
+		Scheduler:           scheduler,
+		RemoteLocation:      remoteLocation,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider)
+}
@@ -0,0 +1,73 @@
+package configrollback
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	// AnnotationKeyLastTrigger records the value of Trigger the rollback was
+	// last run with. It is persisted before the rollback is run.
+	AnnotationKeyLastTrigger = "aci.crossplane.io/last-trigger"
+
+	ConfigImportPClassName = "configImportP"
+)
+
+// PolicyDn returns the DN of the import policy with the supplied name.
+func PolicyDn(name string) string {
+	return fmt.Sprintf("uni/fabric/configimp-%s", name)
+}
+
+// LastTrigger returns the value of Trigger the rollback was last run with.
+// The status is only read for the rollbacks run before the trigger was
+// recorded in an annotation.
+func LastTrigger(s *v1alpha1.ConfigRollback) string {
+	if t, ok := s.GetAnnotations()[AnnotationKeyLastTrigger]; ok {
+		return t
+	}
+	return s.Status.AtProvider.LastTrigger
+}
+
+// TriggerPending returns whether the rollback has to be run, that is whether
+// Trigger was set to a value it was not run with yet.
+func TriggerPending(s *v1alpha1.ConfigRollback) bool {
+	return s.Spec.ForProvider.Trigger != "" && s.Spec.ForProvider.Trigger != LastTrigger(s)
+}
+
+// NewPolicy returns the configImportP of the supplied rollback. The import
+// is only run if trigger is true.
+func NewPolicy(p v1alpha1.ConfigRollbackParameters, trigger bool) *mo.Object {
+	attrs := map[string]string{
+		"name":       p.Name,
+		"descr":      p.Description,
+		"fileName":   p.Snapshot,
+		"importType": p.ImportType,
+		"importMode": p.ImportMode,
+		"snapshot":   "yes",
+	}
+	if trigger {
+		attrs["adminSt"] = "triggered"
+	}
+	return mo.NewObject(ConfigImportPClassName, PolicyDn(p.Name), attrs)
+}
+
+func IsUptoDate(s *v1alpha1.ConfigRollback, t map[string]string) bool {
+
+	if TriggerPending(s) {
+		return false
+	}
+
+	observed := &v1alpha1.ConfigRollbackParameters{
+		Name:        t["name"],
+		Description: t["descr"],
+		Snapshot:    t["fileName"],
+		ImportType:  t["importType"],
+		ImportMode:  t["importMode"],
+		Trigger:     s.Spec.ForProvider.Trigger,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider)
+}
@@ -0,0 +1,141 @@
+package deviceselectionpolicy
+
+import (
+	"fmt"
+	"strings"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/services/v1alpha1"
+	bridgedomainutil "github.com/jgomezve/provider-aci/internal/clients/bridgedomain"
+	l4l7deviceutil "github.com/jgomezve/provider-aci/internal/clients/l4l7device"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+	redirectpolicyutil "github.com/jgomezve/provider-aci/internal/clients/redirectpolicy"
+)
+
+const (
+	VnsLDevCtxClassName                  = "vnsLDevCtx"
+	vnsRsLDevCtxToLDevClassName          = "vnsRsLDevCtxToLDev"
+	vnsLIfCtxClassName                   = "vnsLIfCtx"
+	vnsRsLIfCtxToLIfClassName            = "vnsRsLIfCtxToLIf"
+	vnsRsLIfCtxToBDClassName             = "vnsRsLIfCtxToBD"
+	vnsRsLIfCtxToSvcRedirectPolClassName = "vnsRsLIfCtxToSvcRedirectPol"
+)
+
+// ContextDn returns the DN of the device selection policy of the supplied
+// node of the supplied graph for the supplied contract of the supplied
+// tenant.
+func ContextDn(tenant, contract, graph, node string) string {
+	return fmt.Sprintf("uni/tn-%s/ldevCtx-c-%s-g-%s-n-%s", tenant, contract, graph, node)
+}
+
+func interfaceContextDn(ctxDn, connector string) string {
+	return fmt.Sprintf("%s/lIfCtx-c-%s", ctxDn, connector)
+}
+
+// NewContext returns the vnsLDevCtx of the supplied device selection policy.
+func NewContext(p v1alpha1.DeviceSelectionPolicyParameters) *mo.Object {
+	return mo.NewObject(VnsLDevCtxClassName, ContextDn(p.Tenant, p.Contract, p.Graph, p.Node), map[string]string{
+		"ctrctNameOrLbl": p.Contract,
+		"graphNameOrLbl": p.Graph,
+		"nodeNameOrLbl":  p.Node,
+		"descr":          p.Description,
+	})
+}
+
+// ReconcileChildren converges the device and the interface contexts of the
+// device selection policy with the supplied DN.
+func ReconcileChildren(a *aciclient.Client, ctxDn string, p v1alpha1.DeviceSelectionPolicyParameters) error {
+	deviceDn := l4l7deviceutil.DeviceDn(p.Tenant, p.Device)
+	if err := mo.SaveRelation(a, fmt.Sprintf("%s/rsLDevCtxToLDev", ctxDn), vnsRsLDevCtxToLDevClassName, deviceDn); err != nil {
+		return err
+	}
+
+	lIfCtxs := make([]*mo.Object, 0, len(p.Interfaces))
+	for _, i := range p.Interfaces {
+		lIfCtxs = append(lIfCtxs, mo.NewObject(vnsLIfCtxClassName, interfaceContextDn(ctxDn, i.Connector), map[string]string{
+			"connNameOrLbl": i.Connector,
+		}))
+	}
+	if err := mo.ReconcileChildren(a, ctxDn, vnsLIfCtxClassName, lIfCtxs); err != nil {
+		return err
+	}
+	for _, i := range p.Interfaces {
+		dn := interfaceContextDn(ctxDn, i.Connector)
+		if err := mo.SaveRelation(a, fmt.Sprintf("%s/rsLIfCtxToLIf", dn), vnsRsLIfCtxToLIfClassName, l4l7deviceutil.LogicalInterfaceDn(deviceDn, i.LogicalInterface)); err != nil {
+			return err
+		}
+		bdDn := ""
+		if i.BridgeDomain != "" {
+			bdDn = bridgedomainutil.BridgeDomainDn(p.Tenant, i.BridgeDomain)
+		}
+		if err := mo.SaveRelation(a, fmt.Sprintf("%s/rsLIfCtxToBD", dn), vnsRsLIfCtxToBDClassName, bdDn); err != nil {
+			return err
+		}
+		redirectDn := ""
+		if i.RedirectPolicy != "" {
+			redirectDn = redirectpolicyutil.RedirectPolicyDn(p.Tenant, i.RedirectPolicy)
+		}
+		if err := mo.SaveRelation(a, fmt.Sprintf("%s/rsLIfCtxToSvcRedirectPol", dn), vnsRsLIfCtxToSvcRedirectPolClassName, redirectDn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readInterfaces(a *aciclient.Client, ctxDn string, p v1alpha1.DeviceSelectionPolicyParameters) ([]v1alpha1.DeviceSelectionInterface, error) {
+	lIfCtxs, err := mo.ReadChildren(a, ctxDn, vnsLIfCtxClassName)
+	if err != nil {
+		return nil, err
+	}
+	var interfaces []v1alpha1.DeviceSelectionInterface
+	for _, l := range lIfCtxs {
+		lIf, err := mo.ReadRelation(a, fmt.Sprintf("%s/rsLIfCtxToLIf", l["dn"]), vnsRsLIfCtxToLIfClassName)
+		if err != nil {
+			return nil, err
+		}
+		bd, err := mo.ReadRelation(a, fmt.Sprintf("%s/rsLIfCtxToBD", l["dn"]), vnsRsLIfCtxToBDClassName)
+		if err != nil {
+			return nil, err
+		}
+		redirect, err := mo.ReadRelation(a, fmt.Sprintf("%s/rsLIfCtxToSvcRedirectPol", l["dn"]), vnsRsLIfCtxToSvcRedirectPolClassName)
+		if err != nil {
+			return nil, err
+		}
+		interfaces = append(interfaces, v1alpha1.DeviceSelectionInterface{
+			Connector:        l["connNameOrLbl"],
+			LogicalInterface: strings.TrimPrefix(lIf, l4l7deviceutil.LogicalInterfaceDn(l4l7deviceutil.DeviceDn(p.Tenant, p.Device), "")),
+			BridgeDomain:     strings.TrimPrefix(bd, bridgedomainutil.BridgeDomainDn(p.Tenant, "")),
+			RedirectPolicy:   strings.TrimPrefix(redirect, redirectpolicyutil.RedirectPolicyDn(p.Tenant, "")),
+		})
+	}
+	return interfaces, nil
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.DeviceSelectionPolicy, t map[string]string) bool {
+
+	p := s.Spec.ForProvider
+	dn := ContextDn(p.Tenant, p.Contract, p.Graph, p.Node)
+	device, err := mo.ReadRelation(a, fmt.Sprintf("%s/rsLDevCtxToLDev", dn), vnsRsLDevCtxToLDevClassName)
+	if err != nil {
+		return false
+	}
+	interfaces, err := readInterfaces(a, dn, p)
+	if err != nil {
+		return false
+	}
+
+	observed := &v1alpha1.DeviceSelectionPolicyParameters{
+		Tenant:      p.Tenant,
+		Contract:    t["ctrctNameOrLbl"],
+		Graph:       t["graphNameOrLbl"],
+		Node:        t["nodeNameOrLbl"],
+		Device:      strings.TrimPrefix(device, l4l7deviceutil.DeviceDn(p.Tenant, "")),
+		Description: t["descr"],
+		Interfaces:  interfaces,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(x, y v1alpha1.DeviceSelectionInterface) bool { return x.Connector < y.Connector }))
+}
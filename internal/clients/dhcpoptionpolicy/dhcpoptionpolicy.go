@@ -0,0 +1,68 @@
+package dhcpoptionpolicy
+
+import (
+	"fmt"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	DhcpOptionPolClassName = "dhcpOptionPol"
+	dhcpOptionClassName    = "dhcpOption"
+)
+
+// OptionPolicyDn returns the DN of the DHCP option policy with the supplied
+// name of the supplied tenant.
+func OptionPolicyDn(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/dhcpoptpol-%s", tenant, name)
+}
+
+// NewOptionPolicy returns the dhcpOptionPol of the supplied DHCP option
+// policy.
+func NewOptionPolicy(p v1alpha1.DHCPOptionPolicyParameters) *mo.Object {
+	return mo.NewObject(DhcpOptionPolClassName, OptionPolicyDn(p.Tenant, p.Name), map[string]string{
+		"name":  p.Name,
+		"descr": p.Description,
+	})
+}
+
+// ReconcileOptions converges the options of the DHCP option policy with the
+// supplied DN.
+func ReconcileOptions(a *aciclient.Client, polDn string, p v1alpha1.DHCPOptionPolicyParameters) error {
+	opts := make([]*mo.Object, 0, len(p.Options))
+	for _, o := range p.Options {
+		opts = append(opts, mo.NewObject(dhcpOptionClassName, fmt.Sprintf("%s/opt-%s", polDn, o.Name), map[string]string{
+			"name": o.Name,
+			"id":   o.ID,
+			"data": o.Data,
+		}))
+	}
+	return mo.ReconcileChildren(a, polDn, dhcpOptionClassName, opts)
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.DHCPOptionPolicy, t map[string]string) bool {
+
+	p := s.Spec.ForProvider
+	opts, err := mo.ReadChildren(a, OptionPolicyDn(p.Tenant, p.Name), dhcpOptionClassName)
+	if err != nil {
+		return false
+	}
+	var options []v1alpha1.DHCPOption
+	for _, o := range opts {
+		options = append(options, v1alpha1.DHCPOption{Name: o["name"], ID: o["id"], Data: o["data"]})
+	}
+
+	observed := &v1alpha1.DHCPOptionPolicyParameters{
+		Name:        t["name"],
+		Tenant:      p.Tenant,
+		Description: t["descr"],
+		Options:     options,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(x, y v1alpha1.DHCPOption) bool { return x.Name < y.Name }))
+}
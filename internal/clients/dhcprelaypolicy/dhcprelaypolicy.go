@@ -0,0 +1,69 @@
+package dhcprelaypolicy
+
+import (
+	"fmt"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	DhcpRelayPClassName = "dhcpRelayP"
+	dhcpRsProvClassName = "dhcpRsProv"
+)
+
+// RelayPolicyDn returns the DN of the DHCP relay policy with the supplied
+// name of the supplied tenant.
+func RelayPolicyDn(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/relayp-%s", tenant, name)
+}
+
+// NewRelayPolicy returns the dhcpRelayP of the supplied DHCP relay policy.
+func NewRelayPolicy(p v1alpha1.DHCPRelayPolicyParameters) *mo.Object {
+	return mo.NewObject(DhcpRelayPClassName, RelayPolicyDn(p.Tenant, p.Name), map[string]string{
+		"name":  p.Name,
+		"descr": p.Description,
+		"owner": p.Owner,
+		"mode":  "visible",
+	})
+}
+
+// ReconcileProviders converges the DHCP servers of the relay policy with the
+// supplied DN.
+func ReconcileProviders(a *aciclient.Client, relayDn string, p v1alpha1.DHCPRelayPolicyParameters) error {
+	provs := make([]*mo.Object, 0, len(p.Providers))
+	for _, prov := range p.Providers {
+		provs = append(provs, mo.NewObject(dhcpRsProvClassName, fmt.Sprintf("%s/rsprov-[%s]", relayDn, prov.Dn), map[string]string{
+			"tDn":  prov.Dn,
+			"addr": prov.ServerIP,
+		}))
+	}
+	return mo.ReconcileChildren(a, relayDn, dhcpRsProvClassName, provs)
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.DHCPRelayPolicy, t map[string]string) bool {
+
+	p := s.Spec.ForProvider
+	provs, err := mo.ReadChildren(a, RelayPolicyDn(p.Tenant, p.Name), dhcpRsProvClassName)
+	if err != nil {
+		return false
+	}
+	var providers []v1alpha1.DHCPProvider
+	for _, prov := range provs {
+		providers = append(providers, v1alpha1.DHCPProvider{Dn: prov["tDn"], ServerIP: prov["addr"]})
+	}
+
+	observed := &v1alpha1.DHCPRelayPolicyParameters{
+		Name:        t["name"],
+		Tenant:      p.Tenant,
+		Description: t["descr"],
+		Owner:       t["owner"],
+		Providers:   providers,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(x, y v1alpha1.DHCPProvider) bool { return x.Dn < y.Dn }))
+}
@@ -0,0 +1,103 @@
+package dnsprofile
+
+import (
+	"fmt"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/fabric/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	DnsProfileClassName        = "dnsProfile"
+	dnsProvClassName           = "dnsProv"
+	dnsDomainClassName         = "dnsDomain"
+	dnsRsProfileToEpgClassName = "dnsRsProfileToEpg"
+)
+
+// ProfileDn returns the DN of the DNS profile with the supplied name.
+func ProfileDn(name string) string {
+	return fmt.Sprintf("uni/fabric/dnsp-%s", name)
+}
+
+// EPGRelationDn returns the DN of the management EPG relation of the profile
+// with the supplied DN.
+func EPGRelationDn(profileDn string) string {
+	return fmt.Sprintf("%s/rsProfileToEpg", profileDn)
+}
+
+// NewProvider returns the dnsProv p of the profile with the supplied DN.
+func NewProvider(profileDn string, p v1alpha1.DNSProvider) *mo.Object {
+	return mo.NewObject(dnsProvClassName, fmt.Sprintf("%s/prov-[%s]", profileDn, p.Address), map[string]string{
+		"addr":      p.Address,
+		"preferred": p.Preferred,
+	})
+}
+
+// NewDomain returns the dnsDomain d of the profile with the supplied DN.
+func NewDomain(profileDn string, d v1alpha1.DNSDomain) *mo.Object {
+	return mo.NewObject(dnsDomainClassName, fmt.Sprintf("%s/dom-%s", profileDn, d.Name), map[string]string{
+		"name":      d.Name,
+		"isDefault": d.IsDefault,
+	})
+}
+
+// ReconcileChildren converges the providers, the domains and the
+// management EPG of the profile with the supplied DN.
+func ReconcileChildren(a *aciclient.Client, profileDn string, p v1alpha1.DNSProfileParameters) error {
+	providers := make([]*mo.Object, 0, len(p.Providers))
+	for _, prov := range p.Providers {
+		providers = append(providers, NewProvider(profileDn, prov))
+	}
+	if err := mo.ReconcileChildren(a, profileDn, dnsProvClassName, providers); err != nil {
+		return err
+	}
+	domains := make([]*mo.Object, 0, len(p.Domains))
+	for _, d := range p.Domains {
+		domains = append(domains, NewDomain(profileDn, d))
+	}
+	if err := mo.ReconcileChildren(a, profileDn, dnsDomainClassName, domains); err != nil {
+		return err
+	}
+	return mo.SaveRelation(a, EPGRelationDn(profileDn), dnsRsProfileToEpgClassName, mo.ManagementEPGDn(p.ManagementEPG))
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.DNSProfile, t map[string]string) bool {
+
+	dn := ProfileDn(s.Spec.ForProvider.Name)
+	provs, err := mo.ReadChildren(a, dn, dnsProvClassName)
+	if err != nil {
+		return false
+	}
+	var providers []v1alpha1.DNSProvider
+	for _, p := range provs {
+		providers = append(providers, v1alpha1.DNSProvider{Address: p["addr"], Preferred: p["preferred"]})
+	}
+	doms, err := mo.ReadChildren(a, dn, dnsDomainClassName)
+	if err != nil {
+		return false
+	}
+	var domains []v1alpha1.DNSDomain
+	for _, d := range doms {
+		domains = append(domains, v1alpha1.DNSDomain{Name: d["name"], IsDefault: d["isDefault"]})
+	}
+	epg, err := mo.ReadRelation(a, EPGRelationDn(dn), dnsRsProfileToEpgClassName)
+	if err != nil {
+		return false
+	}
+
+	observed := &v1alpha1.DNSProfileParameters{
+		Name:          t["name"],
+		Description:   t["descr"],
+		NameAlias:     t["nameAlias"],
+		ManagementEPG: mo.ManagementEPGFromDn(epg),
+		Providers:     providers,
+		Domains:       domains,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(x, y v1alpha1.DNSProvider) bool { return x.Address < y.Address }),
+		cmpopts.SortSlices(func(x, y v1alpha1.DNSDomain) bool { return x.Name < y.Name }))
+}
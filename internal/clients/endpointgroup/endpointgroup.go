@@ -5,26 +5,205 @@ import (
 	"strings"
 
 	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
-	"github.com/ciscoecosystem/aci-go-client/v2/models"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/jgomezve/provider-aci/apis/application-management/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+	securitydomainutil "github.com/jgomezve/provider-aci/internal/clients/securitydomain"
 )
 
-func IsUptoDate(a *aciclient.Client, s *v1alpha1.EndpointGroup, t models.ApplicationEPGAttributes) bool {
+const (
+	FvAEPgClassName         = "fvAEPg"
+	fvRsBdClassName         = "fvRsBd"
+	fvRsIntraEpgClassName   = "fvRsIntraEpg"
+	fvRsAEPgMonPolClassName = "fvRsAEPgMonPol"
+)
+
+// Name returns the name of the EPG of the supplied EndpointGroup, which
+// defaults to the name of the EndpointGroup.
+func Name(s *v1alpha1.EndpointGroup) string {
+	if s.Spec.ForProvider.Name != "" {
+		return s.Spec.ForProvider.Name
+	}
+	return s.Name
+}
+
+// EndpointGroupDn returns the DN of the EPG of the supplied EndpointGroup.
+func EndpointGroupDn(s *v1alpha1.EndpointGroup) string {
+	return fmt.Sprintf("uni/tn-%s/ap-%s/epg-%s", s.Spec.ForProvider.Tenant, s.Spec.ForProvider.ApplicationProfile, Name(s))
+}
+
+// StaleDn returns the DN of the EPG last observed for the supplied
+// EndpointGroup if it is no longer the DN of its EPG, because its name,
+// application profile or tenant changed, or an empty string otherwise.
+func StaleDn(s *v1alpha1.EndpointGroup) string {
+	if dn := s.Status.AtProvider.Dn; dn != "" && dn != EndpointGroupDn(s) {
+		return dn
+	}
+	return ""
+}
+
+// Tenant returns the name of the tenant of the EPG with the supplied DN.
+func Tenant(epgDn string) string {
+	tenant, _, _ := strings.Cut(strings.TrimPrefix(epgDn, "uni/tn-"), "/")
+	return tenant
+}
 
+func monPolDn(epgDn string) string {
+	return fmt.Sprintf("%s/rsAEPgMonPol", epgDn)
+}
+
+// BridgeDomainName returns the name of the bridge domain the EPG with the
+// supplied DN is associated with.
+func BridgeDomainName(a *aciclient.Client, dn string) string {
 	bdName := ""
-	dn := fmt.Sprintf("uni/tn-%s/ap-%s/epg-%s", s.Spec.ForProvider.Tenant, s.Spec.ForProvider.ApplicationProfile, s.Name)
 	fvRsBdData, err := a.ReadRelationfvRsBdFromApplicationEPG(dn)
 	if err == nil {
 		bdName = strings.TrimPrefix(strings.Split(fvRsBdData.(string), "/")[2], "BD-")
 	}
+	return bdName
+}
+
+// NewEndpointGroup returns the fvAEPg of the supplied EndpointGroup.
+func NewEndpointGroup(s *v1alpha1.EndpointGroup) *mo.Object {
+	p := s.Spec.ForProvider
+	return mo.NewObject(FvAEPgClassName, EndpointGroupDn(s), map[string]string{
+		"name":           Name(s),
+		"descr":          p.Description,
+		"nameAlias":      p.NameAlias,
+		"prefGrMemb":     p.PreferedGroup,
+		"pcEnfPref":      p.PcEnfPref,
+		"floodOnEncap":   p.FloodOnEncap,
+		"prio":           p.Prio,
+		"isAttrBasedEPg": p.IsAttrBasedEPg,
+		"shutdown":       p.Shutdown,
+		"fwdCtrl":        p.FwdCtrl,
+	})
+}
+
+// NewBridgeDomainRef returns the fvRsBd associating the EPG with the
+// supplied DN to the bridge domain with the supplied name.
+func NewBridgeDomainRef(epgDn, bd string) *mo.Object {
+	return mo.NewObject(fvRsBdClassName, fmt.Sprintf("%s/rsbd", epgDn), map[string]string{
+		"tnFvBDName": bd,
+	})
+}
+
+func newIntraEpgContracts(epgDn string, contracts []string) []*mo.Object {
+	objs := make([]*mo.Object, 0, len(contracts))
+	for _, c := range contracts {
+		objs = append(objs, mo.NewObject(fvRsIntraEpgClassName, fmt.Sprintf("%s/rsintraEpg-%s", epgDn, c), map[string]string{
+			"tnVzBrCPName": c,
+		}))
+	}
+	return objs
+}
+
+func newMonitoringPolicyRef(epgDn, policy string) *mo.Object {
+	return mo.NewObject(fvRsAEPgMonPolClassName, monPolDn(epgDn), map[string]string{
+		"tnMonEPGPolName": policy,
+	})
+}
+
+// NewTree returns the tree of the EPG of the supplied EndpointGroup with its
+// bridge domain association, its relations, its uSeg criteria and its
+// security domains, so that they are all created in a single transaction.
+func NewTree(s *v1alpha1.EndpointGroup) *mo.Tree {
+	p := s.Spec.ForProvider
+	fvAEPg := NewEndpointGroup(s)
+	children := []*mo.Tree{
+		NewBridgeDomainRef(fvAEPg.Dn, p.BridgeDomain).Tree(),
+		newMonitoringPolicyRef(fvAEPg.Dn, p.MonitoringPolicy).Tree(),
+	}
+	children = append(children, trees(newIntraEpgContracts(fvAEPg.Dn, p.IntraEpgContracts))...)
+	if p.USegCriteria != nil {
+		children = append(children, newUSegCriteriaTree(fvAEPg.Dn, p.USegCriteria))
+	}
+	for _, d := range p.SecurityDomains {
+		children = append(children, securitydomainutil.NewDomainRef(fvAEPg.Dn, d).Tree())
+	}
+	return fvAEPg.Tree(children...)
+}
+
+// ReconcileRelations converges the intra EPG contracts and the monitoring
+// policy of the EPG with the supplied DN.
+func ReconcileRelations(a *aciclient.Client, epgDn string, p v1alpha1.EndpointGroupParameters) error {
+	if err := mo.ReconcileChildren(a, epgDn, fvRsIntraEpgClassName, newIntraEpgContracts(epgDn, p.IntraEpgContracts)); err != nil {
+		return err
+	}
+	return a.Save(newMonitoringPolicyRef(epgDn, p.MonitoringPolicy))
+}
+
+// readRelations reads the intra EPG contracts and the monitoring policy of
+// the EPG with the supplied DN into the supplied parameters.
+func readRelations(a *aciclient.Client, epgDn string, observed *v1alpha1.EndpointGroupParameters) error {
+	refs, err := mo.ReadChildren(a, epgDn, fvRsIntraEpgClassName)
+	if err != nil {
+		return err
+	}
+	for _, r := range refs {
+		observed.IntraEpgContracts = append(observed.IntraEpgContracts, r["tnVzBrCPName"])
+	}
+	observed.MonitoringPolicy, err = mo.ReadAttribute(a, monPolDn(epgDn), fvRsAEPgMonPolClassName, "tnMonEPGPolName")
+	return err
+}
+
+// RelationsUpToDate returns whether the relations of the EPG with the
+// supplied DN match the supplied parameters, that is whether
+// ReconcileRelations would change nothing.
+func RelationsUpToDate(a *aciclient.Client, epgDn string, p v1alpha1.EndpointGroupParameters) bool {
+	observed := &v1alpha1.EndpointGroupParameters{}
+	if err := readRelations(a, epgDn, observed); err != nil {
+		return false
+	}
+	desired := &v1alpha1.EndpointGroupParameters{
+		IntraEpgContracts: p.IntraEpgContracts,
+		MonitoringPolicy:  p.MonitoringPolicy,
+	}
+	return cmp.Equal(observed, desired, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(x, y string) bool { return x < y }))
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.EndpointGroup, t map[string]string) bool {
+
+	dn := EndpointGroupDn(s)
+	securityDomains, err := securitydomainutil.DomainRefs(a, dn)
+	if err != nil {
+		return false
+	}
+	criteria, err := ReadUSegCriteria(a, dn)
+	if err != nil {
+		return false
+	}
 
 	observed := &v1alpha1.EndpointGroupParameters{
-		PreferedGroup:      t.PrefGrMemb,
-		BridgeDomain:       bdName,
+		Name:               t["name"],
 		Tenant:             s.Spec.ForProvider.Tenant,
 		ApplicationProfile: s.Spec.ForProvider.ApplicationProfile,
+		BridgeDomain:       BridgeDomainName(a, dn),
+		Description:        t["descr"],
+		NameAlias:          t["nameAlias"],
+		PreferedGroup:      t["prefGrMemb"],
+		PcEnfPref:          t["pcEnfPref"],
+		FloodOnEncap:       t["floodOnEncap"],
+		Prio:               t["prio"],
+		IsAttrBasedEPg:     t["isAttrBasedEPg"],
+		Shutdown:           t["shutdown"],
+		FwdCtrl:            t["fwdCtrl"],
+		USegCriteria:       criteria,
+		SecurityDomains:    securityDomains,
+	}
+	if err := readRelations(a, dn, observed); err != nil {
+		return false
 	}
+	// The name of the EPG defaults to the name of the EndpointGroup.
+	desired := s.Spec.ForProvider.DeepCopy()
+	desired.Name = Name(s)
 
-	return cmp.Equal(observed, &s.Spec.ForProvider)
+	return cmp.Equal(observed, desired, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(x, y string) bool { return x < y }),
+		cmpopts.SortSlices(func(x, y v1alpha1.USegIPAttribute) bool { return x.Name < y.Name }),
+		cmpopts.SortSlices(func(x, y v1alpha1.USegMACAttribute) bool { return x.Name < y.Name }),
+		cmpopts.SortSlices(func(x, y v1alpha1.USegVMAttribute) bool { return x.Name < y.Name }),
+		cmpopts.SortSlices(func(x, y v1alpha1.USegSubCriteria) bool { return x.Name < y.Name }),
+		cmp.Comparer(func(x, y v1alpha1.USegMACAttribute) bool { return x.Name == y.Name && strings.EqualFold(x.MAC, y.MAC) }))
 }
@@ -0,0 +1,201 @@
+package endpointgroup
+
+import (
+	"fmt"
+	"strconv"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/jgomezve/provider-aci/apis/application-management/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	fvCrtrnClassName   = "fvCrtrn"
+	fvSCrtrnClassName  = "fvSCrtrn"
+	fvIpAttrClassName  = "fvIpAttr"
+	fvMacAttrClassName = "fvMacAttr"
+	fvVmAttrClassName  = "fvVmAttr"
+)
+
+// CriteriaDn returns the DN of the uSeg criteria of the EPG with the
+// supplied DN.
+func CriteriaDn(epgDn string) string {
+	return fmt.Sprintf("%s/crtrn", epgDn)
+}
+
+// ValidateUSeg returns an error if uSeg criteria are set on an EPG that is
+// not attribute based.
+func ValidateUSeg(p v1alpha1.EndpointGroupParameters) error {
+	if p.USegCriteria != nil && p.IsAttrBasedEPg != "yes" {
+		return fmt.Errorf("uSeg criteria require isAttrBasedEPg to be yes")
+	}
+	return nil
+}
+
+func newVMAttributes(parentDn string, attrs []v1alpha1.USegVMAttribute) []*mo.Object {
+	objs := make([]*mo.Object, 0, len(attrs))
+	for _, v := range attrs {
+		objs = append(objs, mo.NewObject(fvVmAttrClassName, fmt.Sprintf("%s/vmattr-%s", parentDn, v.Name), map[string]string{
+			"name":      v.Name,
+			"type":      v.Type,
+			"operator":  v.Operator,
+			"value":     v.Value,
+			"labelName": v.LabelName,
+		}))
+	}
+	return objs
+}
+
+func newIPAttributes(parentDn string, attrs []v1alpha1.USegIPAttribute) []*mo.Object {
+	objs := make([]*mo.Object, 0, len(attrs))
+	for _, ip := range attrs {
+		objs = append(objs, mo.NewObject(fvIpAttrClassName, fmt.Sprintf("%s/ipattr-%s", parentDn, ip.Name), map[string]string{
+			"name":        ip.Name,
+			"ip":          ip.IP,
+			"usefvSubnet": ip.UseEpgSubnet,
+		}))
+	}
+	return objs
+}
+
+func newMACAttributes(parentDn string, attrs []v1alpha1.USegMACAttribute) []*mo.Object {
+	objs := make([]*mo.Object, 0, len(attrs))
+	for _, m := range attrs {
+		objs = append(objs, mo.NewObject(fvMacAttrClassName, fmt.Sprintf("%s/macattr-%s", parentDn, m.Name), map[string]string{
+			"name": m.Name,
+			"mac":  m.MAC,
+		}))
+	}
+	return objs
+}
+
+func newSubCriteria(parentDn string, subs []v1alpha1.USegSubCriteria) []*mo.Object {
+	objs := make([]*mo.Object, 0, len(subs))
+	for _, sc := range subs {
+		objs = append(objs, mo.NewObject(fvSCrtrnClassName, fmt.Sprintf("%s/crtrn-%s", parentDn, sc.Name), map[string]string{
+			"name":  sc.Name,
+			"match": sc.Match,
+		}))
+	}
+	return objs
+}
+
+func newCriteria(epgDn string, c *v1alpha1.USegCriteria) *mo.Object {
+	return mo.NewObject(fvCrtrnClassName, CriteriaDn(epgDn), map[string]string{
+		"match": c.Match,
+		"prec":  strconv.Itoa(c.Precedence),
+	})
+}
+
+func trees(objs []*mo.Object) []*mo.Tree {
+	t := make([]*mo.Tree, 0, len(objs))
+	for _, o := range objs {
+		t = append(t, o.Tree())
+	}
+	return t
+}
+
+// newUSegCriteriaTree returns the tree of the supplied uSeg criteria of the
+// EPG with the supplied DN.
+func newUSegCriteriaTree(epgDn string, c *v1alpha1.USegCriteria) *mo.Tree {
+	crtrn := newCriteria(epgDn, c)
+	children := trees(newIPAttributes(crtrn.Dn, c.IPAttributes))
+	children = append(children, trees(newMACAttributes(crtrn.Dn, c.MACAttributes))...)
+	children = append(children, trees(newVMAttributes(crtrn.Dn, c.VMAttributes))...)
+	for i, sub := range newSubCriteria(crtrn.Dn, c.SubCriteria) {
+		children = append(children, sub.Tree(trees(newVMAttributes(sub.Dn, c.SubCriteria[i].VMAttributes))...))
+	}
+	return crtrn.Tree(children...)
+}
+
+// ReconcileUSegCriteria converges the uSeg criteria of the EPG with the
+// supplied DN. The criteria of the EPG are deleted if none are set.
+func ReconcileUSegCriteria(a *aciclient.Client, epgDn string, p v1alpha1.EndpointGroupParameters) error {
+	c := p.USegCriteria
+	dn := CriteriaDn(epgDn)
+	if c == nil {
+		return a.DeleteByDn(dn, fvCrtrnClassName)
+	}
+	if err := a.Save(newCriteria(epgDn, c)); err != nil {
+		return err
+	}
+	if err := mo.ReconcileChildren(a, dn, fvIpAttrClassName, newIPAttributes(dn, c.IPAttributes)); err != nil {
+		return err
+	}
+	if err := mo.ReconcileChildren(a, dn, fvMacAttrClassName, newMACAttributes(dn, c.MACAttributes)); err != nil {
+		return err
+	}
+	if err := mo.ReconcileChildren(a, dn, fvVmAttrClassName, newVMAttributes(dn, c.VMAttributes)); err != nil {
+		return err
+	}
+	subs := newSubCriteria(dn, c.SubCriteria)
+	if err := mo.ReconcileChildren(a, dn, fvSCrtrnClassName, subs); err != nil {
+		return err
+	}
+	for i, sc := range c.SubCriteria {
+		if err := mo.ReconcileChildren(a, subs[i].Dn, fvVmAttrClassName, newVMAttributes(subs[i].Dn, sc.VMAttributes)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readVMAttributes(a *aciclient.Client, parentDn string) ([]v1alpha1.USegVMAttribute, error) {
+	children, err := mo.ReadChildren(a, parentDn, fvVmAttrClassName)
+	if err != nil {
+		return nil, err
+	}
+	var attrs []v1alpha1.USegVMAttribute
+	for _, v := range children {
+		attrs = append(attrs, v1alpha1.USegVMAttribute{
+			Name:      v["name"],
+			Type:      v["type"],
+			Operator:  v["operator"],
+			Value:     v["value"],
+			LabelName: v["labelName"],
+		})
+	}
+	return attrs, nil
+}
+
+// ReadUSegCriteria returns the uSeg criteria of the EPG with the supplied
+// DN, or nil if it has none.
+func ReadUSegCriteria(a *aciclient.Client, epgDn string) (*v1alpha1.USegCriteria, error) {
+	dn := CriteriaDn(epgDn)
+	crtrn, err := mo.Read(a, dn, fvCrtrnClassName)
+	if err != nil || crtrn == nil {
+		return nil, err
+	}
+	prec, _ := strconv.Atoi(crtrn["prec"])
+	c := &v1alpha1.USegCriteria{Match: crtrn["match"], Precedence: prec}
+
+	ips, err := mo.ReadChildren(a, dn, fvIpAttrClassName)
+	if err != nil {
+		return nil, err
+	}
+	for _, ip := range ips {
+		c.IPAttributes = append(c.IPAttributes, v1alpha1.USegIPAttribute{Name: ip["name"], IP: ip["ip"], UseEpgSubnet: ip["usefvSubnet"]})
+	}
+	macs, err := mo.ReadChildren(a, dn, fvMacAttrClassName)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range macs {
+		c.MACAttributes = append(c.MACAttributes, v1alpha1.USegMACAttribute{Name: m["name"], MAC: m["mac"]})
+	}
+	if c.VMAttributes, err = readVMAttributes(a, dn); err != nil {
+		return nil, err
+	}
+	subs, err := mo.ReadChildren(a, dn, fvSCrtrnClassName)
+	if err != nil {
+		return nil, err
+	}
+	for _, sc := range subs {
+		vms, err := readVMAttributes(a, sc["dn"])
+		if err != nil {
+			return nil, err
+		}
+		c.SubCriteria = append(c.SubCriteria, v1alpha1.USegSubCriteria{Name: sc["name"], Match: sc["match"], VMAttributes: vms})
+	}
+	return c, nil
+}
@@ -0,0 +1,94 @@
+package expiry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// FieldPathExpiresAt is the field path of when a managed resource that
+	// expires is deleted.
+	FieldPathExpiresAt = "status.atProvider.expiresAt"
+
+	reasonExpired event.Reason = "Expired"
+
+	errDeleteExpired = "cannot delete expired managed resource"
+)
+
+// ExpiresAt returns when the supplied managed resource expires if it expires
+// the supplied duration after its creation, or nil if the duration is nil.
+func ExpiresAt(mg resource.Managed, expireAfter *metav1.Duration) *metav1.Time {
+	if expireAfter == nil {
+		return nil
+	}
+	t := metav1.NewTime(mg.GetCreationTimestamp().Add(expireAfter.Duration))
+	return &t
+}
+
+// DeleteIfExpired deletes the supplied managed resource if it expired at the
+// supplied time, which deletes its external resource too, and returns whether
+// it did. Expiry is checked when the managed resource is observed, which a
+// Reconciler requeues at the supplied time.
+func DeleteIfExpired(ctx context.Context, kube client.Client, recorder event.Recorder, mg resource.Managed, expiresAt *metav1.Time) (bool, error) {
+	if expiresAt == nil || meta.WasDeleted(mg) || time.Now().Before(expiresAt.Time) {
+		return false, nil
+	}
+	recorder.Event(mg, event.Normal(reasonExpired, fmt.Sprintf("Deleting, expired at %s", expiresAt.Format(time.RFC3339))))
+	if err := kube.Delete(ctx, mg); resource.IgnoreNotFound(err) != nil {
+		return false, errors.Wrap(err, errDeleteExpired)
+	}
+	return true, nil
+}
+
+// A Reconciler requeues the managed resources it reconciles when they expire,
+// so that they are deleted on time whatever their poll interval.
+type Reconciler struct {
+	reconcile.Reconciler
+	kube       client.Client
+	newManaged func() resource.Managed
+}
+
+// NewReconciler returns a Reconciler that requeues the managed resources
+// reconciled by the supplied reconciler when they expire. The supplied
+// function returns an empty managed resource of the reconciled kind.
+func NewReconciler(r reconcile.Reconciler, kube client.Client, newManaged func() resource.Managed) *Reconciler {
+	return &Reconciler{Reconciler: r, kube: kube, newManaged: newManaged}
+}
+
+// Reconcile reconciles the requested managed resource and requeues it when it
+// expires if that is sooner than its requeue.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	res, err := r.Reconciler.Reconcile(ctx, req)
+	if err != nil || res.Requeue && res.RequeueAfter == 0 {
+		return res, err
+	}
+	mg := r.newManaged()
+	if err := r.kube.Get(ctx, req.NamespacedName, mg); err != nil || meta.WasDeleted(mg) {
+		return res, nil
+	}
+	p, err := fieldpath.PaveObject(mg)
+	if err != nil {
+		return res, nil
+	}
+	at, _ := p.GetString(FieldPathExpiresAt)
+	expiresAt, err := time.Parse(time.RFC3339, at)
+	if err != nil {
+		return res, nil
+	}
+	// The expiry is stored to the second, requeue once it has passed.
+	after := time.Until(expiresAt.Add(time.Second))
+	if after > 0 && (res.RequeueAfter == 0 || after < res.RequeueAfter) {
+		res.RequeueAfter = after
+	}
+	return res, nil
+}
@@ -0,0 +1,349 @@
+// Package fakeapic is a fake APIC to test controllers against.
+package fakeapic
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	loginPath  = "/api/aaaLogin.json"
+	socketPath = "/socket"
+	moPath     = "/api/node/mo/"
+)
+
+// A Request is a request received by a Server.
+type Request struct {
+	Method string
+	Path   string
+	Body   map[string]interface{}
+}
+
+// A Server is a fake APIC. It authenticates any user, returns no objects
+// unless told otherwise and accepts any change, except for the POSTs and
+// GETs it is told to fail. It accepts any subscription and sends the
+// notifications it is told to on its websockets.
+type Server struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	requests      []Request
+	failures      map[string]string
+	getFailures   map[string]string
+	responses     map[string]string
+	children      map[string]string
+	subscriptions map[string]string
+	sockets       map[*websocket.Conn]bool
+}
+
+// New returns a started fake APIC. It has to be closed when done.
+func New() *Server {
+	s := &Server{
+		failures:      map[string]string{},
+		getFailures:   map[string]string{},
+		responses:     map[string]string{},
+		children:      map[string]string{},
+		subscriptions: map[string]string{},
+		sockets:       map[*websocket.Conn]bool{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// FailPost makes the POSTs to the supplied path, e.g.
+// /api/node/mo/uni/tn-a/BD-b.json, fail with the supplied error text. Like
+// the APIC, none of the objects of a failed POST are changed.
+func (s *Server) FailPost(path, text string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[path] = text
+}
+
+// FailGet makes the GETs of the supplied path, e.g.
+// /api/node/mo/uni/tn-a.json, fail with the supplied error text.
+func (s *Server) FailGet(path, text string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.getFailures[path] = text
+}
+
+// RespondGet makes the GETs of the object of the supplied path, e.g.
+// /api/node/mo/uni/tn-a.json, return the supplied JSON body, whatever their
+// rsp-subtree query. The GETs of the children or the subtree of the object
+// still return no objects.
+func (s *Server) RespondGet(path, body string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.responses[path] = body
+}
+
+// APICClient returns a client of the fake APIC.
+func (s *Server) APICClient() *aciclient.Client {
+	return aciclient.NewClient(s.URL, "admin", aciclient.Password("password"), aciclient.Insecure(true))
+}
+
+// RespondChildren makes the GETs of the children of the object of the
+// supplied path, e.g. /api/node/mo/uni/tn-a.json, return the supplied JSON
+// body. Like the APIC, only the children of the classes of the
+// target-subtree-class query, if any, are returned.
+func (s *Server) RespondChildren(path, body string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.children[path] = body
+}
+
+// SubscriptionID returns the ID of the subscription to the object with the
+// supplied DN, or an empty string if it is not subscribed to.
+func (s *Server) SubscriptionID(dn string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.subscriptions[dn]
+}
+
+// Notify sends a change notification of the subscriptions with the supplied
+// IDs on all the websockets.
+func (s *Server) Notify(ids ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for conn := range s.sockets {
+		_ = websocket.JSON.Send(conn, map[string]interface{}{"subscriptionId": ids, "imdata": []interface{}{}})
+	}
+}
+
+// Disconnect closes all the websockets.
+func (s *Server) Disconnect() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for conn := range s.sockets {
+		_ = conn.Close()
+		delete(s.sockets, conn)
+	}
+}
+
+// Posts returns the POSTs received by the fake APIC, except for the logins,
+// in the order they were received.
+func (s *Server) Posts() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var posts []Request
+	for _, r := range s.requests {
+		if r.Method == http.MethodPost {
+			posts = append(posts, r)
+		}
+	}
+	return posts
+}
+
+// Attribute returns the supplied attribute of the object of the supplied
+// class of a POST body, or an empty string if there is none.
+func Attribute(body map[string]interface{}, className, attr string) string {
+	obj, _ := body[className].(map[string]interface{})
+	attrs, _ := obj["attributes"].(map[string]interface{})
+	v, _ := attrs[attr].(string)
+	return v
+}
+
+// ChildAttribute returns the supplied attribute of the first child of the
+// supplied class of the object of the supplied class of a POST body, or an
+// empty string if there is none.
+func ChildAttribute(body map[string]interface{}, className, childClassName, attr string) string {
+	obj, _ := body[className].(map[string]interface{})
+	children, _ := obj["children"].([]interface{})
+	for _, c := range children {
+		child, _ := c.(map[string]interface{})
+		if o, ok := child[childClassName].(map[string]interface{}); ok {
+			attrs, _ := o["attributes"].(map[string]interface{})
+			v, _ := attrs[attr].(string)
+			return v
+		}
+	}
+	return ""
+}
+
+// ChildClasses returns the sorted classes of the children of the object of
+// the supplied class of a POST body.
+func ChildClasses(body map[string]interface{}, className string) []string {
+	obj, _ := body[className].(map[string]interface{})
+	children, _ := obj["children"].([]interface{})
+	var classes []string
+	for _, c := range children {
+		child, _ := c.(map[string]interface{})
+		for class := range child {
+			classes = append(classes, class)
+		}
+	}
+	sort.Strings(classes)
+	return classes
+}
+
+// Deleted returns the sorted DNs of the objects deleted by the supplied
+// POSTs, including their children.
+func Deleted(posts []Request) []string {
+	return dns(posts, func(_ string, attrs map[string]interface{}) bool {
+		return attrs["status"] == "deleted"
+	})
+}
+
+// Saved returns the sorted DNs of the objects of the supplied class created
+// or changed by the supplied POSTs, including their children.
+func Saved(posts []Request, className string) []string {
+	return dns(posts, func(class string, attrs map[string]interface{}) bool {
+		return class == className && attrs["status"] != "deleted"
+	})
+}
+
+func dns(posts []Request, match func(className string, attrs map[string]interface{}) bool) []string {
+	var found []string
+	var walk func(body map[string]interface{})
+	walk = func(body map[string]interface{}) {
+		for class, v := range body {
+			obj, _ := v.(map[string]interface{})
+			attrs, _ := obj["attributes"].(map[string]interface{})
+			if dn, _ := attrs["dn"].(string); dn != "" && match(class, attrs) {
+				found = append(found, dn)
+			}
+			children, _ := obj["children"].([]interface{})
+			for _, c := range children {
+				child, _ := c.(map[string]interface{})
+				walk(child)
+			}
+		}
+	}
+	for _, p := range posts {
+		walk(p.Body)
+	}
+	sort.Strings(found)
+	return found
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == loginPath {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"totalCount": "1",
+			"imdata": []interface{}{map[string]interface{}{"aaaLogin": map[string]interface{}{"attributes": map[string]string{
+				"token":                 "token",
+				"creationTime":          fmt.Sprintf("%d", time.Now().Unix()),
+				"refreshTimeoutSeconds": "600",
+			}}}},
+		})
+		return
+	}
+	if strings.HasPrefix(r.URL.Path, socketPath) {
+		websocket.Handler(s.socket).ServeHTTP(w, r)
+		return
+	}
+
+	req := Request{Method: r.Method, Path: r.URL.Path}
+	if body, err := io.ReadAll(r.Body); err == nil && len(body) > 0 {
+		_ = json.Unmarshal(body, &req.Body)
+	}
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	text, fail := s.failures[r.URL.Path]
+	if r.Method == http.MethodGet {
+		text, fail = s.getFailures[r.URL.Path]
+	}
+	body, respond := s.responses[r.URL.Path]
+	switch r.URL.Query().Get("query-target") {
+	case "":
+	case "children":
+		body, respond = s.children[r.URL.Path]
+		if classes := r.URL.Query().Get("target-subtree-class"); respond && classes != "" {
+			body = filter(body, strings.Split(classes, ","))
+		}
+	default:
+		respond = false
+	}
+	s.mu.Unlock()
+
+	if respond && r.Method == http.MethodGet {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, body)
+		return
+	}
+
+	if fail {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{
+			"totalCount": "1",
+			"imdata": []interface{}{map[string]interface{}{"error": map[string]interface{}{"attributes": map[string]string{
+				"code": "122",
+				"text": text,
+			}}}},
+		})
+		return
+	}
+	if r.URL.Query().Get("subscription") == "yes" {
+		// A subtree query returns at least the subscribed object, whatever
+		// its class.
+		dn, id := s.subscribe(r.URL.Path)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"totalCount":     "1",
+			"subscriptionId": id,
+			"imdata":         []interface{}{map[string]interface{}{"object": map[string]interface{}{"attributes": map[string]string{"dn": dn}}}},
+		})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"totalCount": "0", "imdata": []interface{}{}})
+}
+
+func (s *Server) subscribe(path string) (string, string) {
+	dn := strings.TrimSuffix(strings.TrimPrefix(path, moPath), ".json")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id, ok := s.subscriptions[dn]
+	if !ok {
+		id = fmt.Sprintf("%d", len(s.subscriptions)+1)
+		s.subscriptions[dn] = id
+	}
+	return dn, id
+}
+
+// socket serves a websocket until it is closed.
+func (s *Server) socket(conn *websocket.Conn) {
+	s.mu.Lock()
+	s.sockets[conn] = true
+	s.mu.Unlock()
+	var discard interface{}
+	for websocket.JSON.Receive(conn, &discard) == nil {
+	}
+	s.mu.Lock()
+	delete(s.sockets, conn)
+	s.mu.Unlock()
+}
+
+// filter returns the supplied JSON body with only the objects of the
+// supplied classes.
+func filter(body string, classes []string) string {
+	var resp struct {
+		Imdata []map[string]interface{} `json:"imdata"`
+	}
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		return body
+	}
+	imdata := []interface{}{}
+	for _, o := range resp.Imdata {
+		for _, c := range classes {
+			if _, ok := o[c]; ok {
+				imdata = append(imdata, o)
+			}
+		}
+	}
+	filtered, _ := json.Marshal(map[string]interface{}{"totalCount": fmt.Sprintf("%d", len(imdata)), "imdata": imdata})
+	return string(filtered)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
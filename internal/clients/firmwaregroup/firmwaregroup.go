@@ -0,0 +1,121 @@
+package firmwaregroup
+
+import (
+	"fmt"
+	"strconv"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	FirmwareFwGrpClassName    = "firmwareFwGrp"
+	FirmwareFwPClassName      = "firmwareFwP"
+	firmwareRsFwgrppClassName = "firmwareRsFwgrpp"
+	fabricNodeBlkClassName    = "fabricNodeBlk"
+)
+
+// GroupDn returns the DN of the firmware group with the supplied name.
+func GroupDn(name string) string {
+	return fmt.Sprintf("uni/fabric/fwgrp-%s", name)
+}
+
+// PolicyDn returns the DN of the firmware policy with the supplied name.
+func PolicyDn(name string) string {
+	return fmt.Sprintf("uni/fabric/fwpol-%s", name)
+}
+
+// NewNodeBlock returns the fabricNodeBlk b of the group with the supplied DN.
+func NewNodeBlock(groupDn string, b v1alpha1.NodeBlock) *mo.Object {
+	return mo.NewObject(fabricNodeBlkClassName, fmt.Sprintf("%s/nodeblk-%s", groupDn, b.Name), map[string]string{
+		"name":  b.Name,
+		"from_": strconv.Itoa(b.From),
+		"to_":   strconv.Itoa(b.To),
+	})
+}
+
+// ReadNodeBlocks returns the node blocks of the group with the supplied DN.
+func ReadNodeBlocks(a *aciclient.Client, groupDn string) ([]v1alpha1.NodeBlock, error) {
+	children, err := mo.ReadChildren(a, groupDn, fabricNodeBlkClassName)
+	if err != nil {
+		return nil, err
+	}
+	var blocks []v1alpha1.NodeBlock
+	for _, c := range children {
+		from, _ := strconv.Atoi(c["from_"])
+		to, _ := strconv.Atoi(c["to_"])
+		blocks = append(blocks, v1alpha1.NodeBlock{Name: c["name"], From: from, To: to})
+	}
+	return blocks, nil
+}
+
+// ReconcileNodeBlocks converges the node blocks of the group with the
+// supplied DN with the desired ones.
+func ReconcileNodeBlocks(a *aciclient.Client, groupDn string, desired []v1alpha1.NodeBlock) error {
+	objs := make([]*mo.Object, 0, len(desired))
+	for _, b := range desired {
+		objs = append(objs, NewNodeBlock(groupDn, b))
+	}
+	return mo.ReconcileChildren(a, groupDn, fabricNodeBlkClassName, objs)
+}
+
+// NewPolicy returns the firmwareFwP that holds the target version of the
+// supplied firmware group.
+func NewPolicy(p v1alpha1.FirmwareGroupParameters) *mo.Object {
+	return mo.NewObject(FirmwareFwPClassName, PolicyDn(p.Name), map[string]string{
+		"name":         p.Name,
+		"version":      p.Version,
+		"ignoreCompat": p.IgnoreCompatibility,
+	})
+}
+
+// SavePolicyRelation points the firmware group with the supplied DN to the
+// firmware policy with the supplied name.
+func SavePolicyRelation(a *aciclient.Client, groupDn, policy string) error {
+	return a.Save(mo.NewObject(firmwareRsFwgrppClassName, groupDn+"/rsfwgrpp", map[string]string{
+		"tnFirmwareFwPName": policy,
+	}))
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.FirmwareGroup, t map[string]string) bool {
+
+	dn := GroupDn(s.Spec.ForProvider.Name)
+	policy, err := mo.Read(a, PolicyDn(s.Spec.ForProvider.Name), FirmwareFwPClassName)
+	if err != nil || policy == nil {
+		return false
+	}
+	rel, err := mo.Read(a, dn+"/rsfwgrpp", firmwareRsFwgrppClassName)
+	if err != nil || rel == nil || rel["tnFirmwareFwPName"] != s.Spec.ForProvider.Name {
+		return false
+	}
+	blocks, err := ReadNodeBlocks(a, dn)
+	if err != nil {
+		return false
+	}
+
+	observed := &v1alpha1.FirmwareGroupParameters{
+		Name:                t["name"],
+		Description:         t["descr"],
+		Version:             policy["version"],
+		IgnoreCompatibility: policy["ignoreCompat"],
+		NodeBlocks:          blocks,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(x, y v1alpha1.NodeBlock) bool { return x.Name < y.Name }))
+}
+
+// SaveChildren saves the firmware policy of the group with the supplied DN,
+// points the group to it and converges its node blocks.
+func SaveChildren(a *aciclient.Client, groupDn string, p v1alpha1.FirmwareGroupParameters) error {
+	if err := a.Save(NewPolicy(p)); err != nil {
+		return err
+	}
+	if err := SavePolicyRelation(a, groupDn, p.Name); err != nil {
+		return err
+	}
+	return ReconcileNodeBlocks(a, groupDn, p.NodeBlocks)
+}
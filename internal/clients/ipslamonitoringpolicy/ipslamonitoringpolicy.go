@@ -0,0 +1,51 @@
+package ipslamonitoringpolicy
+
+import (
+	"fmt"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/services/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const FvIPSLAMonitoringPolClassName = "fvIPSLAMonitoringPol"
+
+// PolicyDn returns the DN of the IP SLA monitoring policy with the supplied
+// name of the supplied tenant.
+func PolicyDn(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/ipslaMonitoringPol-%s", tenant, name)
+}
+
+// NewPolicy returns the fvIPSLAMonitoringPol of the supplied IP SLA
+// monitoring policy.
+func NewPolicy(p v1alpha1.IPSLAMonitoringPolicyParameters) *mo.Object {
+	return mo.NewObject(FvIPSLAMonitoringPolClassName, PolicyDn(p.Tenant, p.Name), map[string]string{
+		"name":                p.Name,
+		"descr":               p.Description,
+		"slaType":             p.SlaType,
+		"slaPort":             p.SlaPort,
+		"slaFrequency":        p.SlaFrequency,
+		"slaDetectMultiplier": p.DetectMultiplier,
+		"threshold":           p.Threshold,
+		"timeout":             p.Timeout,
+	})
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.IPSLAMonitoringPolicy, t map[string]string) bool {
+
+	observed := &v1alpha1.IPSLAMonitoringPolicyParameters{
+		Name:             t["name"],
+		Tenant:           s.Spec.ForProvider.Tenant,
+		Description:      t["descr"],
+		SlaType:          t["slaType"],
+		SlaPort:          t["slaPort"],
+		SlaFrequency:     t["slaFrequency"],
+		DetectMultiplier: t["slaDetectMultiplier"],
+		Threshold:        t["threshold"],
+		Timeout:          t["timeout"],
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty())
+}
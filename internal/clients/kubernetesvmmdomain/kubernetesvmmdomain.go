@@ -0,0 +1,110 @@
+package kubernetesvmmdomain
+
+import (
+	"fmt"
+	"strings"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/ciscoecosystem/aci-go-client/v2/models"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/vmm/v1alpha1"
+	vmmdomainutil "github.com/jgomezve/provider-aci/internal/clients/vmmdomain"
+)
+
+// DomainDn returns the DN of the Kubernetes VMM domain with the supplied name.
+func DomainDn(name string) string {
+	return fmt.Sprintf("uni/vmmp-Kubernetes/dom-%s", name)
+}
+
+// MulticastPoolDn returns the DN of the multicast address pool with the
+// supplied name.
+func MulticastPoolDn(name string) string {
+	return fmt.Sprintf("uni/infra/maddrns-%s", name)
+}
+
+// SubnetDn returns the DN of the supplied cluster subnet, or an empty string
+// if no subnet is supplied.
+func SubnetDn(s *v1alpha1.ClusterSubnet) string {
+	if s == nil {
+		return ""
+	}
+	return fmt.Sprintf("uni/tn-%s/BD-%s/subnet-[%s]", s.Tenant, s.BridgeDomain, s.Gateway)
+}
+
+// Exists returns whether the object with the supplied DN exists.
+func Exists(a *aciclient.Client, dn string) (bool, error) {
+	cont, err := a.Get(dn)
+	if err != nil {
+		if fmt.Sprintf("%s", err) == "Error retrieving Object: Object may not exist" {
+			return false, nil
+		}
+		return false, err
+	}
+	return models.G(cont, "totalCount") != "0", nil
+}
+
+// IsStale returns whether a subnet configured by a previous spec, recorded
+// with lastDn, differs from the desired one and has to be removed.
+func IsStale(lastDn string, desired *v1alpha1.ClusterSubnet) bool {
+	return lastDn != "" && lastDn != SubnetDn(desired)
+}
+
+func observedSubnet(a *aciclient.Client, desired *v1alpha1.ClusterSubnet) *v1alpha1.ClusterSubnet {
+	dn := SubnetDn(desired)
+	if dn == "" {
+		return nil
+	}
+	if ok, err := Exists(a, dn); err != nil || !ok {
+		return nil
+	}
+	return desired
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.KubernetesVMMDomain, t models.VMMDomainAttributes) bool {
+
+	dn := DomainDn(s.Spec.ForProvider.Name)
+
+	if IsStale(s.Status.AtProvider.NodeSubnetDn, s.Spec.ForProvider.NodeSubnet) ||
+		IsStale(s.Status.AtProvider.ServiceSubnetDn, s.Spec.ForProvider.ServiceSubnet) {
+		return false
+	}
+
+	vlanPool := ""
+	vlanPoolAllocationMode := s.Spec.ForProvider.VlanPoolAllocationMode
+	infraRsVlanNsData, err := a.ReadRelationinfraRsVlanNsFromVMMDomain(dn)
+	if err == nil && infraRsVlanNsData != nil {
+		vlanPool, vlanPoolAllocationMode = vmmdomainutil.VlanPoolFromDn(infraRsVlanNsData.(string))
+	}
+
+	multicastPool := ""
+	vmmRsDomMcastAddrNsData, err := a.ReadRelationvmmRsDomMcastAddrNsFromVMMDomain(dn)
+	if err == nil && vmmRsDomMcastAddrNsData != nil {
+		multicastPool = strings.TrimPrefix(vmmRsDomMcastAddrNsData.(string), "uni/infra/maddrns-")
+	}
+
+	vmmCtrlrPCont, err := a.Get(fmt.Sprintf("%s/ctrlr-%s", dn, s.Spec.ForProvider.Controller.Name))
+	if err != nil {
+		return false
+	}
+	vmmCtrlrP := models.VMMControllerFromContainer(vmmCtrlrPCont)
+
+	observed := &v1alpha1.KubernetesVMMDomainParameters{
+		Name:                   t.Name,
+		NameAlias:              t.NameAlias,
+		EncapMode:              t.EncapMode,
+		VlanPool:               vlanPool,
+		VlanPoolAllocationMode: vlanPoolAllocationMode,
+		MulticastPool:          multicastPool,
+		MulticastAddress:       t.McastAddr,
+		Controller: v1alpha1.KubernetesVMMController{
+			Name:        vmmCtrlrP.Name,
+			HostOrIP:    vmmCtrlrP.HostOrIp,
+			ClusterName: vmmCtrlrP.RootContName,
+		},
+		NodeSubnet:    observedSubnet(a, s.Spec.ForProvider.NodeSubnet),
+		ServiceSubnet: observedSubnet(a, s.Spec.ForProvider.ServiceSubnet),
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,275 @@
+package l4l7device
+
+import (
+	"fmt"
+	"strings"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/services/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	VnsLDevVipClassName           = "vnsLDevVip"
+	vnsRsALDevToPhysDomPClassName = "vnsRsALDevToPhysDomP"
+	vnsRsALDevToDomPClassName     = "vnsRsALDevToDomP"
+	vnsCDevClassName              = "vnsCDev"
+	vnsCIfClassName               = "vnsCIf"
+	vnsRsCIfPathAttClassName      = "vnsRsCIfPathAtt"
+	vnsLIfClassName               = "vnsLIf"
+	vnsRsCIfAttNClassName         = "vnsRsCIfAttN"
+
+	physDomPrefix = "uni/phys-"
+	vmmDomPrefix  = "uni/vmmp-VMware/dom-"
+)
+
+// DeviceDn returns the DN of the logical device with the supplied name of
+// the supplied tenant.
+func DeviceDn(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/lDevVip-%s", tenant, name)
+}
+
+// ConcreteDeviceDn returns the DN of the concrete device with the supplied
+// name of the logical device with the supplied DN.
+func ConcreteDeviceDn(deviceDn, name string) string {
+	return fmt.Sprintf("%s/cDev-%s", deviceDn, name)
+}
+
+// ConcreteInterfaceDn returns the DN of the interface with the supplied name
+// of the concrete device with the supplied DN.
+func ConcreteInterfaceDn(cDevDn, name string) string {
+	return fmt.Sprintf("%s/cIf-[%s]", cDevDn, name)
+}
+
+// LogicalInterfaceDn returns the DN of the logical interface with the
+// supplied name of the logical device with the supplied DN.
+func LogicalInterfaceDn(deviceDn, name string) string {
+	return fmt.Sprintf("%s/lIf-%s", deviceDn, name)
+}
+
+// concreteInterfaceRef returns the DN of the concrete interface referenced
+// in the device/interface form by a logical interface.
+func concreteInterfaceRef(deviceDn, ref string) string {
+	parts := strings.SplitN(ref, "/", 2)
+	if len(parts) != 2 {
+		return ""
+	}
+	return ConcreteInterfaceDn(ConcreteDeviceDn(deviceDn, parts[0]), parts[1])
+}
+
+// concreteInterfaceFromDn is the inverse of concreteInterfaceRef.
+func concreteInterfaceFromDn(deviceDn, dn string) string {
+	ref := strings.TrimPrefix(dn, deviceDn+"/cDev-")
+	ref = strings.Replace(ref, "/cIf-[", "/", 1)
+	return strings.TrimSuffix(ref, "]")
+}
+
+// Validate returns an error if a logical interface references a concrete
+// interface that is not part of the device.
+func Validate(p v1alpha1.L4L7DeviceParameters) error {
+	known := map[string]bool{}
+	for _, d := range p.ConcreteDevices {
+		for _, i := range d.Interfaces {
+			known[d.Name+"/"+i.Name] = true
+		}
+	}
+	for _, l := range p.LogicalInterfaces {
+		for _, ref := range l.ConcreteInterfaces {
+			if !known[ref] {
+				return fmt.Errorf("logical interface %s references unknown concrete interface %s", l.Name, ref)
+			}
+		}
+	}
+	return nil
+}
+
+// NewDevice returns the vnsLDevVip of the supplied logical device. Only
+// unmanaged devices are supported.
+func NewDevice(p v1alpha1.L4L7DeviceParameters) *mo.Object {
+	return mo.NewObject(VnsLDevVipClassName, DeviceDn(p.Tenant, p.Name), map[string]string{
+		"name":         p.Name,
+		"descr":        p.Description,
+		"devtype":      p.DeviceType,
+		"svcType":      p.ServiceType,
+		"funcType":     p.FunctionType,
+		"contextAware": p.ContextAware,
+		"managed":      "no",
+	})
+}
+
+// ReconcileChildren converges the domain, the concrete devices with their
+// interfaces and the logical interfaces of the logical device with the
+// supplied DN.
+func ReconcileChildren(a *aciclient.Client, deviceDn string, p v1alpha1.L4L7DeviceParameters) error {
+	physDom, vmmDom := "", ""
+	if p.DeviceType == "VIRTUAL" {
+		vmmDom = vmmDomPrefix + p.Domain
+	} else {
+		physDom = physDomPrefix + p.Domain
+	}
+	if err := mo.SaveRelation(a, fmt.Sprintf("%s/rsALDevToPhysDomP", deviceDn), vnsRsALDevToPhysDomPClassName, physDom); err != nil {
+		return err
+	}
+	if err := mo.SaveRelation(a, fmt.Sprintf("%s/rsALDevToDomP", deviceDn), vnsRsALDevToDomPClassName, vmmDom); err != nil {
+		return err
+	}
+
+	cDevs := make([]*mo.Object, 0, len(p.ConcreteDevices))
+	for _, d := range p.ConcreteDevices {
+		cDevs = append(cDevs, mo.NewObject(vnsCDevClassName, ConcreteDeviceDn(deviceDn, d.Name), map[string]string{
+			"name":        d.Name,
+			"vmName":      d.VMName,
+			"vcenterName": d.VCenterName,
+		}))
+	}
+	if err := mo.ReconcileChildren(a, deviceDn, vnsCDevClassName, cDevs); err != nil {
+		return err
+	}
+	for _, d := range p.ConcreteDevices {
+		cDevDn := ConcreteDeviceDn(deviceDn, d.Name)
+		cIfs := make([]*mo.Object, 0, len(d.Interfaces))
+		for _, i := range d.Interfaces {
+			cIfs = append(cIfs, mo.NewObject(vnsCIfClassName, ConcreteInterfaceDn(cDevDn, i.Name), map[string]string{
+				"name":     i.Name,
+				"vnicName": i.VnicName,
+			}))
+		}
+		if err := mo.ReconcileChildren(a, cDevDn, vnsCIfClassName, cIfs); err != nil {
+			return err
+		}
+		for _, i := range d.Interfaces {
+			dn := fmt.Sprintf("%s/rsCIfPathAtt", ConcreteInterfaceDn(cDevDn, i.Name))
+			if err := mo.SaveRelation(a, dn, vnsRsCIfPathAttClassName, i.PathDn); err != nil {
+				return err
+			}
+		}
+	}
+
+	lIfs := make([]*mo.Object, 0, len(p.LogicalInterfaces))
+	for _, l := range p.LogicalInterfaces {
+		lIfs = append(lIfs, mo.NewObject(vnsLIfClassName, LogicalInterfaceDn(deviceDn, l.Name), map[string]string{
+			"name":  l.Name,
+			"encap": l.Encap,
+		}))
+	}
+	if err := mo.ReconcileChildren(a, deviceDn, vnsLIfClassName, lIfs); err != nil {
+		return err
+	}
+	for _, l := range p.LogicalInterfaces {
+		lIfDn := LogicalInterfaceDn(deviceDn, l.Name)
+		refs := make([]*mo.Object, 0, len(l.ConcreteInterfaces))
+		for _, ref := range l.ConcreteInterfaces {
+			tDn := concreteInterfaceRef(deviceDn, ref)
+			refs = append(refs, mo.NewObject(vnsRsCIfAttNClassName, fmt.Sprintf("%s/rsCIfAttN-[%s]", lIfDn, tDn), map[string]string{
+				"tDn": tDn,
+			}))
+		}
+		if err := mo.ReconcileChildren(a, lIfDn, vnsRsCIfAttNClassName, refs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readConcreteDevices(a *aciclient.Client, deviceDn string) ([]v1alpha1.ConcreteDevice, error) {
+	cDevs, err := mo.ReadChildren(a, deviceDn, vnsCDevClassName)
+	if err != nil {
+		return nil, err
+	}
+	var devices []v1alpha1.ConcreteDevice
+	for _, d := range cDevs {
+		cIfs, err := mo.ReadChildren(a, d["dn"], vnsCIfClassName)
+		if err != nil {
+			return nil, err
+		}
+		var interfaces []v1alpha1.ConcreteInterface
+		for _, i := range cIfs {
+			path, err := mo.ReadRelation(a, fmt.Sprintf("%s/rsCIfPathAtt", i["dn"]), vnsRsCIfPathAttClassName)
+			if err != nil {
+				return nil, err
+			}
+			interfaces = append(interfaces, v1alpha1.ConcreteInterface{
+				Name:     i["name"],
+				PathDn:   path,
+				VnicName: i["vnicName"],
+			})
+		}
+		devices = append(devices, v1alpha1.ConcreteDevice{
+			Name:        d["name"],
+			VMName:      d["vmName"],
+			VCenterName: d["vcenterName"],
+			Interfaces:  interfaces,
+		})
+	}
+	return devices, nil
+}
+
+func readLogicalInterfaces(a *aciclient.Client, deviceDn string) ([]v1alpha1.LogicalInterface, error) {
+	lIfs, err := mo.ReadChildren(a, deviceDn, vnsLIfClassName)
+	if err != nil {
+		return nil, err
+	}
+	var interfaces []v1alpha1.LogicalInterface
+	for _, l := range lIfs {
+		refs, err := mo.ReadChildren(a, l["dn"], vnsRsCIfAttNClassName)
+		if err != nil {
+			return nil, err
+		}
+		var cIfs []string
+		for _, r := range refs {
+			cIfs = append(cIfs, concreteInterfaceFromDn(deviceDn, r["tDn"]))
+		}
+		interfaces = append(interfaces, v1alpha1.LogicalInterface{
+			Name:               l["name"],
+			Encap:              l["encap"],
+			ConcreteInterfaces: cIfs,
+		})
+	}
+	return interfaces, nil
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.L4L7Device, t map[string]string) bool {
+
+	dn := DeviceDn(s.Spec.ForProvider.Tenant, s.Spec.ForProvider.Name)
+	var domain string
+	var err error
+	if t["devtype"] == "VIRTUAL" {
+		domain, err = mo.ReadRelation(a, fmt.Sprintf("%s/rsALDevToDomP", dn), vnsRsALDevToDomPClassName)
+		domain = strings.TrimPrefix(domain, vmmDomPrefix)
+	} else {
+		domain, err = mo.ReadRelation(a, fmt.Sprintf("%s/rsALDevToPhysDomP", dn), vnsRsALDevToPhysDomPClassName)
+		domain = strings.TrimPrefix(domain, physDomPrefix)
+	}
+	if err != nil {
+		return false
+	}
+	devices, err := readConcreteDevices(a, dn)
+	if err != nil {
+		return false
+	}
+	interfaces, err := readLogicalInterfaces(a, dn)
+	if err != nil {
+		return false
+	}
+
+	observed := &v1alpha1.L4L7DeviceParameters{
+		Name:              t["name"],
+		Tenant:            s.Spec.ForProvider.Tenant,
+		Description:       t["descr"],
+		DeviceType:        t["devtype"],
+		ServiceType:       t["svcType"],
+		FunctionType:      t["funcType"],
+		ContextAware:      t["contextAware"],
+		Domain:            domain,
+		ConcreteDevices:   devices,
+		LogicalInterfaces: interfaces,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(x, y v1alpha1.ConcreteDevice) bool { return x.Name < y.Name }),
+		cmpopts.SortSlices(func(x, y v1alpha1.ConcreteInterface) bool { return x.Name < y.Name }),
+		cmpopts.SortSlices(func(x, y v1alpha1.LogicalInterface) bool { return x.Name < y.Name }),
+		cmpopts.SortSlices(func(x, y string) bool { return x < y }))
+}
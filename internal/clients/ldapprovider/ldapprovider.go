@@ -0,0 +1,90 @@
+package ldapprovider
+
+import (
+	"fmt"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	AaaLdapProviderClassName   = "aaaLdapProvider"
+	aaaRsSecProvToEpgClassName = "aaaRsSecProvToEpg"
+
+	// AnnotationKeyBindPasswordVersion records the version of the Secret of the bind password last
+	// pushed to APIC.
+	AnnotationKeyBindPasswordVersion = "aci.crossplane.io/bind-password-secret-version"
+)
+
+// ProviderDn returns the DN of the LDAP provider with the supplied name.
+func ProviderDn(name string) string {
+	return fmt.Sprintf("uni/userext/ldapext/ldapprovider-%s", name)
+}
+
+// EPGRelationDn returns the DN of the management EPG relation of the
+// provider with the supplied DN.
+func EPGRelationDn(providerDn string) string {
+	return fmt.Sprintf("%s/rsSecProvToEpg", providerDn)
+}
+
+// NewProvider returns the aaaLdapProvider of the supplied provider. The bind
+// password is only set if key is not empty.
+func NewProvider(p v1alpha1.LDAPProviderParameters, key string) *mo.Object {
+	attrs := map[string]string{
+		"name":               p.Name,
+		"descr":              p.Description,
+		"port":               p.Port,
+		"rootdn":             p.RootDn,
+		"basedn":             p.BaseDn,
+		"filter":             p.Filter,
+		"attribute":          p.Attribute,
+		"enableSSL":          p.EnableSSL,
+		"SSLValidationLevel": p.SSLValidationLevel,
+		"timeout":            p.Timeout,
+		"retries":            p.Retries,
+		"monitorServer":      p.MonitorServer,
+		"monitoringUser":     p.MonitoringUser,
+	}
+	if key != "" {
+		attrs["key"] = key
+	}
+	return mo.NewObject(AaaLdapProviderClassName, ProviderDn(p.Name), attrs)
+}
+
+// SaveManagementEPG points the provider with the supplied DN to its
+// management EPG.
+func SaveManagementEPG(a *aciclient.Client, providerDn string, p v1alpha1.LDAPProviderParameters) error {
+	return mo.SaveRelation(a, EPGRelationDn(providerDn), aaaRsSecProvToEpgClassName, mo.ManagementEPGDn(p.ManagementEPG))
+}
+
+// IsUptoDate compares everything but the bind password, which APIC never
+// returns.
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.LDAPProvider, t map[string]string) bool {
+
+	epg, err := mo.ReadRelation(a, EPGRelationDn(t["dn"]), aaaRsSecProvToEpgClassName)
+	if err != nil {
+		return false
+	}
+
+	observed := &v1alpha1.LDAPProviderParameters{
+		Name:                  t["name"],
+		Description:           t["descr"],
+		Port:                  t["port"],
+		RootDn:                t["rootdn"],
+		BaseDn:                t["basedn"],
+		Filter:                t["filter"],
+		Attribute:             t["attribute"],
+		EnableSSL:             t["enableSSL"],
+		SSLValidationLevel:    t["SSLValidationLevel"],
+		Timeout:               t["timeout"],
+		Retries:               t["retries"],
+		MonitorServer:         t["monitorServer"],
+		MonitoringUser:        t["monitoringUser"],
+		BindPasswordSecretRef: s.Spec.ForProvider.BindPasswordSecretRef,
+		ManagementEPG:         mo.ManagementEPGFromDn(epg),
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider)
+}
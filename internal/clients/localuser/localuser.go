@@ -0,0 +1,129 @@
+package localuser
+
+import (
+	"fmt"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	AaaUserClassName       = "aaaUser"
+	aaaUserDomainClassName = "aaaUserDomain"
+	aaaUserRoleClassName   = "aaaUserRole"
+
+	// AnnotationKeyPasswordVersion records the version of the Secret of the
+	// password last pushed to APIC.
+	AnnotationKeyPasswordVersion = "aci.crossplane.io/password-secret-version"
+)
+
+// UserDn returns the DN of the local user with the supplied name.
+func UserDn(name string) string {
+	return fmt.Sprintf("uni/userext/user-%s", name)
+}
+
+// NewUser returns the aaaUser of the supplied user. The password is only
+// set if pwd is not empty.
+func NewUser(p v1alpha1.LocalUserParameters, pwd string) *mo.Object {
+	attrs := map[string]string{
+		"name":          p.Name,
+		"descr":         p.Description,
+		"accountStatus": p.AccountStatus,
+		"expires":       p.Expires,
+		"expiration":    p.Expiration,
+		"email":         p.Email,
+		"firstName":     p.FirstName,
+		"lastName":      p.LastName,
+		"phone":         p.Phone,
+	}
+	if pwd != "" {
+		attrs["pwd"] = pwd
+	}
+	return mo.NewObject(AaaUserClassName, UserDn(p.Name), attrs)
+}
+
+// NewUserDomain returns the aaaUserDomain d of the user with the supplied
+// DN.
+func NewUserDomain(userDn string, d v1alpha1.UserDomain) *mo.Object {
+	return mo.NewObject(aaaUserDomainClassName, fmt.Sprintf("%s/userdomain-%s", userDn, d.Name), map[string]string{
+		"name": d.Name,
+	})
+}
+
+// NewUserRole returns the aaaUserRole r of the user domain with the supplied
+// DN.
+func NewUserRole(domainDn string, r v1alpha1.UserRole) *mo.Object {
+	return mo.NewObject(aaaUserRoleClassName, fmt.Sprintf("%s/role-%s", domainDn, r.Name), map[string]string{
+		"name":     r.Name,
+		"privType": r.PrivilegeType,
+	})
+}
+
+// ReconcileDomains converges the domains of the user with the supplied DN
+// and the roles of the user in each of them.
+func ReconcileDomains(a *aciclient.Client, userDn string, p v1alpha1.LocalUserParameters) error {
+	domains := make([]*mo.Object, 0, len(p.Domains))
+	for _, d := range p.Domains {
+		domains = append(domains, NewUserDomain(userDn, d))
+	}
+	if err := mo.ReconcileChildren(a, userDn, aaaUserDomainClassName, domains); err != nil {
+		return err
+	}
+	for i, d := range p.Domains {
+		roles := make([]*mo.Object, 0, len(d.Roles))
+		for _, r := range d.Roles {
+			roles = append(roles, NewUserRole(domains[i].Dn, r))
+		}
+		if err := mo.ReconcileChildren(a, domains[i].Dn, aaaUserRoleClassName, roles); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsUptoDate compares everything but the password, which APIC never returns.
+// The expiration is only compared if the account expires.
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.LocalUser, t map[string]string) bool {
+
+	dn := UserDn(s.Spec.ForProvider.Name)
+	doms, err := mo.ReadChildren(a, dn, aaaUserDomainClassName)
+	if err != nil {
+		return false
+	}
+	var domains []v1alpha1.UserDomain
+	for _, d := range doms {
+		rs, err := mo.ReadChildren(a, d["dn"], aaaUserRoleClassName)
+		if err != nil {
+			return false
+		}
+		var roles []v1alpha1.UserRole
+		for _, r := range rs {
+			roles = append(roles, v1alpha1.UserRole{Name: r["name"], PrivilegeType: r["privType"]})
+		}
+		domains = append(domains, v1alpha1.UserDomain{Name: d["name"], Roles: roles})
+	}
+
+	observed := &v1alpha1.LocalUserParameters{
+		Name:              t["name"],
+		Description:       t["descr"],
+		PasswordSecretRef: s.Spec.ForProvider.PasswordSecretRef,
+		AccountStatus:     t["accountStatus"],
+		Expires:           t["expires"],
+		Expiration:        t["expiration"],
+		Email:             t["email"],
+		FirstName:         t["firstName"],
+		LastName:          t["lastName"],
+		Phone:             t["phone"],
+		Domains:           domains,
+	}
+	if observed.Expires != "yes" {
+		observed.Expiration = s.Spec.ForProvider.Expiration
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(x, y v1alpha1.UserDomain) bool { return x.Name < y.Name }),
+		cmpopts.SortSlices(func(x, y v1alpha1.UserRole) bool { return x.Name < y.Name }))
+}
@@ -0,0 +1,59 @@
+package logindomain
+
+import (
+	"fmt"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	AaaLoginDomainClassName = "aaaLoginDomain"
+	aaaDomainAuthClassName  = "aaaDomainAuth"
+)
+
+// DomainDn returns the DN of the login domain with the supplied name.
+func DomainDn(name string) string {
+	return fmt.Sprintf("uni/userext/logindomain-%s", name)
+}
+
+func domainAuthDn(domainDn string) string {
+	return fmt.Sprintf("%s/domainauth", domainDn)
+}
+
+// Validate returns an error if the realm of the supplied domain is not
+// local and has no provider group.
+func Validate(p v1alpha1.LoginDomainParameters) error {
+	if p.Realm != "local" && p.ProviderGroup == "" {
+		return fmt.Errorf("a provider group is required for the %s realm", p.Realm)
+	}
+	return nil
+}
+
+// SaveRealm saves the realm of the login domain with the supplied DN.
+func SaveRealm(a *aciclient.Client, domainDn string, p v1alpha1.LoginDomainParameters) error {
+	return a.Save(mo.NewObject(aaaDomainAuthClassName, domainAuthDn(domainDn), map[string]string{
+		"realm":         p.Realm,
+		"providerGroup": p.ProviderGroup,
+	}))
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.LoginDomain, t map[string]string) bool {
+
+	auth, err := mo.Read(a, domainAuthDn(t["dn"]), aaaDomainAuthClassName)
+	if err != nil || auth == nil {
+		return false
+	}
+
+	observed := &v1alpha1.LoginDomainParameters{
+		Name:          t["name"],
+		Description:   t["descr"],
+		NameAlias:     t["nameAlias"],
+		Realm:         auth["realm"],
+		ProviderGroup: auth["providerGroup"],
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider)
+}
@@ -0,0 +1,144 @@
+package maintenancegroup
+
+import (
+	"fmt"
+	"sort"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/ciscoecosystem/aci-go-client/v2/models"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	firmwaregrouputil "github.com/jgomezve/provider-aci/internal/clients/firmwaregroup"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	// AnnotationKeyLastTrigger records the value of Trigger the upgrade was
+	// last started with. It is persisted before the upgrade is started.
+	AnnotationKeyLastTrigger = "aci.crossplane.io/last-trigger"
+
+	MaintMaintGrpClassName = "maintMaintGrp"
+	MaintMaintPClassName   = "maintMaintP"
+	maintRsMgrppClassName  = "maintRsMgrpp"
+	maintUpgJobClassName   = "maintUpgJob"
+)
+
+// GroupDn returns the DN of the maintenance group with the supplied name.
+func GroupDn(name string) string {
+	return fmt.Sprintf("uni/fabric/maintgrp-%s", name)
+}
+
+// PolicyDn returns the DN of the maintenance policy with the supplied name.
+func PolicyDn(name string) string {
+	return fmt.Sprintf("uni/fabric/maintpol-%s", name)
+}
+
+// LastTrigger returns the value of Trigger the upgrade of the group was last
+// started with. The status is only read for the groups triggered before the
+// trigger was recorded in an annotation.
+func LastTrigger(s *v1alpha1.MaintenanceGroup) string {
+	if t, ok := s.GetAnnotations()[AnnotationKeyLastTrigger]; ok {
+		return t
+	}
+	return s.Status.AtProvider.LastTrigger
+}
+
+// TriggerPending returns whether the upgrade of the group has to be started,
+// that is whether Trigger was set to a value it was not started with yet.
+func TriggerPending(s *v1alpha1.MaintenanceGroup) bool {
+	return s.Spec.ForProvider.Trigger != "" && s.Spec.ForProvider.Trigger != LastTrigger(s)
+}
+
+// NewPolicy returns the maintMaintP of the supplied maintenance group. The
+// policy is only triggered if trigger is true; otherwise its admin state is
+// left as it is.
+func NewPolicy(p v1alpha1.MaintenanceGroupParameters, trigger bool) *mo.Object {
+	attrs := map[string]string{
+		"name":         p.Name,
+		"version":      p.Version,
+		"runMode":      p.RunMode,
+		"graceful":     p.Graceful,
+		"ignoreCompat": p.IgnoreCompatibility,
+	}
+	if trigger {
+		attrs["adminSt"] = "triggered"
+	}
+	return mo.NewObject(MaintMaintPClassName, PolicyDn(p.Name), attrs)
+}
+
+// SavePolicyRelation points the maintenance group with the supplied DN to
+// the maintenance policy with the supplied name.
+func SavePolicyRelation(a *aciclient.Client, groupDn, policy string) error {
+	return a.Save(mo.NewObject(maintRsMgrppClassName, groupDn+"/rsmgrpp", map[string]string{
+		"tnMaintMaintPName": policy,
+	}))
+}
+
+// ReadUpgradeStatus returns the upgrade progress of the nodes of the
+// maintenance group with the supplied name, aggregated from their upgrade
+// jobs.
+func ReadUpgradeStatus(a *aciclient.Client, name string) (v1alpha1.MaintenanceGroupObservation, error) {
+	o := v1alpha1.MaintenanceGroupObservation{}
+	cont, err := a.GetViaURL(fmt.Sprintf("/api/node/class/%s.json?query-target-filter=eq(%s.maintGrp,\"%s\")", maintUpgJobClassName, maintUpgJobClassName, name))
+	if err != nil {
+		if mo.IsNotFound(err) {
+			return o, nil
+		}
+		return o, err
+	}
+	for _, j := range models.ListFromContainer(cont, maintUpgJobClassName) {
+		n := v1alpha1.NodeUpgradeStatus{
+			Node:           models.G(j, "dn"),
+			Status:         models.G(j, "upgradeStatus"),
+			Progress:       models.G(j, "instlProgPct"),
+			DesiredVersion: models.G(j, "desiredVersion"),
+		}
+		switch n.Status {
+		case "completeok":
+			o.Completed++
+		case "completenok", "incompatible":
+			o.Failed++
+		case "inprogress", "inqueue", "inretryqueue", "scheduled", "waitonbootup":
+			o.InProgress++
+		}
+		o.Nodes = append(o.Nodes, n)
+	}
+	sort.Slice(o.Nodes, func(i, j int) bool { return o.Nodes[i].Node < o.Nodes[j].Node })
+	return o, nil
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.MaintenanceGroup, t map[string]string) bool {
+
+	if TriggerPending(s) {
+		return false
+	}
+
+	dn := GroupDn(s.Spec.ForProvider.Name)
+	policy, err := mo.Read(a, PolicyDn(s.Spec.ForProvider.Name), MaintMaintPClassName)
+	if err != nil || policy == nil {
+		return false
+	}
+	rel, err := mo.Read(a, dn+"/rsmgrpp", maintRsMgrppClassName)
+	if err != nil || rel == nil || rel["tnMaintMaintPName"] != s.Spec.ForProvider.Name {
+		return false
+	}
+	blocks, err := firmwaregrouputil.ReadNodeBlocks(a, dn)
+	if err != nil {
+		return false
+	}
+
+	observed := &v1alpha1.MaintenanceGroupParameters{
+		Name:                t["name"],
+		Description:         t["descr"],
+		Version:             policy["version"],
+		RunMode:             policy["runMode"],
+		Graceful:            policy["graceful"],
+		IgnoreCompatibility: policy["ignoreCompat"],
+		NodeBlocks:          blocks,
+		Trigger:             s.Spec.ForProvider.Trigger,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(x, y v1alpha1.NodeBlock) bool { return x.Name < y.Name }))
+}
@@ -0,0 +1,103 @@
+package managedobject
+
+import (
+	"fmt"
+	"strings"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/jgomezve/provider-aci/apis/rest/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+	"github.com/pkg/errors"
+)
+
+const tenantPrefix = "uni/tn-"
+
+// Dn returns the DN of the supplied managed object.
+func Dn(p v1alpha1.ManagedObjectParameters) string {
+	if p.Dn != "" {
+		return p.Dn
+	}
+	return fmt.Sprintf("%s/%s", p.ParentDn, p.Rn)
+}
+
+func childDn(dn string, c v1alpha1.ManagedObjectChild) string {
+	return fmt.Sprintf("%s/%s", dn, c.Rn)
+}
+
+// Tenant returns the name of the tenant of the object with the supplied DN,
+// or an empty string if it is not in a tenant.
+func Tenant(dn string) string {
+	if !strings.HasPrefix(dn, tenantPrefix) {
+		return ""
+	}
+	tenant, _, _ := strings.Cut(strings.TrimPrefix(dn, tenantPrefix), "/")
+	return tenant
+}
+
+// Validate returns an error if the supplied managed object is not identified
+// either by its DN or by the DN of its parent and its RN.
+func Validate(p v1alpha1.ManagedObjectParameters) error {
+	if p.Dn != "" && (p.ParentDn != "" || p.Rn != "") {
+		return errors.New("parentDn and rn cannot be set with dn")
+	}
+	if p.Dn == "" && (p.ParentDn == "" || p.Rn == "") {
+		return errors.New("either dn or both parentDn and rn are required")
+	}
+	return nil
+}
+
+// NewManagedObject returns the object of the supplied managed object.
+func NewManagedObject(p v1alpha1.ManagedObjectParameters) *mo.Object {
+	return mo.NewObject(p.ClassName, Dn(p), copyAttributes(p.Attributes))
+}
+
+func copyAttributes(attributes map[string]string) map[string]string {
+	attrs := make(map[string]string, len(attributes))
+	for k, v := range attributes {
+		attrs[k] = v
+	}
+	return attrs
+}
+
+// SaveChildren creates or updates the children of the managed object with
+// the supplied DN.
+func SaveChildren(a *aciclient.Client, dn string, p v1alpha1.ManagedObjectParameters) error {
+	for _, c := range p.Children {
+		if err := a.Save(mo.NewObject(c.ClassName, childDn(dn, c), copyAttributes(c.Attributes))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasAttributes returns whether the observed attributes t include the
+// desired ones.
+func hasAttributes(desired, t map[string]string) bool {
+	for k, v := range desired {
+		if t[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// IsUptoDate returns whether the observed object t and its children, read
+// from the APIC, have the desired attributes.
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.ManagedObject, t map[string]string) (bool, error) {
+
+	p := s.Spec.ForProvider
+	if !hasAttributes(p.Attributes, t) {
+		return false, nil
+	}
+	dn := Dn(p)
+	for _, c := range p.Children {
+		child, err := mo.Read(a, childDn(dn, c), c.ClassName)
+		if err != nil {
+			return false, err
+		}
+		if child == nil || !hasAttributes(c.Attributes, child) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,148 @@
+package matchrule
+
+import (
+	"fmt"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	RtctrlSubjPClassName              = "rtctrlSubjP"
+	rtctrlMatchRtDestClassName        = "rtctrlMatchRtDest"
+	rtctrlMatchCommTermClassName      = "rtctrlMatchCommTerm"
+	rtctrlMatchCommFactorClassName    = "rtctrlMatchCommFactor"
+	rtctrlMatchCommRegexTermClassName = "rtctrlMatchCommRegexTerm"
+)
+
+// MatchRuleDn returns the DN of the match rule with the supplied name of the
+// supplied tenant.
+func MatchRuleDn(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/subj-%s", tenant, name)
+}
+
+func communityTermDn(ruleDn, name string) string {
+	return fmt.Sprintf("%s/commtrm-%s", ruleDn, name)
+}
+
+// NewMatchRule returns the rtctrlSubjP of the supplied match rule.
+func NewMatchRule(p v1alpha1.MatchRuleParameters) *mo.Object {
+	return mo.NewObject(RtctrlSubjPClassName, MatchRuleDn(p.Tenant, p.Name), map[string]string{
+		"name":  p.Name,
+		"descr": p.Description,
+	})
+}
+
+// ReconcileTerms converges the prefix, community and community regex terms
+// of the match rule with the supplied DN.
+func ReconcileTerms(a *aciclient.Client, ruleDn string, p v1alpha1.MatchRuleParameters) error {
+	prefixes := make([]*mo.Object, 0, len(p.Prefixes))
+	for _, m := range p.Prefixes {
+		prefixes = append(prefixes, mo.NewObject(rtctrlMatchRtDestClassName, fmt.Sprintf("%s/dest-[%s]", ruleDn, m.IP), map[string]string{
+			"ip":         m.IP,
+			"descr":      m.Description,
+			"aggregate":  m.Aggregate,
+			"fromPfxLen": m.FromPrefixLength,
+			"toPfxLen":   m.ToPrefixLength,
+		}))
+	}
+	if err := mo.ReconcileChildren(a, ruleDn, rtctrlMatchRtDestClassName, prefixes); err != nil {
+		return err
+	}
+
+	terms := make([]*mo.Object, 0, len(p.CommunityTerms))
+	for _, c := range p.CommunityTerms {
+		terms = append(terms, mo.NewObject(rtctrlMatchCommTermClassName, communityTermDn(ruleDn, c.Name), map[string]string{
+			"name":  c.Name,
+			"descr": c.Description,
+		}))
+	}
+	if err := mo.ReconcileChildren(a, ruleDn, rtctrlMatchCommTermClassName, terms); err != nil {
+		return err
+	}
+	for _, c := range p.CommunityTerms {
+		dn := communityTermDn(ruleDn, c.Name)
+		factors := make([]*mo.Object, 0, len(c.Factors))
+		for _, f := range c.Factors {
+			factors = append(factors, mo.NewObject(rtctrlMatchCommFactorClassName, fmt.Sprintf("%s/commfct-%s", dn, f.Community), map[string]string{
+				"community": f.Community,
+				"scope":     f.Scope,
+			}))
+		}
+		if err := mo.ReconcileChildren(a, dn, rtctrlMatchCommFactorClassName, factors); err != nil {
+			return err
+		}
+	}
+
+	regexes := make([]*mo.Object, 0, len(p.CommunityRegexes))
+	for _, r := range p.CommunityRegexes {
+		regexes = append(regexes, mo.NewObject(rtctrlMatchCommRegexTermClassName, fmt.Sprintf("%s/commrxtrm-%s", ruleDn, r.Type), map[string]string{
+			"commType": r.Type,
+			"regex":    r.Regex,
+			"descr":    r.Description,
+		}))
+	}
+	return mo.ReconcileChildren(a, ruleDn, rtctrlMatchCommRegexTermClassName, regexes)
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.MatchRule, t map[string]string) bool {
+
+	p := s.Spec.ForProvider
+	dn := MatchRuleDn(p.Tenant, p.Name)
+	dests, err := mo.ReadChildren(a, dn, rtctrlMatchRtDestClassName)
+	if err != nil {
+		return false
+	}
+	var prefixes []v1alpha1.PrefixMatch
+	for _, d := range dests {
+		prefixes = append(prefixes, v1alpha1.PrefixMatch{
+			IP:               d["ip"],
+			Description:      d["descr"],
+			Aggregate:        d["aggregate"],
+			FromPrefixLength: d["fromPfxLen"],
+			ToPrefixLength:   d["toPfxLen"],
+		})
+	}
+	commTerms, err := mo.ReadChildren(a, dn, rtctrlMatchCommTermClassName)
+	if err != nil {
+		return false
+	}
+	var terms []v1alpha1.CommunityTerm
+	for _, c := range commTerms {
+		commFactors, err := mo.ReadChildren(a, c["dn"], rtctrlMatchCommFactorClassName)
+		if err != nil {
+			return false
+		}
+		var factors []v1alpha1.CommunityFactor
+		for _, f := range commFactors {
+			factors = append(factors, v1alpha1.CommunityFactor{Community: f["community"], Scope: f["scope"]})
+		}
+		terms = append(terms, v1alpha1.CommunityTerm{Name: c["name"], Description: c["descr"], Factors: factors})
+	}
+	regexTerms, err := mo.ReadChildren(a, dn, rtctrlMatchCommRegexTermClassName)
+	if err != nil {
+		return false
+	}
+	var regexes []v1alpha1.CommunityRegex
+	for _, r := range regexTerms {
+		regexes = append(regexes, v1alpha1.CommunityRegex{Type: r["commType"], Regex: r["regex"], Description: r["descr"]})
+	}
+
+	observed := &v1alpha1.MatchRuleParameters{
+		Name:             t["name"],
+		Tenant:           p.Tenant,
+		Description:      t["descr"],
+		Prefixes:         prefixes,
+		CommunityTerms:   terms,
+		CommunityRegexes: regexes,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(x, y v1alpha1.PrefixMatch) bool { return x.IP < y.IP }),
+		cmpopts.SortSlices(func(x, y v1alpha1.CommunityTerm) bool { return x.Name < y.Name }),
+		cmpopts.SortSlices(func(x, y v1alpha1.CommunityFactor) bool { return x.Community < y.Community }),
+		cmpopts.SortSlices(func(x, y v1alpha1.CommunityRegex) bool { return x.Type < y.Type }))
+}
@@ -0,0 +1,179 @@
+package mo
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/ciscoecosystem/aci-go-client/v2/container"
+	"github.com/ciscoecosystem/aci-go-client/v2/models"
+)
+
+const errObjectNotFound = "Error retrieving Object: Object may not exist"
+
+// Object is a managed object of a class that is not modelled by the
+// aci-go-client.
+type Object struct {
+	Dn         string
+	ClassName  string
+	Status     string
+	Attributes map[string]string
+}
+
+// NewObject returns the object of the supplied class with the supplied DN
+// and attributes.
+func NewObject(className, dn string, attributes map[string]string) *Object {
+	return &Object{
+		Dn:         dn,
+		ClassName:  className,
+		Status:     "created, modified",
+		Attributes: attributes,
+	}
+}
+
+// ToMap returns all the attributes of the object, including the empty ones,
+// so that optional attributes can be cleared.
+func (o *Object) ToMap() (map[string]string, error) {
+	objMap := map[string]string{}
+	for k, v := range o.Attributes {
+		objMap[k] = v
+	}
+	objMap["dn"] = o.Dn
+	objMap["classname"] = o.ClassName
+	models.A(objMap, "status", o.Status)
+	return objMap, nil
+}
+
+// IsNotFound returns whether err reports a missing object.
+func IsNotFound(err error) bool {
+	return err != nil && fmt.Sprintf("%s", err) == errObjectNotFound
+}
+
+// Read returns the attributes of the object of the supplied class with the
+// supplied DN, or nil if it does not exist.
+func Read(a *aciclient.Client, dn, className string) (map[string]string, error) {
+	cont, err := a.Get(dn)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	objs := models.ListFromContainer(cont, className)
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	return attributesOf(objs[0]), nil
+}
+
+// ReadChildren returns the attributes of the children of the supplied class
+// of the object with the supplied DN, sorted by DN.
+func ReadChildren(a *aciclient.Client, parentDn, className string) ([]map[string]string, error) {
+	cont, err := a.GetViaURL(fmt.Sprintf("/api/node/mo/%s.json?query-target=children&target-subtree-class=%s", parentDn, className))
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var children []map[string]string
+	for _, c := range models.ListFromContainer(cont, className) {
+		children = append(children, attributesOf(c))
+	}
+	sort.Slice(children, func(i, j int) bool { return children[i]["dn"] < children[j]["dn"] })
+	return children, nil
+}
+
+// ReadClass returns the attributes of the objects of the supplied class that
+// match the supplied query-target-filter, sorted by DN.
+func ReadClass(a *aciclient.Client, className, filter string) ([]map[string]string, error) {
+	cont, err := a.GetViaURL(fmt.Sprintf("/api/node/class/%s.json?query-target-filter=%s", className, filter))
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var objs []map[string]string
+	for _, c := range models.ListFromContainer(cont, className) {
+		objs = append(objs, attributesOf(c))
+	}
+	sort.Slice(objs, func(i, j int) bool { return objs[i]["dn"] < objs[j]["dn"] })
+	return objs, nil
+}
+
+// ReconcileChildren creates or updates the desired children of the supplied
+// class of the object with the supplied DN and removes the ones that are no
+// longer desired.
+func ReconcileChildren(a *aciclient.Client, parentDn, className string, desired []*Object) error {
+	observed, err := ReadChildren(a, parentDn, className)
+	if err != nil {
+		return err
+	}
+	keep := map[string]bool{}
+	for _, o := range desired {
+		keep[o.Dn] = true
+		if err := a.Save(o); err != nil {
+			return err
+		}
+	}
+	for _, o := range observed {
+		if !keep[o["dn"]] {
+			if err := a.DeleteByDn(o["dn"], className); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ReadAttribute returns the attribute attr of the object of the supplied
+// class with the supplied DN, or an empty string if it does not exist.
+func ReadAttribute(a *aciclient.Client, dn, className, attr string) (string, error) {
+	attrs, err := Read(a, dn, className)
+	if err != nil || attrs == nil {
+		return "", err
+	}
+	return attrs[attr], nil
+}
+
+// ReadRelation returns the target DN of the relation of the supplied class
+// with the supplied DN, or an empty string if it does not exist.
+func ReadRelation(a *aciclient.Client, dn, className string) (string, error) {
+	return ReadAttribute(a, dn, className, "tDn")
+}
+
+// SaveRelation points the relation of the supplied class with the supplied
+// DN to tDn, or removes it if tDn is empty.
+func SaveRelation(a *aciclient.Client, dn, className, tDn string) error {
+	if tDn == "" {
+		return a.DeleteByDn(dn, className)
+	}
+	return a.Save(NewObject(className, dn, map[string]string{"tDn": tDn}))
+}
+
+func attributesOf(c *container.Container) map[string]string {
+	attrs := map[string]string{}
+	if cm, ok := c.Data().(map[string]interface{}); ok {
+		for k, v := range cm {
+			attrs[k] = models.StripQuotes(fmt.Sprintf("%v", v))
+		}
+	}
+	return attrs
+}
+
+// ManagementEPGDn returns the DN of the management EPG with the supplied RN,
+// e.g. oob-default, of the mgmt tenant.
+func ManagementEPGDn(rn string) string {
+	if rn == "" {
+		return ""
+	}
+	return fmt.Sprintf("uni/tn-mgmt/mgmtp-default/%s", rn)
+}
+
+// ManagementEPGFromDn returns the RN of the management EPG with the supplied
+// DN.
+func ManagementEPGFromDn(dn string) string {
+	return strings.TrimPrefix(dn, "uni/tn-mgmt/mgmtp-default/")
+}
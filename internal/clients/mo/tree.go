@@ -0,0 +1,117 @@
+package mo
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/ciscoecosystem/aci-go-client/v2/container"
+)
+
+// A Tree is an object with its children, posted or read in a single request.
+type Tree struct {
+	ClassName  string
+	Attributes map[string]string
+	Children   []*Tree
+}
+
+// NewTree returns the tree of the supplied class with the supplied attributes
+// and children.
+func NewTree(className string, attributes map[string]string, children ...*Tree) *Tree {
+	return &Tree{ClassName: className, Attributes: attributes, Children: children}
+}
+
+// Tree returns the tree of the object with the supplied children, so that
+// they are created or changed in the same transaction as the object.
+func (o *Object) Tree(children ...*Tree) *Tree {
+	attrs, _ := o.ToMap()
+	delete(attrs, "classname")
+	return NewTree(o.ClassName, attrs, children...)
+}
+
+// MarshalJSON returns the APIC JSON representation of the tree.
+func (t *Tree) MarshalJSON() ([]byte, error) {
+	body := map[string]interface{}{"attributes": t.Attributes}
+	if len(t.Children) > 0 {
+		body["children"] = t.Children
+	}
+	return json.Marshal(map[string]interface{}{t.ClassName: body})
+}
+
+// Find returns the children of the supplied class of the tree.
+func (t *Tree) Find(className string) []*Tree {
+	var found []*Tree
+	for _, c := range t.Children {
+		if c.ClassName == className {
+			found = append(found, c)
+		}
+	}
+	return found
+}
+
+// PostTree posts the supplied tree to the object with the supplied DN. The
+// APIC applies the whole tree in a single transaction, either all the
+// objects of the tree are changed or none of them.
+func PostTree(a *aciclient.Client, dn string, t *Tree) error {
+	payload, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+	req, err := a.MakeRestRequestRaw("POST", fmt.Sprintf("/api/node/mo/%s.json", dn), payload, true)
+	if err != nil {
+		return err
+	}
+	cont, _, err := a.Do(req)
+	if err != nil {
+		return err
+	}
+	return aciclient.CheckForErrors(cont, "POST", false)
+}
+
+// ReadTree returns the tree of the object of the supplied class with the
+// supplied DN, with its subtree of the supplied classes, or nil if it does
+// not exist. The APIC returns the RN of the children of a subtree, their DN
+// is built from the DN of their parent. The children of each object are
+// sorted by class and DN.
+func ReadTree(a *aciclient.Client, dn, className string, subtreeClasses []string) (*Tree, error) {
+	cont, err := a.GetViaURL(fmt.Sprintf("/api/node/mo/%s.json?rsp-subtree=full&rsp-subtree-class=%s", dn, strings.Join(subtreeClasses, ",")))
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	objs, err := cont.S("imdata").Children()
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range objs {
+		if o.Exists(className) {
+			return treeOf(className, "", o.S(className)), nil
+		}
+	}
+	return nil, nil
+}
+
+func treeOf(className, parentDn string, c *container.Container) *Tree {
+	t := &Tree{ClassName: className, Attributes: attributesOf(c.S("attributes"))}
+	if t.Attributes["dn"] == "" && t.Attributes["rn"] != "" {
+		t.Attributes["dn"] = fmt.Sprintf("%s/%s", parentDn, t.Attributes["rn"])
+	}
+	children, _ := c.S("children").Children()
+	for _, child := range children {
+		classes, _ := child.ChildrenMap()
+		for class, o := range classes {
+			t.Children = append(t.Children, treeOf(class, t.Attributes["dn"], o))
+		}
+	}
+	sort.Slice(t.Children, func(i, j int) bool {
+		if t.Children[i].ClassName != t.Children[j].ClassName {
+			return t.Children[i].ClassName < t.Children[j].ClassName
+		}
+		return t.Children[i].Attributes["dn"] < t.Children[j].Attributes["dn"]
+	})
+	return t
+}
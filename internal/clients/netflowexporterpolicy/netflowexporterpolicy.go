@@ -0,0 +1,81 @@
+package netflowexporterpolicy
+
+import (
+	"fmt"
+	"strings"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/monitoring/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+	vrfutil "github.com/jgomezve/provider-aci/internal/clients/vrf"
+)
+
+const (
+	NetflowExporterPolClassName     = "netflowExporterPol"
+	netflowRsExporterToEPgClassName = "netflowRsExporterToEPg"
+	netflowRsExporterToCtxClassName = "netflowRsExporterToCtx"
+)
+
+// ExporterPolicyDn returns the DN of the NetFlow exporter policy with the
+// supplied name of the supplied tenant.
+func ExporterPolicyDn(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/exporterpol-%s", tenant, name)
+}
+
+// NewExporterPolicy returns the netflowExporterPol of the supplied NetFlow
+// exporter policy.
+func NewExporterPolicy(p v1alpha1.NetflowExporterPolicyParameters) *mo.Object {
+	return mo.NewObject(NetflowExporterPolClassName, ExporterPolicyDn(p.Tenant, p.Name), map[string]string{
+		"name":    p.Name,
+		"descr":   p.Description,
+		"dstAddr": p.DestinationAddress,
+		"dstPort": p.DestinationPort,
+		"srcAddr": p.SourceAddress,
+		"ver":     p.Version,
+		"dscp":    p.DSCP,
+	})
+}
+
+// ReconcileRelations converges the EPG and the VRF of the NetFlow exporter
+// policy with the supplied DN.
+func ReconcileRelations(a *aciclient.Client, dn string, p v1alpha1.NetflowExporterPolicyParameters) error {
+	if err := mo.SaveRelation(a, fmt.Sprintf("%s/rsexporterToEPg", dn), netflowRsExporterToEPgClassName, p.EndpointGroupDn); err != nil {
+		return err
+	}
+	vrfDn := ""
+	if p.Vrf != "" {
+		vrfDn = vrfutil.VrfDn(p.Tenant, p.Vrf)
+	}
+	return mo.SaveRelation(a, fmt.Sprintf("%s/rsexporterToCtx", dn), netflowRsExporterToCtxClassName, vrfDn)
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.NetflowExporterPolicy, t map[string]string) bool {
+
+	p := s.Spec.ForProvider
+	dn := ExporterPolicyDn(p.Tenant, p.Name)
+	epg, err := mo.ReadRelation(a, fmt.Sprintf("%s/rsexporterToEPg", dn), netflowRsExporterToEPgClassName)
+	if err != nil {
+		return false
+	}
+	vrf, err := mo.ReadRelation(a, fmt.Sprintf("%s/rsexporterToCtx", dn), netflowRsExporterToCtxClassName)
+	if err != nil {
+		return false
+	}
+
+	observed := &v1alpha1.NetflowExporterPolicyParameters{
+		Name:               t["name"],
+		Tenant:             p.Tenant,
+		Description:        t["descr"],
+		DestinationAddress: t["dstAddr"],
+		DestinationPort:    t["dstPort"],
+		SourceAddress:      t["srcAddr"],
+		Version:            t["ver"],
+		DSCP:               t["dscp"],
+		EndpointGroupDn:    epg,
+		Vrf:                strings.TrimPrefix(vrf, vrfutil.VrfDn(p.Tenant, "")),
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty())
+}
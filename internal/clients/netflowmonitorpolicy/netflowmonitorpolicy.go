@@ -0,0 +1,77 @@
+package netflowmonitorpolicy
+
+import (
+	"fmt"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/monitoring/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	NetflowMonitorPolClassName          = "netflowMonitorPol"
+	netflowRsMonitorToRecordClassName   = "netflowRsMonitorToRecord"
+	netflowRsMonitorToExporterClassName = "netflowRsMonitorToExporter"
+)
+
+// MonitorPolicyDn returns the DN of the NetFlow monitor policy with the
+// supplied name of the supplied tenant.
+func MonitorPolicyDn(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/monitorpol-%s", tenant, name)
+}
+
+// NewMonitorPolicy returns the netflowMonitorPol of the supplied NetFlow
+// monitor policy.
+func NewMonitorPolicy(p v1alpha1.NetflowMonitorPolicyParameters) *mo.Object {
+	return mo.NewObject(NetflowMonitorPolClassName, MonitorPolicyDn(p.Tenant, p.Name), map[string]string{
+		"name":  p.Name,
+		"descr": p.Description,
+	})
+}
+
+// ReconcileRelations converges the record policy and the exporters of the
+// NetFlow monitor policy with the supplied DN.
+func ReconcileRelations(a *aciclient.Client, dn string, p v1alpha1.NetflowMonitorPolicyParameters) error {
+	if err := a.Save(mo.NewObject(netflowRsMonitorToRecordClassName, fmt.Sprintf("%s/rsmonitorToRecord", dn), map[string]string{
+		"tnNetflowRecordPolName": p.RecordPolicy,
+	})); err != nil {
+		return err
+	}
+	exporters := make([]*mo.Object, 0, len(p.Exporters))
+	for _, e := range p.Exporters {
+		exporters = append(exporters, mo.NewObject(netflowRsMonitorToExporterClassName, fmt.Sprintf("%s/rsmonitorToExporter-%s", dn, e), map[string]string{
+			"tnNetflowExporterPolName": e,
+		}))
+	}
+	return mo.ReconcileChildren(a, dn, netflowRsMonitorToExporterClassName, exporters)
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.NetflowMonitorPolicy, t map[string]string) bool {
+
+	p := s.Spec.ForProvider
+	dn := MonitorPolicyDn(p.Tenant, p.Name)
+	record, err := mo.ReadAttribute(a, fmt.Sprintf("%s/rsmonitorToRecord", dn), netflowRsMonitorToRecordClassName, "tnNetflowRecordPolName")
+	if err != nil {
+		return false
+	}
+	refs, err := mo.ReadChildren(a, dn, netflowRsMonitorToExporterClassName)
+	if err != nil {
+		return false
+	}
+	var exporters []string
+	for _, r := range refs {
+		exporters = append(exporters, r["tnNetflowExporterPolName"])
+	}
+
+	observed := &v1alpha1.NetflowMonitorPolicyParameters{
+		Name:         t["name"],
+		Tenant:       p.Tenant,
+		Description:  t["descr"],
+		RecordPolicy: record,
+		Exporters:    exporters,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(x, y string) bool { return x < y }))
+}
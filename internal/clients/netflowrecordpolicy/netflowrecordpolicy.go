@@ -0,0 +1,50 @@
+package netflowrecordpolicy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/monitoring/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const NetflowRecordPolClassName = "netflowRecordPol"
+
+// RecordPolicyDn returns the DN of the NetFlow record policy with the
+// supplied name of the supplied tenant.
+func RecordPolicyDn(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/recordpol-%s", tenant, name)
+}
+
+// NewRecordPolicy returns the netflowRecordPol of the supplied NetFlow record
+// policy.
+func NewRecordPolicy(p v1alpha1.NetflowRecordPolicyParameters) *mo.Object {
+	return mo.NewObject(NetflowRecordPolClassName, RecordPolicyDn(p.Tenant, p.Name), map[string]string{
+		"name":    p.Name,
+		"descr":   p.Description,
+		"collect": strings.Join(p.Collect, ","),
+		"match":   strings.Join(p.Match, ","),
+	})
+}
+
+func splitList(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+func IsUptoDate(s *v1alpha1.NetflowRecordPolicy, t map[string]string) bool {
+
+	observed := &v1alpha1.NetflowRecordPolicyParameters{
+		Name:        t["name"],
+		Tenant:      s.Spec.ForProvider.Tenant,
+		Description: t["descr"],
+		Collect:     splitList(t["collect"]),
+		Match:       splitList(t["match"]),
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(x, y string) bool { return x < y }))
+}
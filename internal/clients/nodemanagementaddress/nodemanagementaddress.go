@@ -0,0 +1,93 @@
+package nodemanagementaddress
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jgomezve/provider-aci/apis/fabric/v1alpha1"
+)
+
+// ClassName returns the class of the static node relation of the supplied
+// management address type.
+func ClassName(p v1alpha1.NodeManagementAddressParameters) string {
+	if p.Type == "inb" {
+		return "mgmtRsInBStNode"
+	}
+	return "mgmtRsOoBStNode"
+}
+
+// NodeDn returns the DN of the fabric node the address is assigned to.
+func NodeDn(p v1alpha1.NodeManagementAddressParameters) string {
+	return fmt.Sprintf("topology/pod-%d/node-%d", p.Pod, p.NodeID)
+}
+
+// Dn returns the DN of the static node relation of the management EPG.
+func Dn(p v1alpha1.NodeManagementAddressParameters) string {
+	if p.Type == "inb" {
+		return fmt.Sprintf("uni/tn-mgmt/mgmtp-default/inb-%s/rsinBStNode-[%s]", p.ManagementEPG, NodeDn(p))
+	}
+	return fmt.Sprintf("uni/tn-mgmt/mgmtp-default/oob-%s/rsooBStNode-[%s]", p.ManagementEPG, NodeDn(p))
+}
+
+// Validate returns an error if an address is not in CIDR notation or if a
+// gateway is not in the subnet of its address.
+func Validate(p v1alpha1.NodeManagementAddressParameters) error {
+	if p.Address == "" && p.IPv6Address == "" {
+		return fmt.Errorf("either an IPv4 or an IPv6 address is required")
+	}
+	if err := validateGateway(p.Address, p.Gateway, false); err != nil {
+		return err
+	}
+	return validateGateway(p.IPv6Address, p.IPv6Gateway, true)
+}
+
+func validateGateway(address, gateway string, v6 bool) error {
+	if address == "" {
+		if gateway != "" {
+			return fmt.Errorf("gateway %s is set without an address", gateway)
+		}
+		return nil
+	}
+	ip, subnet, err := net.ParseCIDR(address)
+	if err != nil {
+		return fmt.Errorf("address %s is not in CIDR notation", address)
+	}
+	if (ip.To4() == nil) != v6 {
+		return fmt.Errorf("address %s is not of the expected IP version", address)
+	}
+	if gateway == "" {
+		return nil
+	}
+	gw := net.ParseIP(gateway)
+	if gw == nil {
+		return fmt.Errorf("gateway %s is not an IP address", gateway)
+	}
+	if !subnet.Contains(gw) {
+		return fmt.Errorf("gateway %s is not in the subnet %s of address %s", gateway, subnet, address)
+	}
+	if gw.Equal(ip) {
+		return fmt.Errorf("gateway %s is the address of the node", gateway)
+	}
+	return nil
+}
+
+// unset returns an empty string for the unspecified addresses APIC reports
+// for attributes that are not configured.
+func unset(addr string) string {
+	if addr == "0.0.0.0" || addr == "::" {
+		return ""
+	}
+	return addr
+}
+
+func IsUptoDate(s *v1alpha1.NodeManagementAddress, t map[string]string) bool {
+
+	observed := s.Spec.ForProvider.DeepCopy()
+	observed.Address = unset(t["addr"])
+	observed.Gateway = unset(t["gw"])
+	observed.IPv6Address = unset(t["v6Addr"])
+	observed.IPv6Gateway = unset(t["v6Gw"])
+
+	return cmp.Equal(observed, &s.Spec.ForProvider)
+}
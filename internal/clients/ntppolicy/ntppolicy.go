@@ -0,0 +1,101 @@
+package ntppolicy
+
+import (
+	"fmt"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/fabric/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	DatetimePolClassName            = "datetimePol"
+	datetimeNtpProvClassName        = "datetimeNtpProv"
+	datetimeRsNtpProvToEpgClassName = "datetimeRsNtpProvToEpg"
+)
+
+// PolicyDn returns the DN of the date and time policy with the supplied name.
+func PolicyDn(name string) string {
+	return fmt.Sprintf("uni/fabric/time-%s", name)
+}
+
+func providerDn(policyDn, name string) string {
+	return fmt.Sprintf("%s/ntpprov-%s", policyDn, name)
+}
+
+// NewProvider returns the datetimeNtpProv p of the policy with the supplied
+// DN.
+func NewProvider(policyDn string, p v1alpha1.NTPProvider) *mo.Object {
+	return mo.NewObject(datetimeNtpProvClassName, providerDn(policyDn, p.Name), map[string]string{
+		"name":      p.Name,
+		"preferred": p.Preferred,
+		"minPoll":   p.MinPoll,
+		"maxPoll":   p.MaxPoll,
+	})
+}
+
+// ReadProviders returns the NTP providers configured on the policy with the
+// supplied DN.
+func ReadProviders(a *aciclient.Client, policyDn string) ([]v1alpha1.NTPProvider, error) {
+	children, err := mo.ReadChildren(a, policyDn, datetimeNtpProvClassName)
+	if err != nil {
+		return nil, err
+	}
+	var providers []v1alpha1.NTPProvider
+	for _, c := range children {
+		epg, err := mo.ReadRelation(a, fmt.Sprintf("%s/rsNtpProvToEpg", c["dn"]), datetimeRsNtpProvToEpgClassName)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, v1alpha1.NTPProvider{
+			Name:          c["name"],
+			Preferred:     c["preferred"],
+			MinPoll:       c["minPoll"],
+			MaxPoll:       c["maxPoll"],
+			ManagementEPG: mo.ManagementEPGFromDn(epg),
+		})
+	}
+	return providers, nil
+}
+
+// ReconcileProviders converges the NTP providers of the policy with the
+// supplied DN with the desired ones.
+func ReconcileProviders(a *aciclient.Client, policyDn string, desired []v1alpha1.NTPProvider) error {
+	objs := make([]*mo.Object, 0, len(desired))
+	for _, p := range desired {
+		objs = append(objs, NewProvider(policyDn, p))
+	}
+	if err := mo.ReconcileChildren(a, policyDn, datetimeNtpProvClassName, objs); err != nil {
+		return err
+	}
+	for _, p := range desired {
+		dn := fmt.Sprintf("%s/rsNtpProvToEpg", providerDn(policyDn, p.Name))
+		if err := mo.SaveRelation(a, dn, datetimeRsNtpProvToEpgClassName, mo.ManagementEPGDn(p.ManagementEPG)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.NTPPolicy, t map[string]string) bool {
+
+	providers, err := ReadProviders(a, PolicyDn(s.Spec.ForProvider.Name))
+	if err != nil {
+		return false
+	}
+
+	observed := &v1alpha1.NTPPolicyParameters{
+		Name:        t["name"],
+		Description: t["descr"],
+		NameAlias:   t["nameAlias"],
+		AdminState:  t["adminSt"],
+		AuthState:   t["authSt"],
+		ServerState: t["serverState"],
+		Providers:   providers,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(x, y v1alpha1.NTPProvider) bool { return x.Name < y.Name }))
+}
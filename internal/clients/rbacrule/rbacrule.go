@@ -0,0 +1,27 @@
+package rbacrule
+
+import (
+	"fmt"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+)
+
+const AaaRbacRuleClassName = "aaaRbacRule"
+
+// RuleDn returns the DN of the rule granting the security domain with the
+// supplied name access to the object with the supplied DN.
+func RuleDn(objectDn, domain string) string {
+	return fmt.Sprintf("uni/rbacdb/rule-[%s]-dom-%s", objectDn, domain)
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.RBACRule, t map[string]string) bool {
+	observed := &v1alpha1.RBACRuleParameters{
+		ObjectDn:       t["objectDn"],
+		SecurityDomain: t["domain"],
+		AllowWrites:    t["allowWrites"],
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider)
+}
@@ -0,0 +1,125 @@
+package redirectpolicy
+
+import (
+	"fmt"
+	"strings"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/services/v1alpha1"
+	ipslamonitoringpolicyutil "github.com/jgomezve/provider-aci/internal/clients/ipslamonitoringpolicy"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	VnsSvcRedirectPolClassName       = "vnsSvcRedirectPol"
+	vnsRedirectDestClassName         = "vnsRedirectDest"
+	vnsRsIPSLAMonitoringPolClassName = "vnsRsIPSLAMonitoringPol"
+)
+
+// RedirectPolicyDn returns the DN of the redirect policy with the supplied
+// name of the supplied tenant.
+func RedirectPolicyDn(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/svcCont/svcRedirectPol-%s", tenant, name)
+}
+
+// DestinationDn returns the DN of the destination with the supplied IP of
+// the redirect policy with the supplied DN.
+func DestinationDn(polDn, ip string) string {
+	return fmt.Sprintf("%s/RedirectDest_ip-[%s]", polDn, ip)
+}
+
+// NewRedirectPolicy returns the vnsSvcRedirectPol of the supplied redirect
+// policy.
+func NewRedirectPolicy(p v1alpha1.RedirectPolicyParameters) *mo.Object {
+	return mo.NewObject(VnsSvcRedirectPolClassName, RedirectPolicyDn(p.Tenant, p.Name), map[string]string{
+		"name":                 p.Name,
+		"descr":                p.Description,
+		"hashingAlgorithm":     p.HashingAlgorithm,
+		"thresholdEnable":      p.ThresholdEnable,
+		"minThresholdPercent":  p.MinThresholdPercent,
+		"maxThresholdPercent":  p.MaxThresholdPercent,
+		"thresholdDownAction":  p.ThresholdDownAction,
+		"resilientHashEnabled": p.ResilientHashEnabled,
+	})
+}
+
+// ReconcileChildren converges the IP SLA monitoring policy and the
+// destinations of the redirect policy with the supplied DN. Destinations are
+// keyed by IP, so changing the IP of a destination replaces it.
+func ReconcileChildren(a *aciclient.Client, polDn string, p v1alpha1.RedirectPolicyParameters) error {
+	ipsla := ""
+	if p.IPSLAMonitoringPolicy != "" {
+		ipsla = ipslamonitoringpolicyutil.PolicyDn(p.Tenant, p.IPSLAMonitoringPolicy)
+	}
+	if err := mo.SaveRelation(a, fmt.Sprintf("%s/rsIPSLAMonitoringPol", polDn), vnsRsIPSLAMonitoringPolClassName, ipsla); err != nil {
+		return err
+	}
+
+	dests := make([]*mo.Object, 0, len(p.Destinations))
+	for _, d := range p.Destinations {
+		dests = append(dests, mo.NewObject(vnsRedirectDestClassName, DestinationDn(polDn, d.IP), map[string]string{
+			"ip":       d.IP,
+			"mac":      d.MAC,
+			"ip2":      d.IP2,
+			"destName": d.DestinationName,
+			"podId":    d.PodID,
+		}))
+	}
+	return mo.ReconcileChildren(a, polDn, vnsRedirectDestClassName, dests)
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.RedirectPolicy, t map[string]string) bool {
+
+	p := s.Spec.ForProvider
+	dn := RedirectPolicyDn(p.Tenant, p.Name)
+	ipsla, err := mo.ReadRelation(a, fmt.Sprintf("%s/rsIPSLAMonitoringPol", dn), vnsRsIPSLAMonitoringPolClassName)
+	if err != nil {
+		return false
+	}
+	dests, err := mo.ReadChildren(a, dn, vnsRedirectDestClassName)
+	if err != nil {
+		return false
+	}
+	macs := map[string]string{}
+	for _, d := range p.Destinations {
+		macs[d.IP] = d.MAC
+	}
+	var destinations []v1alpha1.RedirectDestination
+	for _, d := range dests {
+		mac := d["mac"]
+		// APIC reports MAC addresses in upper case.
+		if strings.EqualFold(mac, macs[d["ip"]]) {
+			mac = macs[d["ip"]]
+		}
+		ip2 := d["ip2"]
+		if ip2 == "0.0.0.0" {
+			ip2 = ""
+		}
+		destinations = append(destinations, v1alpha1.RedirectDestination{
+			IP:              d["ip"],
+			MAC:             mac,
+			IP2:             ip2,
+			DestinationName: d["destName"],
+			PodID:           d["podId"],
+		})
+	}
+
+	observed := &v1alpha1.RedirectPolicyParameters{
+		Name:                  t["name"],
+		Tenant:                p.Tenant,
+		Description:           t["descr"],
+		HashingAlgorithm:      t["hashingAlgorithm"],
+		ThresholdEnable:       t["thresholdEnable"],
+		MinThresholdPercent:   t["minThresholdPercent"],
+		MaxThresholdPercent:   t["maxThresholdPercent"],
+		ThresholdDownAction:   t["thresholdDownAction"],
+		ResilientHashEnabled:  t["resilientHashEnabled"],
+		IPSLAMonitoringPolicy: strings.TrimPrefix(ipsla, ipslamonitoringpolicyutil.PolicyDn(p.Tenant, "")),
+		Destinations:          destinations,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(x, y v1alpha1.RedirectDestination) bool { return x.IP < y.IP }))
+}
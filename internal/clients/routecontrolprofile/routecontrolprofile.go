@@ -0,0 +1,145 @@
+package routecontrolprofile
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	RtctrlProfileClassName        = "rtctrlProfile"
+	rtctrlCtxPClassName           = "rtctrlCtxP"
+	rtctrlRsCtxPToSubjPClassName  = "rtctrlRsCtxPToSubjP"
+	rtctrlScopeClassName          = "rtctrlScope"
+	rtctrlRsScopeToAttrPClassName = "rtctrlRsScopeToAttrP"
+)
+
+// ProfileDn returns the DN of the route map with the supplied name of the
+// supplied L3Out of the supplied tenant, or of the tenant itself if l3Out is
+// empty.
+func ProfileDn(tenant, l3Out, name string) string {
+	if l3Out == "" {
+		return fmt.Sprintf("uni/tn-%s/prof-%s", tenant, name)
+	}
+	return fmt.Sprintf("uni/tn-%s/out-%s/prof-%s", tenant, l3Out, name)
+}
+
+func contextDn(profileDn, name string) string {
+	return fmt.Sprintf("%s/ctx-%s", profileDn, name)
+}
+
+// NewProfile returns the rtctrlProfile of the supplied route map.
+func NewProfile(p v1alpha1.RouteControlProfileParameters) *mo.Object {
+	return mo.NewObject(RtctrlProfileClassName, ProfileDn(p.Tenant, p.L3Out, p.Name), map[string]string{
+		"name":  p.Name,
+		"descr": p.Description,
+		"type":  p.Type,
+	})
+}
+
+// ReconcileContexts converges the contexts of the route map with the
+// supplied DN. The order of each context is its position in the list.
+func ReconcileContexts(a *aciclient.Client, profileDn string, p v1alpha1.RouteControlProfileParameters) error {
+	ctxs := make([]*mo.Object, 0, len(p.Contexts))
+	for i, c := range p.Contexts {
+		ctxs = append(ctxs, mo.NewObject(rtctrlCtxPClassName, contextDn(profileDn, c.Name), map[string]string{
+			"name":   c.Name,
+			"descr":  c.Description,
+			"action": c.Action,
+			"order":  strconv.Itoa(i),
+		}))
+	}
+	if err := mo.ReconcileChildren(a, profileDn, rtctrlCtxPClassName, ctxs); err != nil {
+		return err
+	}
+	for _, c := range p.Contexts {
+		dn := contextDn(profileDn, c.Name)
+		refs := make([]*mo.Object, 0, len(c.MatchRules))
+		for _, m := range c.MatchRules {
+			refs = append(refs, mo.NewObject(rtctrlRsCtxPToSubjPClassName, fmt.Sprintf("%s/rsctxPToSubjP-%s", dn, m), map[string]string{
+				"tnRtctrlSubjPName": m,
+			}))
+		}
+		if err := mo.ReconcileChildren(a, dn, rtctrlRsCtxPToSubjPClassName, refs); err != nil {
+			return err
+		}
+		scopeDn := fmt.Sprintf("%s/scp", dn)
+		if c.SetRule == "" {
+			if err := a.DeleteByDn(scopeDn, rtctrlScopeClassName); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := a.Save(mo.NewObject(rtctrlScopeClassName, scopeDn, map[string]string{})); err != nil {
+			return err
+		}
+		if err := a.Save(mo.NewObject(rtctrlRsScopeToAttrPClassName, fmt.Sprintf("%s/rsScopeToAttrP", scopeDn), map[string]string{
+			"tnRtctrlAttrPName": c.SetRule,
+		})); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readContexts(a *aciclient.Client, profileDn string) ([]v1alpha1.RouteControlContext, error) {
+	ctxs, err := mo.ReadChildren(a, profileDn, rtctrlCtxPClassName)
+	if err != nil {
+		return nil, err
+	}
+	// Contexts are compared in the order of the route map.
+	sort.SliceStable(ctxs, func(i, j int) bool {
+		x, _ := strconv.Atoi(ctxs[i]["order"])
+		y, _ := strconv.Atoi(ctxs[j]["order"])
+		return x < y
+	})
+	var contexts []v1alpha1.RouteControlContext
+	for _, c := range ctxs {
+		refs, err := mo.ReadChildren(a, c["dn"], rtctrlRsCtxPToSubjPClassName)
+		if err != nil {
+			return nil, err
+		}
+		var matchRules []string
+		for _, r := range refs {
+			matchRules = append(matchRules, r["tnRtctrlSubjPName"])
+		}
+		setRule, err := mo.ReadAttribute(a, fmt.Sprintf("%s/scp/rsScopeToAttrP", c["dn"]), rtctrlRsScopeToAttrPClassName, "tnRtctrlAttrPName")
+		if err != nil {
+			return nil, err
+		}
+		contexts = append(contexts, v1alpha1.RouteControlContext{
+			Name:        c["name"],
+			Description: c["descr"],
+			Action:      c["action"],
+			MatchRules:  matchRules,
+			SetRule:     setRule,
+		})
+	}
+	return contexts, nil
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.RouteControlProfile, t map[string]string) bool {
+
+	p := s.Spec.ForProvider
+	contexts, err := readContexts(a, ProfileDn(p.Tenant, p.L3Out, p.Name))
+	if err != nil {
+		return false
+	}
+
+	observed := &v1alpha1.RouteControlProfileParameters{
+		Name:        t["name"],
+		Tenant:      p.Tenant,
+		L3Out:       p.L3Out,
+		Description: t["descr"],
+		Type:        t["type"],
+		Contexts:    contexts,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(x, y string) bool { return x < y }))
+}
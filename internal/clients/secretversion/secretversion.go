@@ -0,0 +1,46 @@
+package secretversion
+
+import (
+	"context"
+	"fmt"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/jgomezve/provider-aci/internal/clients/annotation"
+)
+
+// Read returns the value of the secret key referenced by the supplied
+// selector and the version of its Secret. The version changes each time the
+// Secret is changed or replaced.
+func Read(ctx context.Context, kube client.Client, ref xpv1.SecretKeySelector) ([]byte, string, error) {
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, "", err
+	}
+	return s.Data[ref.Key], fmt.Sprintf("%s/%s", s.GetUID(), s.GetResourceVersion()), nil
+}
+
+// Changed returns whether the supplied Secret version differs from the one
+// recorded in the annotation key of mg. APIC never returns secrets, so this
+// is the only way to detect that one has to be pushed.
+func Changed(mg resource.Managed, key, version string) bool {
+	return mg.GetAnnotations()[key] != version
+}
+
+// Record records the supplied Secret version in the annotation key of mg,
+// once its secret was pushed. The annotation is persisted along with the
+// external name annotation when called from Create.
+func Record(mg resource.Managed, key, version string) {
+	meta.AddAnnotations(mg, map[string]string{key: version})
+}
+
+// Persist records the supplied Secret version in the annotation key of mg
+// and updates mg, once its secret was pushed by Update.
+func Persist(ctx context.Context, kube client.Client, mg resource.Managed, key, version string) error {
+	return annotation.Persist(ctx, kube, mg, map[string]string{key: version})
+}
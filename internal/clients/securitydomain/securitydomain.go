@@ -0,0 +1,62 @@
+package securitydomain
+
+import (
+	"fmt"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	AaaDomainClassName    = "aaaDomain"
+	aaaDomainRefClassName = "aaaDomainRef"
+)
+
+// DomainDn returns the DN of the security domain with the supplied name.
+func DomainDn(name string) string {
+	return fmt.Sprintf("uni/userext/domain-%s", name)
+}
+
+// NewDomainRef returns the aaaDomainRef attaching the object with the
+// supplied DN to the security domain with the supplied name.
+func NewDomainRef(parentDn, name string) *mo.Object {
+	return mo.NewObject(aaaDomainRefClassName, fmt.Sprintf("%s/domain-%s", parentDn, name), map[string]string{
+		"name": name,
+	})
+}
+
+// ReconcileDomainRefs attaches the object with the supplied DN to the
+// supplied security domains and detaches it from any other.
+func ReconcileDomainRefs(a *aciclient.Client, parentDn string, domains []string) error {
+	refs := make([]*mo.Object, 0, len(domains))
+	for _, d := range domains {
+		refs = append(refs, NewDomainRef(parentDn, d))
+	}
+	return mo.ReconcileChildren(a, parentDn, aaaDomainRefClassName, refs)
+}
+
+// DomainRefs returns the names of the security domains the object with the
+// supplied DN is attached to.
+func DomainRefs(a *aciclient.Client, parentDn string) ([]string, error) {
+	refs, err := mo.ReadChildren(a, parentDn, aaaDomainRefClassName)
+	if err != nil {
+		return nil, err
+	}
+	var domains []string
+	for _, r := range refs {
+		domains = append(domains, r["name"])
+	}
+	return domains, nil
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.SecurityDomain, t map[string]string) bool {
+	observed := &v1alpha1.SecurityDomainParameters{
+		Name:        t["name"],
+		Description: t["descr"],
+		NameAlias:   t["nameAlias"],
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider)
+}
@@ -0,0 +1,316 @@
+package servicegraphtemplate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/services/v1alpha1"
+	l4l7deviceutil "github.com/jgomezve/provider-aci/internal/clients/l4l7device"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	VnsAbsGraphClassName             = "vnsAbsGraph"
+	vnsAbsNodeClassName              = "vnsAbsNode"
+	vnsAbsFuncConnClassName          = "vnsAbsFuncConn"
+	vnsRsNodeToLDevClassName         = "vnsRsNodeToLDev"
+	vnsAbsTermNodeConClassName       = "vnsAbsTermNodeCon"
+	vnsAbsTermNodeProvClassName      = "vnsAbsTermNodeProv"
+	vnsAbsTermConnClassName          = "vnsAbsTermConn"
+	vnsInTermClassName               = "vnsInTerm"
+	vnsOutTermClassName              = "vnsOutTerm"
+	vnsAbsConnectionClassName        = "vnsAbsConnection"
+	vnsRsAbsConnectionConnsClassName = "vnsRsAbsConnectionConns"
+	vzRsSubjGraphAttClassName        = "vzRsSubjGraphAtt"
+
+	consumerConnector = "consumer"
+	providerConnector = "provider"
+	consumerTerminal  = "T1"
+	providerTerminal  = "T2"
+)
+
+// GraphDn returns the DN of the service graph template with the supplied
+// name of the supplied tenant.
+func GraphDn(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/AbsGraph-%s", tenant, name)
+}
+
+// NodeDn returns the DN of the function node with the supplied name of the
+// graph with the supplied DN.
+func NodeDn(graphDn, name string) string {
+	return fmt.Sprintf("%s/AbsNode-%s", graphDn, name)
+}
+
+// SubjectGraphDn returns the DN of the graph attachment of the supplied
+// subject of the supplied contract of the supplied tenant.
+func SubjectGraphDn(tenant, contract, subject string) string {
+	return fmt.Sprintf("uni/tn-%s/brc-%s/subj-%s/rsSubjGraphAtt", tenant, contract, subject)
+}
+
+func funcConnDn(nodeDn, connector string) string {
+	return fmt.Sprintf("%s/AbsFConn-%s", nodeDn, connector)
+}
+
+func consumerTermDn(graphDn string) string {
+	return fmt.Sprintf("%s/AbsTermNodeCon-%s/AbsTConn", graphDn, consumerTerminal)
+}
+
+func providerTermDn(graphDn string) string {
+	return fmt.Sprintf("%s/AbsTermNodeProv-%s/AbsTConn", graphDn, providerTerminal)
+}
+
+// NewGraph returns the vnsAbsGraph of the supplied service graph template.
+func NewGraph(p v1alpha1.ServiceGraphTemplateParameters) *mo.Object {
+	return mo.NewObject(VnsAbsGraphClassName, GraphDn(p.Tenant, p.Name), map[string]string{
+		"name":  p.Name,
+		"descr": p.Description,
+	})
+}
+
+// connections returns the vnsAbsConnection chaining the consumer terminal,
+// the nodes in order and the provider terminal of the graph with the
+// supplied DN, along with the connectors each of them joins.
+func connections(graphDn string, p v1alpha1.ServiceGraphTemplateParameters) ([]*mo.Object, map[string][]string) {
+	conns := make([]*mo.Object, 0, len(p.Nodes)+1)
+	joins := map[string][]string{}
+	from := consumerTermDn(graphDn)
+	for i := 0; i <= len(p.Nodes); i++ {
+		to := providerTermDn(graphDn)
+		if i < len(p.Nodes) {
+			to = funcConnDn(NodeDn(graphDn, p.Nodes[i].Name), consumerConnector)
+		}
+		name := fmt.Sprintf("C%d", i+1)
+		dn := fmt.Sprintf("%s/AbsConnection-%s", graphDn, name)
+		conns = append(conns, mo.NewObject(vnsAbsConnectionClassName, dn, map[string]string{
+			"name":          name,
+			"adjType":       p.AdjacencyType,
+			"connDir":       providerConnector,
+			"connType":      "external",
+			"directConnect": "no",
+			"unicastRoute":  "yes",
+		}))
+		joins[dn] = []string{from, to}
+		if i < len(p.Nodes) {
+			from = funcConnDn(NodeDn(graphDn, p.Nodes[i].Name), providerConnector)
+		}
+	}
+	return conns, joins
+}
+
+func saveTerminal(a *aciclient.Client, className, dn, name string) error {
+	if err := a.Save(mo.NewObject(className, dn, map[string]string{"name": name})); err != nil {
+		return err
+	}
+	if err := a.Save(mo.NewObject(vnsAbsTermConnClassName, fmt.Sprintf("%s/AbsTConn", dn), map[string]string{"name": "1"})); err != nil {
+		return err
+	}
+	if err := a.Save(mo.NewObject(vnsInTermClassName, fmt.Sprintf("%s/intmnl", dn), map[string]string{})); err != nil {
+		return err
+	}
+	return a.Save(mo.NewObject(vnsOutTermClassName, fmt.Sprintf("%s/outtmnl", dn), map[string]string{}))
+}
+
+// ReconcileChildren converges the terminal nodes, the function nodes and the
+// connections of the graph with the supplied DN.
+func ReconcileChildren(a *aciclient.Client, graphDn string, p v1alpha1.ServiceGraphTemplateParameters) error {
+	if err := saveTerminal(a, vnsAbsTermNodeConClassName, fmt.Sprintf("%s/AbsTermNodeCon-%s", graphDn, consumerTerminal), consumerTerminal); err != nil {
+		return err
+	}
+	if err := saveTerminal(a, vnsAbsTermNodeProvClassName, fmt.Sprintf("%s/AbsTermNodeProv-%s", graphDn, providerTerminal), providerTerminal); err != nil {
+		return err
+	}
+
+	nodes := make([]*mo.Object, 0, len(p.Nodes))
+	for _, n := range p.Nodes {
+		nodes = append(nodes, mo.NewObject(vnsAbsNodeClassName, NodeDn(graphDn, n.Name), map[string]string{
+			"name":             n.Name,
+			"funcType":         n.FunctionType,
+			"funcTemplateType": n.FunctionTemplateType,
+			"routingMode":      n.RoutingMode,
+			"managed":          "no",
+		}))
+	}
+	if err := mo.ReconcileChildren(a, graphDn, vnsAbsNodeClassName, nodes); err != nil {
+		return err
+	}
+	for _, n := range p.Nodes {
+		nodeDn := NodeDn(graphDn, n.Name)
+		for _, c := range []string{consumerConnector, providerConnector} {
+			if err := a.Save(mo.NewObject(vnsAbsFuncConnClassName, funcConnDn(nodeDn, c), map[string]string{"name": c})); err != nil {
+				return err
+			}
+		}
+		if err := mo.SaveRelation(a, fmt.Sprintf("%s/rsNodeToLDev", nodeDn), vnsRsNodeToLDevClassName, l4l7deviceutil.DeviceDn(p.Tenant, n.Device)); err != nil {
+			return err
+		}
+	}
+
+	conns, joins := connections(graphDn, p)
+	if err := mo.ReconcileChildren(a, graphDn, vnsAbsConnectionClassName, conns); err != nil {
+		return err
+	}
+	for _, c := range conns {
+		refs := make([]*mo.Object, 0, 2)
+		for _, tDn := range joins[c.Dn] {
+			refs = append(refs, mo.NewObject(vnsRsAbsConnectionConnsClassName, fmt.Sprintf("%s/rsabsConnectionConns-[%s]", c.Dn, tDn), map[string]string{
+				"tDn": tDn,
+			}))
+		}
+		if err := mo.ReconcileChildren(a, c.Dn, vnsRsAbsConnectionConnsClassName, refs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readSubjects(a *aciclient.Client, tenant, name string) ([]map[string]string, error) {
+	atts, err := mo.ReadClass(a, vzRsSubjGraphAttClassName, fmt.Sprintf("eq(%s.tnVnsAbsGraphName,\"%s\")", vzRsSubjGraphAttClassName, name))
+	if err != nil {
+		return nil, err
+	}
+	var tenantAtts []map[string]string
+	for _, att := range atts {
+		if strings.HasPrefix(att["dn"], fmt.Sprintf("uni/tn-%s/", tenant)) {
+			tenantAtts = append(tenantAtts, att)
+		}
+	}
+	return tenantAtts, nil
+}
+
+// ReconcileSubjects attaches the graph to the desired contract subjects and
+// detaches it from the ones of the tenant that are no longer desired.
+func ReconcileSubjects(a *aciclient.Client, p v1alpha1.ServiceGraphTemplateParameters) error {
+	observed, err := readSubjects(a, p.Tenant, p.Name)
+	if err != nil {
+		return err
+	}
+	keep := map[string]bool{}
+	for _, s := range p.ContractSubjects {
+		dn := SubjectGraphDn(p.Tenant, s.Contract, s.Subject)
+		keep[dn] = true
+		if err := a.Save(mo.NewObject(vzRsSubjGraphAttClassName, dn, map[string]string{
+			"tnVnsAbsGraphName": p.Name,
+		})); err != nil {
+			return err
+		}
+	}
+	for _, o := range observed {
+		if !keep[o["dn"]] {
+			if err := a.DeleteByDn(o["dn"], vzRsSubjGraphAttClassName); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// subjectFromDn returns the contract subject of the graph attachment with
+// the supplied DN.
+func subjectFromDn(tenant, dn string) v1alpha1.ContractSubjectRef {
+	rest := strings.TrimSuffix(strings.TrimPrefix(dn, fmt.Sprintf("uni/tn-%s/brc-", tenant)), "/rsSubjGraphAtt")
+	parts := strings.SplitN(rest, "/subj-", 2)
+	if len(parts) != 2 {
+		return v1alpha1.ContractSubjectRef{Contract: rest}
+	}
+	return v1alpha1.ContractSubjectRef{Contract: parts[0], Subject: parts[1]}
+}
+
+func readNodes(a *aciclient.Client, graphDn, tenant string) ([]v1alpha1.ServiceNode, error) {
+	absNodes, err := mo.ReadChildren(a, graphDn, vnsAbsNodeClassName)
+	if err != nil {
+		return nil, err
+	}
+	var nodes []v1alpha1.ServiceNode
+	for _, n := range absNodes {
+		lDev, err := mo.ReadRelation(a, fmt.Sprintf("%s/rsNodeToLDev", n["dn"]), vnsRsNodeToLDevClassName)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, v1alpha1.ServiceNode{
+			Name:                 n["name"],
+			Device:               strings.TrimPrefix(lDev, fmt.Sprintf("uni/tn-%s/lDevVip-", tenant)),
+			FunctionType:         n["funcType"],
+			FunctionTemplateType: n["funcTemplateType"],
+			RoutingMode:          n["routingMode"],
+		})
+	}
+	return nodes, nil
+}
+
+// connectionsUptoDate returns whether the connections of the graph with the
+// supplied DN join the connectors the supplied parameters derive.
+func connectionsUptoDate(a *aciclient.Client, graphDn string, p v1alpha1.ServiceGraphTemplateParameters) bool {
+	observed, err := mo.ReadChildren(a, graphDn, vnsAbsConnectionClassName)
+	if err != nil {
+		return false
+	}
+	desired, joins := connections(graphDn, p)
+	if len(observed) != len(desired) {
+		return false
+	}
+	adjTypes := map[string]string{}
+	for _, o := range observed {
+		adjTypes[o["dn"]] = o["adjType"]
+	}
+	for _, c := range desired {
+		if adjType, ok := adjTypes[c.Dn]; !ok || adjType != p.AdjacencyType {
+			return false
+		}
+		refs, err := mo.ReadChildren(a, c.Dn, vnsRsAbsConnectionConnsClassName)
+		if err != nil {
+			return false
+		}
+		var tDns []string
+		for _, r := range refs {
+			tDns = append(tDns, r["tDn"])
+		}
+		want := append([]string{}, joins[c.Dn]...)
+		sort.Strings(tDns)
+		sort.Strings(want)
+		if !cmp.Equal(tDns, want) {
+			return false
+		}
+	}
+	return true
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.ServiceGraphTemplate, t map[string]string) bool {
+
+	p := s.Spec.ForProvider
+	dn := GraphDn(p.Tenant, p.Name)
+	if !connectionsUptoDate(a, dn, p) {
+		return false
+	}
+	nodes, err := readNodes(a, dn, p.Tenant)
+	if err != nil {
+		return false
+	}
+	atts, err := readSubjects(a, p.Tenant, p.Name)
+	if err != nil {
+		return false
+	}
+	var subjects []v1alpha1.ContractSubjectRef
+	for _, att := range atts {
+		subjects = append(subjects, subjectFromDn(p.Tenant, att["dn"]))
+	}
+
+	observed := &v1alpha1.ServiceGraphTemplateParameters{
+		Name:             t["name"],
+		Tenant:           p.Tenant,
+		Description:      t["descr"],
+		Nodes:            nodes,
+		AdjacencyType:    p.AdjacencyType,
+		ContractSubjects: subjects,
+	}
+
+	// The order of the nodes is checked through the connections.
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(x, y v1alpha1.ServiceNode) bool { return x.Name < y.Name }),
+		cmpopts.SortSlices(func(x, y v1alpha1.ContractSubjectRef) bool {
+			return x.Contract+"/"+x.Subject < y.Contract+"/"+y.Subject
+		}))
+}
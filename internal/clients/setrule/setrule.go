@@ -0,0 +1,125 @@
+package setrule
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	RtctrlAttrPClassName        = "rtctrlAttrP"
+	rtctrlSetCommClassName      = "rtctrlSetComm"
+	rtctrlSetPrefClassName      = "rtctrlSetPref"
+	rtctrlSetRtMetricClassName  = "rtctrlSetRtMetric"
+	rtctrlSetASPathClassName    = "rtctrlSetASPath"
+	rtctrlSetASPathASNClassName = "rtctrlSetASPathASN"
+)
+
+// SetRuleDn returns the DN of the set rule with the supplied name of the
+// supplied tenant.
+func SetRuleDn(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/attr-%s", tenant, name)
+}
+
+// NewSetRule returns the rtctrlAttrP of the supplied set rule.
+func NewSetRule(p v1alpha1.SetRuleParameters) *mo.Object {
+	return mo.NewObject(RtctrlAttrPClassName, SetRuleDn(p.Tenant, p.Name), map[string]string{
+		"name":  p.Name,
+		"descr": p.Description,
+	})
+}
+
+// saveAction saves the action of the supplied class with the supplied DN,
+// or removes it if it is not set.
+func saveAction(a *aciclient.Client, className, dn string, set bool, attrs map[string]string) error {
+	if !set {
+		return a.DeleteByDn(dn, className)
+	}
+	return a.Save(mo.NewObject(className, dn, attrs))
+}
+
+// ReconcileActions converges the community, local preference, metric and
+// AS path actions of the set rule with the supplied DN.
+func ReconcileActions(a *aciclient.Client, ruleDn string, p v1alpha1.SetRuleParameters) error {
+	comm := p.Community
+	if comm == nil {
+		comm = &v1alpha1.SetCommunity{}
+	}
+	if err := saveAction(a, rtctrlSetCommClassName, fmt.Sprintf("%s/scomm", ruleDn), p.Community != nil, map[string]string{
+		"community":   comm.Community,
+		"setCriteria": comm.Criteria,
+	}); err != nil {
+		return err
+	}
+	if err := saveAction(a, rtctrlSetPrefClassName, fmt.Sprintf("%s/spref", ruleDn), p.LocalPreference != "", map[string]string{
+		"localPref": p.LocalPreference,
+	}); err != nil {
+		return err
+	}
+	if err := saveAction(a, rtctrlSetRtMetricClassName, fmt.Sprintf("%s/smetric", ruleDn), p.Metric != "", map[string]string{
+		"metric": p.Metric,
+	}); err != nil {
+		return err
+	}
+
+	asPathDn := fmt.Sprintf("%s/saspath-prepend", ruleDn)
+	if err := saveAction(a, rtctrlSetASPathClassName, asPathDn, len(p.ASPathPrepend) > 0, map[string]string{
+		"criteria": "prepend",
+	}); err != nil || len(p.ASPathPrepend) == 0 {
+		return err
+	}
+	asns := make([]*mo.Object, 0, len(p.ASPathPrepend))
+	for i, asn := range p.ASPathPrepend {
+		asns = append(asns, mo.NewObject(rtctrlSetASPathASNClassName, fmt.Sprintf("%s/asn-%d", asPathDn, i), map[string]string{
+			"asn":   asn,
+			"order": strconv.Itoa(i),
+		}))
+	}
+	return mo.ReconcileChildren(a, asPathDn, rtctrlSetASPathASNClassName, asns)
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.SetRule, t map[string]string) bool {
+
+	p := s.Spec.ForProvider
+	dn := SetRuleDn(p.Tenant, p.Name)
+	observed := &v1alpha1.SetRuleParameters{
+		Name:        t["name"],
+		Tenant:      p.Tenant,
+		Description: t["descr"],
+	}
+
+	comm, err := mo.Read(a, fmt.Sprintf("%s/scomm", dn), rtctrlSetCommClassName)
+	if err != nil {
+		return false
+	}
+	if comm != nil {
+		observed.Community = &v1alpha1.SetCommunity{Community: comm["community"], Criteria: comm["setCriteria"]}
+	}
+	if observed.LocalPreference, err = mo.ReadAttribute(a, fmt.Sprintf("%s/spref", dn), rtctrlSetPrefClassName, "localPref"); err != nil {
+		return false
+	}
+	if observed.Metric, err = mo.ReadAttribute(a, fmt.Sprintf("%s/smetric", dn), rtctrlSetRtMetricClassName, "metric"); err != nil {
+		return false
+	}
+	asns, err := mo.ReadChildren(a, fmt.Sprintf("%s/saspath-prepend", dn), rtctrlSetASPathASNClassName)
+	if err != nil {
+		return false
+	}
+	// The AS numbers are compared in the order they are prepended.
+	sort.SliceStable(asns, func(i, j int) bool {
+		x, _ := strconv.Atoi(asns[i]["order"])
+		y, _ := strconv.Atoi(asns[j]["order"])
+		return x < y
+	})
+	for _, asn := range asns {
+		observed.ASPathPrepend = append(observed.ASPathPrepend, asn["asn"])
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,177 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisv1alpha1 "github.com/jgomezve/provider-aci/apis/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/annotation"
+	configexportpolicyutil "github.com/jgomezve/provider-aci/internal/clients/configexportpolicy"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	// AnnotationKeySnapshot records the file name of the last snapshot taken
+	// before a destructive change of the annotated resource.
+	AnnotationKeySnapshot = "aci.crossplane.io/last-snapshot"
+	// AnnotationKeyPendingSnapshot records the name of the last job of the
+	// export policy when a snapshot was triggered, until the snapshot
+	// completes. The snapshot is the first job that follows it.
+	AnnotationKeyPendingSnapshot = "aci.crossplane.io/pending-snapshot"
+	// AnnotationKeyPendingSnapshotTime records when the pending snapshot was
+	// triggered.
+	AnnotationKeyPendingSnapshotTime = "aci.crossplane.io/pending-snapshot-time"
+
+	reasonSnapshot event.Reason = "TookSnapshot"
+
+	defaultTimeout = 5 * time.Minute
+	// defaultWait is how long a reconcile waits for a snapshot, well within
+	// the one minute a reconcile is given.
+	defaultWait  = 20 * time.Second
+	pollInterval = 2 * time.Second
+
+	errSnapshotFailed  = "snapshot %s of tenant %s failed: %s"
+	errSnapshotTimeout = "timed out waiting for the snapshot of tenant %s"
+	errSnapshotPending = "waiting for the snapshot of tenant %s before %s"
+	errAnnotate        = "cannot record snapshot annotation"
+)
+
+// A Snapshotter takes a snapshot of a tenant before a destructive change.
+type Snapshotter struct {
+	apicClient *aciclient.Client
+	kube       client.Client
+	recorder   event.Recorder
+	timeout    time.Duration
+	wait       time.Duration
+	poll       time.Duration
+}
+
+// NewSnapshotter returns a Snapshotter if the supplied ProviderConfig enables
+// snapshots before changes, and nil otherwise. A nil Snapshotter takes no
+// snapshots.
+func NewSnapshotter(a *aciclient.Client, kube client.Client, recorder event.Recorder, pc *apisv1alpha1.ProviderConfig) *Snapshotter {
+	cfg := pc.Spec.Snapshot
+	if cfg == nil || !cfg.BeforeChanges {
+		return nil
+	}
+	timeout := defaultTimeout
+	if cfg.Timeout != nil {
+		timeout = cfg.Timeout.Duration
+	}
+	return &Snapshotter{apicClient: a, kube: kube, recorder: recorder, timeout: timeout, wait: defaultWait, poll: pollInterval}
+}
+
+// PolicyName returns the name of the export policy used to snapshot the
+// tenant with the supplied name.
+func PolicyName(tenant string) string {
+	return fmt.Sprintf("crossplane-%s", tenant)
+}
+
+// Take snapshots the supplied tenant before the supplied change of mg. It
+// returns nil once the snapshot completed, and an error while it is pending,
+// so that the change is retried by the next reconcile. The snapshot is
+// triggered once and recorded as pending in annotations of mg; the next
+// reconciles wait for it instead of triggering another one. The file name
+// of the snapshot is recorded in an event and an annotation of mg.
+func (s *Snapshotter) Take(ctx context.Context, mg resource.Managed, tenant, change string) error {
+	if s == nil {
+		return nil
+	}
+	dn := configexportpolicyutil.PolicyDn(PolicyName(tenant))
+	previous, triggered, pending := pendingSnapshot(mg)
+	if !pending {
+		last, err := configexportpolicyutil.ReadLastJob(s.apicClient, dn)
+		if err != nil {
+			return err
+		}
+		previous, triggered = last.Name, time.Now()
+		// The snapshot is recorded as pending before it is triggered, so
+		// that it is never triggered twice for the same change.
+		if err := annotation.Persist(ctx, s.kube, mg, map[string]string{
+			AnnotationKeyPendingSnapshot:     previous,
+			AnnotationKeyPendingSnapshotTime: triggered.Format(time.RFC3339),
+		}); err != nil {
+			return errors.Wrap(err, errAnnotate)
+		}
+		err = s.apicClient.Save(mo.NewObject(configexportpolicyutil.ConfigExportPClassName, dn, map[string]string{
+			"name":     PolicyName(tenant),
+			"descr":    "Tenant snapshot taken by Crossplane before destructive changes",
+			"format":   "json",
+			"snapshot": "yes",
+			"targetDn": fmt.Sprintf("uni/tn-%s", tenant),
+			"adminSt":  "triggered",
+		}))
+		if err != nil {
+			return s.clear(ctx, mg, err)
+		}
+	}
+
+	deadline := time.Now().Add(s.wait)
+	for {
+		job, err := configexportpolicyutil.ReadLastJob(s.apicClient, dn)
+		if err != nil {
+			return err
+		}
+		if job.Name != "" && job.Name != previous {
+			switch job.Status {
+			case "success":
+				return s.record(ctx, mg, tenant, change, job.FileName)
+			case "failed", "fail":
+				return s.clear(ctx, mg, fmt.Errorf(errSnapshotFailed, job.Name, tenant, job.Details))
+			}
+		}
+		if time.Since(triggered) > s.timeout {
+			return s.clear(ctx, mg, fmt.Errorf(errSnapshotTimeout, tenant))
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf(errSnapshotPending, tenant, change)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf(errSnapshotPending, tenant, change)
+		case <-time.After(s.poll):
+		}
+	}
+}
+
+// pendingSnapshot returns the last job of the export policy when the pending
+// snapshot of mg was triggered and when it was, and whether there is one.
+func pendingSnapshot(mg resource.Managed) (string, time.Time, bool) {
+	previous, ok := mg.GetAnnotations()[AnnotationKeyPendingSnapshot]
+	if !ok {
+		return "", time.Time{}, false
+	}
+	triggered, err := time.Parse(time.RFC3339, mg.GetAnnotations()[AnnotationKeyPendingSnapshotTime])
+	if err != nil {
+		return "", time.Time{}, false
+	}
+	return previous, triggered, true
+}
+
+// record records the file name of the completed snapshot and clears the
+// pending snapshot. The annotations are persisted on a copy of mg, so that
+// the status of mg that is yet to be persisted is kept.
+func (s *Snapshotter) record(ctx context.Context, mg resource.Managed, tenant, change, fileName string) error {
+	s.recorder.Event(mg, event.Normal(reasonSnapshot, fmt.Sprintf("Took snapshot %s of tenant %s before %s", fileName, tenant, change)))
+	meta.RemoveAnnotations(mg, AnnotationKeyPendingSnapshot, AnnotationKeyPendingSnapshotTime)
+	return errors.Wrap(annotation.Persist(ctx, s.kube, mg, map[string]string{AnnotationKeySnapshot: fileName}), errAnnotate)
+}
+
+// clear clears the pending snapshot of mg that failed with the supplied
+// error, so that the next reconcile triggers a new one, and returns the
+// error.
+func (s *Snapshotter) clear(ctx context.Context, mg resource.Managed, err error) error {
+	meta.RemoveAnnotations(mg, AnnotationKeyPendingSnapshot, AnnotationKeyPendingSnapshotTime)
+	if uerr := annotation.Persist(ctx, s.kube, mg, nil); uerr != nil {
+		return errors.Wrap(uerr, errAnnotate)
+	}
+	return err
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+const (
+	jobsPath = "/api/node/mo/uni/backupst/jobs-[uni/fabric/configexp-crossplane-shop].json"
+	policyDn = "uni/fabric/configexp-crossplane-shop"
+)
+
+// jobs returns the jobs of the export policy of the tenant shop, the job-1
+// that completed before the snapshot and the supplied job-2, if any.
+func jobs(status string) string {
+	job2 := ""
+	if status != "" {
+		job2 = fmt.Sprintf(`,{"configJob":{"attributes":{"name":"job-2","operSt":%q,"fileName":"snapshot-2.tar.gz","details":"no space left","executeTime":"2026-10-19T10:05:00.000+00:00"}}}`, status)
+	}
+	return `{"totalCount":"2","imdata":[{"configJob":{"attributes":{"name":"job-1","operSt":"success","fileName":"snapshot-1.tar.gz","executeTime":"2026-10-19T10:00:00.000+00:00"}}}` + job2 + `]}`
+}
+
+// vrf returns a managed resource with a snapshot triggered at the supplied
+// time after job-1, if the time is not zero.
+func vrf(triggered time.Time) *v1alpha1.Vrf {
+	cr := &v1alpha1.Vrf{ObjectMeta: metav1.ObjectMeta{Name: "web"}}
+	if !triggered.IsZero() {
+		cr.SetAnnotations(map[string]string{
+			AnnotationKeyPendingSnapshot:     "job-1",
+			AnnotationKeyPendingSnapshotTime: triggered.Format(time.RFC3339),
+		})
+	}
+	return cr
+}
+
+// exports returns how many snapshots were triggered by the supplied POSTs.
+func exports(posts []fakeapic.Request) int {
+	n := 0
+	for _, p := range posts {
+		if fakeapic.Attribute(p.Body, "configExportP", "dn") == policyDn && fakeapic.Attribute(p.Body, "configExportP", "adminSt") == "triggered" {
+			n++
+		}
+	}
+	return n
+}
+
+func TestTake(t *testing.T) {
+	type want struct {
+		err     error
+		exports int
+		// annotations are the annotations last persisted, nil if none were.
+		annotations map[string]string
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.Vrf
+		jobs   string
+		want   want
+	}{
+		"Triggered": {
+			reason: "A snapshot should be recorded as pending before it is triggered, and the change wait for it",
+			mg:     vrf(time.Time{}),
+			jobs:   jobs(""),
+			want: want{
+				err:     fmt.Errorf(errSnapshotPending, "shop", "deleting web"),
+				exports: 1,
+				annotations: map[string]string{
+					AnnotationKeyPendingSnapshot: "job-1",
+				},
+			},
+		},
+		"InProgress": {
+			reason: "A pending snapshot should be waited for without triggering another one",
+			mg:     vrf(time.Now()),
+			jobs:   jobs("running"),
+			want: want{
+				err: fmt.Errorf(errSnapshotPending, "shop", "deleting web"),
+			},
+		},
+		"Completed": {
+			reason: "A completed snapshot should be recorded and the pending snapshot cleared",
+			mg:     vrf(time.Now()),
+			jobs:   jobs("success"),
+			want: want{
+				annotations: map[string]string{
+					AnnotationKeySnapshot: "snapshot-2.tar.gz",
+				},
+			},
+		},
+		"Failed": {
+			reason: "A failed snapshot should be cleared so that the next reconcile triggers a new one",
+			mg:     vrf(time.Now()),
+			jobs:   jobs("failed"),
+			want: want{
+				err:         fmt.Errorf(errSnapshotFailed, "job-2", "shop", "no space left"),
+				annotations: map[string]string{},
+			},
+		},
+		"TimedOut": {
+			reason: "A snapshot pending for longer than the timeout should be cleared",
+			mg:     vrf(time.Now().Add(-time.Hour)),
+			jobs:   jobs(""),
+			want: want{
+				err:         fmt.Errorf(errSnapshotTimeout, "shop"),
+				annotations: map[string]string{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apic := fakeapic.New()
+			defer apic.Close()
+			apic.RespondChildren(jobsPath, tc.jobs)
+
+			var persisted map[string]string
+			kube := &test.MockClient{MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+				if _, ok := obj.GetAnnotations()[AnnotationKeyPendingSnapshot]; ok && exports(apic.Posts()) > 0 {
+					t.Errorf("\n%s\ns.Take(...): the snapshot should be recorded as pending before it is triggered", tc.reason)
+				}
+				persisted = map[string]string{}
+				for k, v := range obj.GetAnnotations() {
+					persisted[k] = v
+				}
+				return nil
+			}}
+
+			s := &Snapshotter{
+				apicClient: apic.APICClient(),
+				kube:       kube,
+				recorder:   event.NewNopRecorder(),
+				timeout:    time.Minute,
+				wait:       10 * time.Millisecond,
+				poll:       time.Millisecond,
+			}
+			err := s.Take(context.Background(), tc.mg, "shop", "deleting web")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ns.Take(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.exports, exports(apic.Posts())); diff != "" {
+				t.Errorf("\n%s\ns.Take(...): -want exports, +got exports:\n%s\n", tc.reason, diff)
+			}
+			delete(persisted, AnnotationKeyPendingSnapshotTime)
+			if diff := cmp.Diff(tc.want.annotations, persisted); diff != "" {
+				t.Errorf("\n%s\ns.Take(...): -want persisted annotations, +got persisted annotations:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTakeNil(t *testing.T) {
+	var s *Snapshotter
+	if err := s.Take(context.Background(), vrf(time.Time{}), "shop", "deleting web"); err != nil {
+		t.Errorf("s.Take(...): a nil Snapshotter should take no snapshot, got %s", err)
+	}
+}
@@ -0,0 +1,151 @@
+package snmppolicy
+
+import (
+	"fmt"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/fabric/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	SnmpPolClassName        = "snmpPol"
+	snmpCommunityPClassName = "snmpCommunityP"
+	snmpClientGrpPClassName = "snmpClientGrpP"
+	snmpClientPClassName    = "snmpClientP"
+	snmpRsEpgClassName      = "snmpRsEpg"
+)
+
+// PolicyDn returns the DN of the SNMP policy with the supplied name.
+func PolicyDn(name string) string {
+	return fmt.Sprintf("uni/fabric/snmppol-%s", name)
+}
+
+func clientGroupDn(policyDn, name string) string {
+	return fmt.Sprintf("%s/clgrp-%s", policyDn, name)
+}
+
+// NewCommunity returns the snmpCommunityP with the supplied name of the
+// policy with the supplied DN.
+func NewCommunity(policyDn, name string) *mo.Object {
+	return mo.NewObject(snmpCommunityPClassName, fmt.Sprintf("%s/community-%s", policyDn, name), map[string]string{
+		"name": name,
+	})
+}
+
+// NewClientGroup returns the snmpClientGrpP g of the policy with the
+// supplied DN.
+func NewClientGroup(policyDn string, g v1alpha1.SNMPClientGroup) *mo.Object {
+	return mo.NewObject(snmpClientGrpPClassName, clientGroupDn(policyDn, g.Name), map[string]string{
+		"name":  g.Name,
+		"descr": g.Description,
+	})
+}
+
+// NewClient returns the snmpClientP c of the client group with the supplied
+// DN.
+func NewClient(groupDn string, c v1alpha1.SNMPClient) *mo.Object {
+	return mo.NewObject(snmpClientPClassName, fmt.Sprintf("%s/client-[%s]", groupDn, c.Address), map[string]string{
+		"addr": c.Address,
+		"name": c.Name,
+	})
+}
+
+// ReconcileChildren converges the communities and the client groups of the
+// policy with the supplied DN.
+func ReconcileChildren(a *aciclient.Client, policyDn string, p v1alpha1.SNMPPolicyParameters) error {
+	communities := make([]*mo.Object, 0, len(p.Communities))
+	for _, c := range p.Communities {
+		communities = append(communities, NewCommunity(policyDn, c))
+	}
+	if err := mo.ReconcileChildren(a, policyDn, snmpCommunityPClassName, communities); err != nil {
+		return err
+	}
+	groups := make([]*mo.Object, 0, len(p.ClientGroups))
+	for _, g := range p.ClientGroups {
+		groups = append(groups, NewClientGroup(policyDn, g))
+	}
+	if err := mo.ReconcileChildren(a, policyDn, snmpClientGrpPClassName, groups); err != nil {
+		return err
+	}
+	for _, g := range p.ClientGroups {
+		groupDn := clientGroupDn(policyDn, g.Name)
+		if err := mo.SaveRelation(a, groupDn+"/rsepg", snmpRsEpgClassName, mo.ManagementEPGDn(g.ManagementEPG)); err != nil {
+			return err
+		}
+		clients := make([]*mo.Object, 0, len(g.Clients))
+		for _, c := range g.Clients {
+			clients = append(clients, NewClient(groupDn, c))
+		}
+		if err := mo.ReconcileChildren(a, groupDn, snmpClientPClassName, clients); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadClientGroups returns the client groups configured on the policy with
+// the supplied DN.
+func ReadClientGroups(a *aciclient.Client, policyDn string) ([]v1alpha1.SNMPClientGroup, error) {
+	children, err := mo.ReadChildren(a, policyDn, snmpClientGrpPClassName)
+	if err != nil {
+		return nil, err
+	}
+	var groups []v1alpha1.SNMPClientGroup
+	for _, g := range children {
+		epg, err := mo.ReadRelation(a, g["dn"]+"/rsepg", snmpRsEpgClassName)
+		if err != nil {
+			return nil, err
+		}
+		cs, err := mo.ReadChildren(a, g["dn"], snmpClientPClassName)
+		if err != nil {
+			return nil, err
+		}
+		var clients []v1alpha1.SNMPClient
+		for _, c := range cs {
+			clients = append(clients, v1alpha1.SNMPClient{Address: c["addr"], Name: c["name"]})
+		}
+		groups = append(groups, v1alpha1.SNMPClientGroup{
+			Name:          g["name"],
+			Description:   g["descr"],
+			ManagementEPG: mo.ManagementEPGFromDn(epg),
+			Clients:       clients,
+		})
+	}
+	return groups, nil
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.SNMPPolicy, t map[string]string) bool {
+
+	dn := PolicyDn(s.Spec.ForProvider.Name)
+	cs, err := mo.ReadChildren(a, dn, snmpCommunityPClassName)
+	if err != nil {
+		return false
+	}
+	var communities []string
+	for _, c := range cs {
+		communities = append(communities, c["name"])
+	}
+	groups, err := ReadClientGroups(a, dn)
+	if err != nil {
+		return false
+	}
+
+	observed := &v1alpha1.SNMPPolicyParameters{
+		Name:         t["name"],
+		Description:  t["descr"],
+		NameAlias:    t["nameAlias"],
+		AdminState:   t["adminSt"],
+		Contact:      t["contact"],
+		Location:     t["loc"],
+		Communities:  communities,
+		ClientGroups: groups,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(x, y string) bool { return x < y }),
+		cmpopts.SortSlices(func(x, y v1alpha1.SNMPClientGroup) bool { return x.Name < y.Name }),
+		cmpopts.SortSlices(func(x, y v1alpha1.SNMPClient) bool { return x.Address < y.Address }))
+}
@@ -0,0 +1,88 @@
+package spandestinationgroup
+
+import (
+	"fmt"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/monitoring/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	SpanDestGrpClassName   = "spanDestGrp"
+	spanDestClassName      = "spanDest"
+	spanRsDestEpgClassName = "spanRsDestEpg"
+)
+
+// DestinationGroupDn returns the DN of the SPAN destination group with the
+// supplied name of the supplied tenant.
+func DestinationGroupDn(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/destgrp-%s", tenant, name)
+}
+
+// destinationDn returns the DN of the destination of the SPAN destination
+// group with the supplied DN, which is named after the group.
+func destinationDn(grpDn, name string) string {
+	return fmt.Sprintf("%s/dest-%s", grpDn, name)
+}
+
+// NewDestinationGroup returns the spanDestGrp of the supplied SPAN
+// destination group.
+func NewDestinationGroup(p v1alpha1.SpanDestinationGroupParameters) *mo.Object {
+	return mo.NewObject(SpanDestGrpClassName, DestinationGroupDn(p.Tenant, p.Name), map[string]string{
+		"name":  p.Name,
+		"descr": p.Description,
+	})
+}
+
+// ReconcileDestination converges the ERSPAN destination of the SPAN
+// destination group with the supplied DN.
+func ReconcileDestination(a *aciclient.Client, grpDn string, p v1alpha1.SpanDestinationGroupParameters) error {
+	dn := destinationDn(grpDn, p.Name)
+	if err := a.Save(mo.NewObject(spanDestClassName, dn, map[string]string{
+		"name": p.Name,
+	})); err != nil {
+		return err
+	}
+	d := p.Destination
+	return a.Save(mo.NewObject(spanRsDestEpgClassName, fmt.Sprintf("%s/rsdestEpg", dn), map[string]string{
+		"tDn":         d.EndpointGroupDn,
+		"ip":          d.DestinationIP,
+		"srcIpPrefix": d.SourceIPPrefix,
+		"ver":         d.Version,
+		"dscp":        d.DSCP,
+		"ttl":         d.TTL,
+		"mtu":         d.Mtu,
+		"flowId":      d.FlowID,
+	}))
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.SpanDestinationGroup, t map[string]string) bool {
+
+	p := s.Spec.ForProvider
+	rs, err := mo.Read(a, fmt.Sprintf("%s/rsdestEpg", destinationDn(DestinationGroupDn(p.Tenant, p.Name), p.Name)), spanRsDestEpgClassName)
+	if err != nil || rs == nil {
+		return false
+	}
+
+	observed := &v1alpha1.SpanDestinationGroupParameters{
+		Name:        t["name"],
+		Tenant:      p.Tenant,
+		Description: t["descr"],
+		Destination: v1alpha1.SpanERSPANDestination{
+			EndpointGroupDn: rs["tDn"],
+			DestinationIP:   rs["ip"],
+			SourceIPPrefix:  rs["srcIpPrefix"],
+			Version:         rs["ver"],
+			DSCP:            rs["dscp"],
+			TTL:             rs["ttl"],
+			Mtu:             rs["mtu"],
+			FlowID:          rs["flowId"],
+		},
+		ExpireAfter: p.ExpireAfter,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,126 @@
+package spansourcegroup
+
+import (
+	"fmt"
+	"strings"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/monitoring/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	SpanSrcGrpClassName     = "spanSrcGrp"
+	spanSpanLblClassName    = "spanSpanLbl"
+	spanSrcClassName        = "spanSrc"
+	spanRsSrcToEpgClassName = "spanRsSrcToEpg"
+)
+
+// SourceGroupDn returns the DN of the SPAN source group with the supplied
+// name of the supplied tenant.
+func SourceGroupDn(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/srcgrp-%s", tenant, name)
+}
+
+func epgDn(tenant, ap, epg string) string {
+	return fmt.Sprintf("uni/tn-%s/ap-%s/epg-%s", tenant, ap, epg)
+}
+
+// epgFromDn returns the application profile and the EPG of the EPG with the
+// supplied DN of the supplied tenant.
+func epgFromDn(tenant, dn string) (string, string) {
+	ap, epg, _ := strings.Cut(strings.TrimPrefix(dn, fmt.Sprintf("uni/tn-%s/ap-", tenant)), "/epg-")
+	return ap, epg
+}
+
+// NewSourceGroup returns the spanSrcGrp of the supplied SPAN source group.
+func NewSourceGroup(p v1alpha1.SpanSourceGroupParameters) *mo.Object {
+	return mo.NewObject(SpanSrcGrpClassName, SourceGroupDn(p.Tenant, p.Name), map[string]string{
+		"name":    p.Name,
+		"descr":   p.Description,
+		"adminSt": p.AdminState,
+	})
+}
+
+// ReconcileChildren converges the destination group and the sources of the
+// SPAN source group with the supplied DN.
+func ReconcileChildren(a *aciclient.Client, grpDn string, p v1alpha1.SpanSourceGroupParameters) error {
+	label := mo.NewObject(spanSpanLblClassName, fmt.Sprintf("%s/spanlbl-%s", grpDn, p.DestinationGroup), map[string]string{
+		"name": p.DestinationGroup,
+	})
+	if err := mo.ReconcileChildren(a, grpDn, spanSpanLblClassName, []*mo.Object{label}); err != nil {
+		return err
+	}
+
+	sources := make([]*mo.Object, 0, len(p.Sources))
+	for _, s := range p.Sources {
+		sources = append(sources, mo.NewObject(spanSrcClassName, fmt.Sprintf("%s/src-%s", grpDn, s.Name), map[string]string{
+			"name": s.Name,
+			"dir":  s.Direction,
+		}))
+	}
+	if err := mo.ReconcileChildren(a, grpDn, spanSrcClassName, sources); err != nil {
+		return err
+	}
+	for _, s := range p.Sources {
+		if err := mo.SaveRelation(a, fmt.Sprintf("%s/src-%s/rssrcToEpg", grpDn, s.Name), spanRsSrcToEpgClassName, epgDn(p.Tenant, s.ApplicationProfile, s.EndpointGroup)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readSources(a *aciclient.Client, grpDn, tenant string) ([]v1alpha1.SpanSource, error) {
+	srcs, err := mo.ReadChildren(a, grpDn, spanSrcClassName)
+	if err != nil {
+		return nil, err
+	}
+	var sources []v1alpha1.SpanSource
+	for _, s := range srcs {
+		epg, err := mo.ReadRelation(a, fmt.Sprintf("%s/rssrcToEpg", s["dn"]), spanRsSrcToEpgClassName)
+		if err != nil {
+			return nil, err
+		}
+		ap, epgName := epgFromDn(tenant, epg)
+		sources = append(sources, v1alpha1.SpanSource{
+			Name:               s["name"],
+			ApplicationProfile: ap,
+			EndpointGroup:      epgName,
+			Direction:          s["dir"],
+		})
+	}
+	return sources, nil
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.SpanSourceGroup, t map[string]string) bool {
+
+	p := s.Spec.ForProvider
+	dn := SourceGroupDn(p.Tenant, p.Name)
+	labels, err := mo.ReadChildren(a, dn, spanSpanLblClassName)
+	if err != nil {
+		return false
+	}
+	var destinationGroups []string
+	for _, l := range labels {
+		destinationGroups = append(destinationGroups, l["name"])
+	}
+	sources, err := readSources(a, dn, p.Tenant)
+	if err != nil {
+		return false
+	}
+
+	observed := &v1alpha1.SpanSourceGroupParameters{
+		Name:             t["name"],
+		Tenant:           p.Tenant,
+		Description:      t["descr"],
+		AdminState:       t["adminSt"],
+		DestinationGroup: strings.Join(destinationGroups, ","),
+		Sources:          sources,
+		ExpireAfter:      p.ExpireAfter,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(x, y v1alpha1.SpanSource) bool { return x.Name < y.Name }))
+}
@@ -0,0 +1,104 @@
+package sysloggroup
+
+import (
+	"fmt"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/fabric/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	SyslogGroupClassName            = "syslogGroup"
+	syslogRemoteDestClassName       = "syslogRemoteDest"
+	fileRsARemoteHostToEpgClassName = "fileRsARemoteHostToEpg"
+)
+
+// GroupDn returns the DN of the syslog group with the supplied name.
+func GroupDn(name string) string {
+	return fmt.Sprintf("uni/fabric/slgroup-%s", name)
+}
+
+func destinationDn(groupDn, host string) string {
+	return fmt.Sprintf("%s/rdst-%s", groupDn, host)
+}
+
+// NewRemoteDestination returns the syslogRemoteDest d of the group with the
+// supplied DN.
+func NewRemoteDestination(groupDn string, d v1alpha1.SyslogRemoteDestination) *mo.Object {
+	return mo.NewObject(syslogRemoteDestClassName, destinationDn(groupDn, d.Host), map[string]string{
+		"host":               d.Host,
+		"name":               d.Name,
+		"port":               d.Port,
+		"severity":           d.Severity,
+		"forwardingFacility": d.ForwardingFacility,
+		"adminState":         d.AdminState,
+	})
+}
+
+// ReadRemoteDestinations returns the remote destinations configured on the
+// group with the supplied DN.
+func ReadRemoteDestinations(a *aciclient.Client, groupDn string) ([]v1alpha1.SyslogRemoteDestination, error) {
+	children, err := mo.ReadChildren(a, groupDn, syslogRemoteDestClassName)
+	if err != nil {
+		return nil, err
+	}
+	var destinations []v1alpha1.SyslogRemoteDestination
+	for _, c := range children {
+		epg, err := mo.ReadRelation(a, fmt.Sprintf("%s/rsARemoteHostToEpg", c["dn"]), fileRsARemoteHostToEpgClassName)
+		if err != nil {
+			return nil, err
+		}
+		destinations = append(destinations, v1alpha1.SyslogRemoteDestination{
+			Host:               c["host"],
+			Name:               c["name"],
+			Port:               c["port"],
+			Severity:           c["severity"],
+			ForwardingFacility: c["forwardingFacility"],
+			AdminState:         c["adminState"],
+			ManagementEPG:      mo.ManagementEPGFromDn(epg),
+		})
+	}
+	return destinations, nil
+}
+
+// ReconcileRemoteDestinations converges the remote destinations of the group
+// with the supplied DN with the desired ones.
+func ReconcileRemoteDestinations(a *aciclient.Client, groupDn string, desired []v1alpha1.SyslogRemoteDestination) error {
+	objs := make([]*mo.Object, 0, len(desired))
+	for _, d := range desired {
+		objs = append(objs, NewRemoteDestination(groupDn, d))
+	}
+	if err := mo.ReconcileChildren(a, groupDn, syslogRemoteDestClassName, objs); err != nil {
+		return err
+	}
+	for _, d := range desired {
+		dn := fmt.Sprintf("%s/rsARemoteHostToEpg", destinationDn(groupDn, d.Host))
+		if err := mo.SaveRelation(a, dn, fileRsARemoteHostToEpgClassName, mo.ManagementEPGDn(d.ManagementEPG)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.SyslogGroup, t map[string]string) bool {
+
+	destinations, err := ReadRemoteDestinations(a, GroupDn(s.Spec.ForProvider.Name))
+	if err != nil {
+		return false
+	}
+
+	observed := &v1alpha1.SyslogGroupParameters{
+		Name:                t["name"],
+		Description:         t["descr"],
+		NameAlias:           t["nameAlias"],
+		Format:              t["format"],
+		IncludeMilliseconds: t["includeMilliSeconds"],
+		RemoteDestinations:  destinations,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(x, y v1alpha1.SyslogRemoteDestination) bool { return x.Host < y.Host }))
+}
@@ -0,0 +1,79 @@
+package tacacsplusprovider
+
+import (
+	"fmt"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	AaaTacacsPlusProviderClassName = "aaaTacacsPlusProvider"
+	aaaRsSecProvToEpgClassName     = "aaaRsSecProvToEpg"
+
+	// AnnotationKeyKeyVersion records the version of the Secret of the key last
+	// pushed to APIC.
+	AnnotationKeyKeyVersion = "aci.crossplane.io/key-secret-version"
+)
+
+// ProviderDn returns the DN of the TACACS+ provider with the supplied name.
+func ProviderDn(name string) string {
+	return fmt.Sprintf("uni/userext/tacacsext/tacacsplusprovider-%s", name)
+}
+
+// EPGRelationDn returns the DN of the management EPG relation of the
+// provider with the supplied DN.
+func EPGRelationDn(providerDn string) string {
+	return fmt.Sprintf("%s/rsSecProvToEpg", providerDn)
+}
+
+// NewProvider returns the aaaTacacsPlusProvider of the supplied provider. The
+// key is only set if it is not empty.
+func NewProvider(p v1alpha1.TACACSPlusProviderParameters, key string) *mo.Object {
+	attrs := map[string]string{
+		"name":           p.Name,
+		"descr":          p.Description,
+		"port":           p.Port,
+		"authProtocol":   p.AuthProtocol,
+		"timeout":        p.Timeout,
+		"retries":        p.Retries,
+		"monitorServer":  p.MonitorServer,
+		"monitoringUser": p.MonitoringUser,
+	}
+	if key != "" {
+		attrs["key"] = key
+	}
+	return mo.NewObject(AaaTacacsPlusProviderClassName, ProviderDn(p.Name), attrs)
+}
+
+// SaveManagementEPG points the provider with the supplied DN to its
+// management EPG.
+func SaveManagementEPG(a *aciclient.Client, providerDn string, p v1alpha1.TACACSPlusProviderParameters) error {
+	return mo.SaveRelation(a, EPGRelationDn(providerDn), aaaRsSecProvToEpgClassName, mo.ManagementEPGDn(p.ManagementEPG))
+}
+
+// IsUptoDate compares everything but the key, which APIC never returns.
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.TACACSPlusProvider, t map[string]string) bool {
+
+	epg, err := mo.ReadRelation(a, EPGRelationDn(t["dn"]), aaaRsSecProvToEpgClassName)
+	if err != nil {
+		return false
+	}
+
+	observed := &v1alpha1.TACACSPlusProviderParameters{
+		Name:           t["name"],
+		Description:    t["descr"],
+		Port:           t["port"],
+		AuthProtocol:   t["authProtocol"],
+		Timeout:        t["timeout"],
+		Retries:        t["retries"],
+		MonitorServer:  t["monitorServer"],
+		MonitoringUser: t["monitoringUser"],
+		KeySecretRef:   s.Spec.ForProvider.KeySecretRef,
+		ManagementEPG:  mo.ManagementEPGFromDn(epg),
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider)
+}
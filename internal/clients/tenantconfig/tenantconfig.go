@@ -0,0 +1,243 @@
+package tenantconfig
+
+import (
+	"fmt"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	FvTenantClassName = "fvTenant"
+	fvCtxClassName    = "fvCtx"
+	fvBDClassName     = "fvBD"
+	fvRsCtxClassName  = "fvRsCtx"
+	fvSubnetClassName = "fvSubnet"
+	fvApClassName     = "fvAp"
+	fvAEPgClassName   = "fvAEPg"
+	fvRsBdClassName   = "fvRsBd"
+
+	statusDeleted = "deleted"
+)
+
+// subtreeClasses are the classes of the objects of the subtree of the tenant
+// a TenantConfig manages.
+var subtreeClasses = []string{fvCtxClassName, fvBDClassName, fvRsCtxClassName, fvSubnetClassName, fvApClassName, fvAEPgClassName, fvRsBdClassName}
+
+// TenantDn returns the DN of the tenant with the supplied name.
+func TenantDn(tenant string) string {
+	return fmt.Sprintf("uni/tn-%s", tenant)
+}
+
+// Annotation returns the annotation of the objects owned by the TenantConfig
+// with the supplied name. Only the objects with this annotation are updated
+// or deleted, the other objects of the tenant are left untouched.
+func Annotation(name string) string {
+	return fmt.Sprintf("crossplane:%s", name)
+}
+
+func owned(t *mo.Tree, annotation string) bool {
+	return t.Attributes["annotation"] == annotation
+}
+
+// NewTree returns the fvTenant tree of the supplied TenantConfig with the
+// objects it owns, tagged with the supplied annotation.
+func NewTree(p v1alpha1.TenantConfigParameters, annotation string) *mo.Tree {
+	tenantDn := TenantDn(p.Tenant)
+	tenant := mo.NewTree(FvTenantClassName, map[string]string{"dn": tenantDn})
+	for _, v := range p.Vrfs {
+		tenant.Children = append(tenant.Children, mo.NewTree(fvCtxClassName, map[string]string{
+			"dn":         fmt.Sprintf("%s/ctx-%s", tenantDn, v.Name),
+			"name":       v.Name,
+			"descr":      v.Description,
+			"pcEnfPref":  v.PcEnfPref,
+			"annotation": annotation,
+		}))
+	}
+	for _, b := range p.BridgeDomains {
+		bdDn := fmt.Sprintf("%s/BD-%s", tenantDn, b.Name)
+		bd := mo.NewTree(fvBDClassName, map[string]string{
+			"dn":             bdDn,
+			"name":           b.Name,
+			"descr":          b.Description,
+			"arpFlood":       b.ArpFlood,
+			"unicastRoute":   b.UnicastRoute,
+			"unkMacUcastAct": b.UnkMacUcastAct,
+			"annotation":     annotation,
+		}, mo.NewTree(fvRsCtxClassName, map[string]string{
+			"dn":          fmt.Sprintf("%s/rsctx", bdDn),
+			"tnFvCtxName": b.Vrf,
+		}))
+		for _, s := range b.Subnets {
+			bd.Children = append(bd.Children, mo.NewTree(fvSubnetClassName, map[string]string{
+				"dn":         fmt.Sprintf("%s/subnet-[%s]", bdDn, s.IP),
+				"ip":         s.IP,
+				"scope":      s.Scope,
+				"descr":      s.Description,
+				"annotation": annotation,
+			}))
+		}
+		tenant.Children = append(tenant.Children, bd)
+	}
+	for _, ap := range p.ApplicationProfiles {
+		apDn := fmt.Sprintf("%s/ap-%s", tenantDn, ap.Name)
+		fvAp := mo.NewTree(fvApClassName, map[string]string{
+			"dn":         apDn,
+			"name":       ap.Name,
+			"descr":      ap.Description,
+			"annotation": annotation,
+		})
+		for _, e := range ap.EndpointGroups {
+			epgDn := fmt.Sprintf("%s/epg-%s", apDn, e.Name)
+			fvAp.Children = append(fvAp.Children, mo.NewTree(fvAEPgClassName, map[string]string{
+				"dn":         epgDn,
+				"name":       e.Name,
+				"descr":      e.Description,
+				"annotation": annotation,
+			}, mo.NewTree(fvRsBdClassName, map[string]string{
+				"dn":         fmt.Sprintf("%s/rsbd", epgDn),
+				"tnFvBDName": e.BridgeDomain,
+			})))
+		}
+		tenant.Children = append(tenant.Children, fvAp)
+	}
+	return tenant
+}
+
+// DeleteStale adds to the supplied desired tree the deletion of the objects
+// of the supplied observed tree with the supplied annotation that are not
+// desired anymore, so they are deleted in the same transaction, and returns
+// how many objects are deleted.
+func DeleteStale(desired, observed *mo.Tree, annotation string) int {
+	if observed == nil {
+		return 0
+	}
+	wanted := map[string]*mo.Tree{}
+	for _, c := range desired.Children {
+		wanted[c.Attributes["dn"]] = c
+	}
+	deleted := 0
+	for _, c := range observed.Children {
+		if !owned(c, annotation) {
+			continue
+		}
+		if d, ok := wanted[c.Attributes["dn"]]; ok {
+			deleted += DeleteStale(d, c, annotation)
+			continue
+		}
+		desired.Children = append(desired.Children, mo.NewTree(c.ClassName, map[string]string{
+			"dn":     c.Attributes["dn"],
+			"status": statusDeleted,
+		}))
+		deleted++
+	}
+	return deleted
+}
+
+// NewDeletion returns the fvTenant tree deleting all the objects of the
+// supplied observed tree with the supplied annotation.
+func NewDeletion(observed *mo.Tree, annotation string) *mo.Tree {
+	deletion := mo.NewTree(FvTenantClassName, map[string]string{"dn": observed.Attributes["dn"]})
+	DeleteStale(deletion, observed, annotation)
+	return deletion
+}
+
+// Read returns the fvTenant tree of the tenant with the supplied name, with
+// the objects a TenantConfig manages, or nil if the tenant does not exist.
+func Read(a *aciclient.Client, tenant string) (*mo.Tree, error) {
+	return mo.ReadTree(a, TenantDn(tenant), FvTenantClassName, subtreeClasses)
+}
+
+// Owns returns whether any object of the supplied tree has the supplied
+// annotation.
+func Owns(t *mo.Tree, annotation string) bool {
+	for _, c := range t.Children {
+		if owned(c, annotation) {
+			return true
+		}
+	}
+	return false
+}
+
+func relationTarget(t *mo.Tree, className, attr string) string {
+	if rs := t.Find(className); len(rs) > 0 {
+		return rs[0].Attributes[attr]
+	}
+	return ""
+}
+
+// Observed returns the TenantConfig of the objects of the supplied tree with
+// the supplied annotation.
+func Observed(t *mo.Tree, tenant, annotation string) v1alpha1.TenantConfigParameters {
+	p := v1alpha1.TenantConfigParameters{Tenant: tenant}
+	for _, v := range t.Find(fvCtxClassName) {
+		if !owned(v, annotation) {
+			continue
+		}
+		p.Vrfs = append(p.Vrfs, v1alpha1.TenantConfigVrf{
+			Name:        v.Attributes["name"],
+			Description: v.Attributes["descr"],
+			PcEnfPref:   v.Attributes["pcEnfPref"],
+		})
+	}
+	for _, b := range t.Find(fvBDClassName) {
+		if !owned(b, annotation) {
+			continue
+		}
+		bd := v1alpha1.TenantConfigBridgeDomain{
+			Name:           b.Attributes["name"],
+			Vrf:            relationTarget(b, fvRsCtxClassName, "tnFvCtxName"),
+			Description:    b.Attributes["descr"],
+			ArpFlood:       b.Attributes["arpFlood"],
+			UnicastRoute:   b.Attributes["unicastRoute"],
+			UnkMacUcastAct: b.Attributes["unkMacUcastAct"],
+		}
+		for _, s := range b.Find(fvSubnetClassName) {
+			if !owned(s, annotation) {
+				continue
+			}
+			bd.Subnets = append(bd.Subnets, v1alpha1.TenantConfigSubnet{
+				IP:          s.Attributes["ip"],
+				Scope:       s.Attributes["scope"],
+				Description: s.Attributes["descr"],
+			})
+		}
+		p.BridgeDomains = append(p.BridgeDomains, bd)
+	}
+	for _, a := range t.Find(fvApClassName) {
+		if !owned(a, annotation) {
+			continue
+		}
+		ap := v1alpha1.TenantConfigApplicationProfile{
+			Name:        a.Attributes["name"],
+			Description: a.Attributes["descr"],
+		}
+		for _, e := range a.Find(fvAEPgClassName) {
+			if !owned(e, annotation) {
+				continue
+			}
+			ap.EndpointGroups = append(ap.EndpointGroups, v1alpha1.TenantConfigEndpointGroup{
+				Name:         e.Attributes["name"],
+				BridgeDomain: relationTarget(e, fvRsBdClassName, "tnFvBDName"),
+				Description:  e.Attributes["descr"],
+			})
+		}
+		p.ApplicationProfiles = append(p.ApplicationProfiles, ap)
+	}
+	return p
+}
+
+// IsUptoDate returns whether the objects of the supplied tree owned by the
+// supplied TenantConfig are the desired ones.
+func IsUptoDate(s *v1alpha1.TenantConfig, t *mo.Tree) bool {
+	observed := Observed(t, s.Spec.ForProvider.Tenant, Annotation(s.GetName()))
+	return cmp.Equal(&observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(x, y v1alpha1.TenantConfigVrf) bool { return x.Name < y.Name }),
+		cmpopts.SortSlices(func(x, y v1alpha1.TenantConfigBridgeDomain) bool { return x.Name < y.Name }),
+		cmpopts.SortSlices(func(x, y v1alpha1.TenantConfigSubnet) bool { return x.IP < y.IP }),
+		cmpopts.SortSlices(func(x, y v1alpha1.TenantConfigApplicationProfile) bool { return x.Name < y.Name }),
+		cmpopts.SortSlices(func(x, y v1alpha1.TenantConfigEndpointGroup) bool { return x.Name < y.Name }))
+}
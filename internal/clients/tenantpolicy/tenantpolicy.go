@@ -0,0 +1,191 @@
+package tenantpolicy
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+// A Kind maps a tenant policy kind to its APIC class.
+type Kind struct {
+	ClassName string
+	// Rn is the format of the RN of the policies of the kind.
+	Rn string
+}
+
+// The tenant policy kinds.
+var (
+	EndpointRetentionPolicy = Kind{ClassName: "fvEpRetPol", Rn: "epRPol-%s"}
+	BGPTimersPolicy         = Kind{ClassName: "bgpCtxPol", Rn: "bgpCtxP-%s"}
+	BGPAddressFamilyPolicy  = Kind{ClassName: "bgpCtxAfPol", Rn: "bgpCtxAfP-%s"}
+	BGPBestPathPolicy       = Kind{ClassName: "bgpBestPathCtrlPol", Rn: "bestpath-%s"}
+	OSPFInterfacePolicy     = Kind{ClassName: "ospfIfPol", Rn: "ospfIfPol-%s"}
+	OSPFTimersPolicy        = Kind{ClassName: "ospfCtxPol", Rn: "ospfCtxP-%s"}
+	BFDInterfacePolicy      = Kind{ClassName: "bfdIfPol", Rn: "bfdIfPol-%s"}
+	HSRPInterfacePolicy     = Kind{ClassName: "hsrpIfPol", Rn: "hsrpIfPol-%s"}
+)
+
+// Dn returns the DN of the policy of the kind with the supplied name of the
+// supplied tenant.
+func (k Kind) Dn(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/"+k.Rn, tenant, name)
+}
+
+// forProvider returns the parameters of the supplied managed resource.
+func forProvider(mg resource.Managed) (reflect.Value, bool) {
+	v := reflect.ValueOf(mg)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+	spec := v.Elem().FieldByName("Spec")
+	if !spec.IsValid() {
+		return reflect.Value{}, false
+	}
+	fp := spec.FieldByName("ForProvider")
+	if !fp.IsValid() {
+		return reflect.Value{}, false
+	}
+	return fp, true
+}
+
+// Parameters returns the parameters common to the tenant policies of the
+// supplied managed resource, or false if it is not a tenant policy.
+func Parameters(mg resource.Managed) (*v1alpha1.TenantPolicyParameters, bool) {
+	fp, ok := forProvider(mg)
+	if !ok {
+		return nil, false
+	}
+	common := fp.FieldByName("TenantPolicyParameters")
+	if !common.IsValid() || !common.CanAddr() {
+		return nil, false
+	}
+	p, ok := common.Addr().Interface().(*v1alpha1.TenantPolicyParameters)
+	return p, ok
+}
+
+var (
+	stringType     = reflect.TypeOf("")
+	stringListType = reflect.TypeOf([]string{})
+)
+
+// checkAttributes returns an error if a field of the supplied struct type
+// with an aci tag, including the embedded ones, is neither a string nor a
+// list of strings.
+func checkAttributes(t reflect.Type) error {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			if err := checkAttributes(f.Type); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, ok := f.Tag.Lookup("aci"); ok && f.Type != stringType && f.Type != stringListType {
+			return fmt.Errorf("field %s of %s has an aci tag but is a %s, not a string or a list of strings", f.Name, t, f.Type)
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if the supplied managed resource is not a tenant
+// policy whose attributes can be reconciled.
+func Validate(mg resource.Managed) error {
+	fp, ok := forProvider(mg)
+	if !ok {
+		return fmt.Errorf("%T has no spec.forProvider", mg)
+	}
+	if _, ok := Parameters(mg); !ok {
+		return fmt.Errorf("%T does not embed the tenant policy parameters", mg)
+	}
+	return checkAttributes(fp.Type())
+}
+
+// attributes calls fn with the APIC attribute and the value of each field of
+// the supplied struct with an aci tag, including the embedded ones. Lists
+// are passed as comma-separated values. It panics on a field that is neither
+// a string nor a list of strings, which Validate reports.
+func attributes(v reflect.Value, fn func(attr, value string, list bool)) {
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			attributes(v.Field(i), fn)
+			continue
+		}
+		attr, ok := f.Tag.Lookup("aci")
+		if !ok {
+			continue
+		}
+		switch value := v.Field(i).Interface().(type) {
+		case string:
+			fn(attr, value, false)
+		case []string:
+			fn(attr, strings.Join(value, ","), true)
+		default:
+			panic(checkAttributes(v.Type()))
+		}
+	}
+}
+
+// NewPolicy returns the object of the supplied kind of the supplied tenant
+// policy.
+func NewPolicy(k Kind, mg resource.Managed) *mo.Object {
+	fp, _ := forProvider(mg)
+	p, _ := Parameters(mg)
+	attrs := map[string]string{"name": p.Name}
+	attributes(fp, func(attr, value string, _ bool) {
+		attrs[attr] = value
+	})
+	return mo.NewObject(k.ClassName, k.Dn(p.Tenant, p.Name), attrs)
+}
+
+// splitList returns the sorted values of the supplied comma-separated list.
+func splitList(s string) []string {
+	var values []string
+	for _, v := range strings.Split(s, ",") {
+		if v != "" {
+			values = append(values, v)
+		}
+	}
+	sort.Strings(values)
+	return values
+}
+
+// IsUptoDate returns whether the observed attributes t match the tenant
+// policy. Lists are compared as sets.
+func IsUptoDate(mg resource.Managed, t map[string]string) bool {
+	fp, ok := forProvider(mg)
+	if !ok {
+		return false
+	}
+	p, _ := Parameters(mg)
+	upToDate := t["name"] == p.Name
+	attributes(fp, func(attr, value string, list bool) {
+		if list {
+			upToDate = upToDate && reflect.DeepEqual(splitList(value), splitList(t[attr]))
+			return
+		}
+		upToDate = upToDate && value == t[attr]
+	})
+	return upToDate
+}
+
+// SetDn records the supplied DN in the observation of the supplied tenant
+// policy.
+func SetDn(mg resource.Managed, dn string) {
+	v := reflect.ValueOf(mg)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return
+	}
+	status := v.Elem().FieldByName("Status")
+	if !status.IsValid() {
+		return
+	}
+	if f := status.FieldByName("AtProvider").FieldByName("Dn"); f.IsValid() && f.CanSet() {
+		f.SetString(dn)
+	}
+}
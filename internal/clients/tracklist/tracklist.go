@@ -0,0 +1,77 @@
+package tracklist
+
+import (
+	"fmt"
+	"strings"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/services/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+	trackmemberutil "github.com/jgomezve/provider-aci/internal/clients/trackmember"
+)
+
+const (
+	FvTrackListClassName       = "fvTrackList"
+	fvRsOtmListMemberClassName = "fvRsOtmListMember"
+)
+
+// TrackListDn returns the DN of the track list with the supplied name of the
+// supplied tenant.
+func TrackListDn(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/tracklist-%s", tenant, name)
+}
+
+// NewTrackList returns the fvTrackList of the supplied track list.
+func NewTrackList(p v1alpha1.TrackListParameters) *mo.Object {
+	return mo.NewObject(FvTrackListClassName, TrackListDn(p.Tenant, p.Name), map[string]string{
+		"name":           p.Name,
+		"descr":          p.Description,
+		"type":           p.Type,
+		"percentageUp":   p.PercentageUp,
+		"percentageDown": p.PercentageDown,
+		"weightUp":       p.WeightUp,
+		"weightDown":     p.WeightDown,
+	})
+}
+
+// ReconcileMembers converges the members of the track list with the
+// supplied DN.
+func ReconcileMembers(a *aciclient.Client, listDn string, p v1alpha1.TrackListParameters) error {
+	members := make([]*mo.Object, 0, len(p.Members))
+	for _, m := range p.Members {
+		tDn := trackmemberutil.TrackMemberDn(p.Tenant, m)
+		members = append(members, mo.NewObject(fvRsOtmListMemberClassName, fmt.Sprintf("%s/rsotmListMember-[%s]", listDn, tDn), map[string]string{
+			"tDn": tDn,
+		}))
+	}
+	return mo.ReconcileChildren(a, listDn, fvRsOtmListMemberClassName, members)
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.TrackList, t map[string]string) bool {
+
+	p := s.Spec.ForProvider
+	refs, err := mo.ReadChildren(a, TrackListDn(p.Tenant, p.Name), fvRsOtmListMemberClassName)
+	if err != nil {
+		return false
+	}
+	var members []string
+	for _, r := range refs {
+		members = append(members, strings.TrimPrefix(r["tDn"], trackmemberutil.TrackMemberDn(p.Tenant, "")))
+	}
+
+	observed := &v1alpha1.TrackListParameters{
+		Name:           t["name"],
+		Tenant:         p.Tenant,
+		Description:    t["descr"],
+		Type:           t["type"],
+		PercentageUp:   t["percentageUp"],
+		PercentageDown: t["percentageDown"],
+		WeightUp:       t["weightUp"],
+		WeightDown:     t["weightDown"],
+		Members:        members,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(x, y string) bool { return x < y }))
+}
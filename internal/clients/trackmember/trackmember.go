@@ -0,0 +1,86 @@
+package trackmember
+
+import (
+	"fmt"
+	"strings"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/services/v1alpha1"
+	bridgedomainutil "github.com/jgomezve/provider-aci/internal/clients/bridgedomain"
+	ipslamonitoringpolicyutil "github.com/jgomezve/provider-aci/internal/clients/ipslamonitoringpolicy"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+)
+
+const (
+	FvTrackMemberClassName   = "fvTrackMember"
+	fvRsIpslaMonPolClassName = "fvRsIpslaMonPol"
+)
+
+// TrackMemberDn returns the DN of the track member with the supplied name of
+// the supplied tenant.
+func TrackMemberDn(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/trackmember-%s", tenant, name)
+}
+
+func l3OutDn(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/out-%s", tenant, name)
+}
+
+// Validate returns an error unless exactly one of the bridge domain and the
+// L3Out is set.
+func Validate(p v1alpha1.TrackMemberParameters) error {
+	if (p.BridgeDomain == "") == (p.L3Out == "") {
+		return fmt.Errorf("exactly one of bridgeDomain and l3Out is required")
+	}
+	return nil
+}
+
+func scopeDn(p v1alpha1.TrackMemberParameters) string {
+	if p.BridgeDomain != "" {
+		return bridgedomainutil.BridgeDomainDn(p.Tenant, p.BridgeDomain)
+	}
+	return l3OutDn(p.Tenant, p.L3Out)
+}
+
+// NewTrackMember returns the fvTrackMember of the supplied track member.
+func NewTrackMember(p v1alpha1.TrackMemberParameters) *mo.Object {
+	return mo.NewObject(FvTrackMemberClassName, TrackMemberDn(p.Tenant, p.Name), map[string]string{
+		"name":      p.Name,
+		"descr":     p.Description,
+		"dstIpAddr": p.DestinationIP,
+		"scopeDn":   scopeDn(p),
+	})
+}
+
+// SaveIPSLAMonitoringPolicy points the track member with the supplied DN to
+// its IP SLA monitoring policy.
+func SaveIPSLAMonitoringPolicy(a *aciclient.Client, dn string, p v1alpha1.TrackMemberParameters) error {
+	return mo.SaveRelation(a, fmt.Sprintf("%s/rsIpslaMonPol", dn), fvRsIpslaMonPolClassName, ipslamonitoringpolicyutil.PolicyDn(p.Tenant, p.IPSLAMonitoringPolicy))
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.TrackMember, t map[string]string) bool {
+
+	p := s.Spec.ForProvider
+	ipsla, err := mo.ReadRelation(a, fmt.Sprintf("%s/rsIpslaMonPol", TrackMemberDn(p.Tenant, p.Name)), fvRsIpslaMonPolClassName)
+	if err != nil {
+		return false
+	}
+
+	observed := &v1alpha1.TrackMemberParameters{
+		Name:                  t["name"],
+		Tenant:                p.Tenant,
+		Description:           t["descr"],
+		DestinationIP:         t["dstIpAddr"],
+		IPSLAMonitoringPolicy: strings.TrimPrefix(ipsla, ipslamonitoringpolicyutil.PolicyDn(p.Tenant, "")),
+	}
+	switch scope := t["scopeDn"]; {
+	case strings.HasPrefix(scope, bridgedomainutil.BridgeDomainDn(p.Tenant, "")):
+		observed.BridgeDomain = strings.TrimPrefix(scope, bridgedomainutil.BridgeDomainDn(p.Tenant, ""))
+	case strings.HasPrefix(scope, l3OutDn(p.Tenant, "")):
+		observed.L3Out = strings.TrimPrefix(scope, l3OutDn(p.Tenant, ""))
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,33 @@
+package vmmcontroller
+
+import (
+	"fmt"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/ciscoecosystem/aci-go-client/v2/models"
+	"github.com/google/go-cmp/cmp"
+	"github.com/jgomezve/provider-aci/apis/vmm/v1alpha1"
+)
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.VMMController, t models.VMMControllerAttributes, nameAlias string) bool {
+
+	credential := ""
+	dn := fmt.Sprintf("uni/vmmp-VMware/dom-%s/ctrlr-%s", s.Spec.ForProvider.VMMDomain, s.Spec.ForProvider.Name)
+	vmmRsAccData, err := a.ReadRelationvmmRsAcc(dn)
+	if err == nil && vmmRsAccData != nil {
+		credential = models.GetMOName(vmmRsAccData.(string))
+	}
+
+	observed := &v1alpha1.VMMControllerParameters{
+		Name:            t.Name,
+		VMMDomain:       s.Spec.ForProvider.VMMDomain,
+		HostOrIP:        t.HostOrIp,
+		Datacenter:      t.RootContName,
+		Credential:      credential,
+		DvsVersion:      t.DvsVersion,
+		StatsCollection: t.StatsMode,
+		NameAlias:       nameAlias,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider)
+}
@@ -0,0 +1,25 @@
+package vmmcredential
+
+import (
+	"github.com/ciscoecosystem/aci-go-client/v2/models"
+	"github.com/google/go-cmp/cmp"
+	"github.com/jgomezve/provider-aci/apis/vmm/v1alpha1"
+)
+
+// AnnotationKeyPasswordVersion records the version of the Secret of the
+// password last pushed to APIC.
+const AnnotationKeyPasswordVersion = "aci.crossplane.io/password-secret-version"
+
+// IsUptoDate compares everything but the password, which APIC never returns.
+func IsUptoDate(s v1alpha1.VMMCredentialParameters, t models.VMMCredentialAttributes, description, nameAlias string) bool {
+	observed := &v1alpha1.VMMCredentialParameters{
+		Name:              t.Name,
+		VMMDomain:         s.VMMDomain,
+		Username:          t.Usr,
+		PasswordSecretRef: s.PasswordSecretRef,
+		Description:       description,
+		NameAlias:         nameAlias,
+	}
+
+	return cmp.Equal(observed, &s)
+}
@@ -0,0 +1,147 @@
+package vmmdomain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/ciscoecosystem/aci-go-client/v2/models"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/vmm/v1alpha1"
+)
+
+const lacpEnhancedLagPolClassName = "lacpEnhancedLagPol"
+
+// EnhancedLagPolicy is the lacpEnhancedLagPol object, which is not modelled
+// by the aci-go-client.
+type EnhancedLagPolicy struct {
+	models.BaseAttributes
+	Name     string
+	Mode     string
+	LbMode   string
+	NumLinks string
+}
+
+// NewEnhancedLagPolicy returns the lacpEnhancedLagPol p of the VMM domain
+// with the supplied DN.
+func NewEnhancedLagPolicy(domainDn string, p v1alpha1.EnhancedLagPolicy) *EnhancedLagPolicy {
+	rn := fmt.Sprintf("enlacplagp-%s", p.Name)
+	return &EnhancedLagPolicy{
+		BaseAttributes: models.BaseAttributes{
+			DistinguishedName: fmt.Sprintf("%s/vswitchpolcont/%s", domainDn, rn),
+			Status:            "created, modified",
+			ClassName:         lacpEnhancedLagPolClassName,
+			Rn:                rn,
+		},
+		Name:     p.Name,
+		Mode:     p.Mode,
+		LbMode:   p.LoadBalancingMode,
+		NumLinks: p.NumberOfLinks,
+	}
+}
+
+func (l *EnhancedLagPolicy) ToMap() (map[string]string, error) {
+	lagMap, err := l.BaseAttributes.ToMap()
+	if err != nil {
+		return nil, err
+	}
+	models.A(lagMap, "name", l.Name)
+	models.A(lagMap, "mode", l.Mode)
+	models.A(lagMap, "lbmode", l.LbMode)
+	models.A(lagMap, "numLinks", l.NumLinks)
+	return lagMap, nil
+}
+
+// ReadEnhancedLagPolicies returns the enhanced LAG policies configured on the
+// VMM domain with the supplied DN, sorted by name.
+func ReadEnhancedLagPolicies(a *aciclient.Client, domainDn string) ([]v1alpha1.EnhancedLagPolicy, error) {
+	cont, err := a.GetViaURL(fmt.Sprintf("/api/node/mo/%s/vswitchpolcont.json?query-target=children&target-subtree-class=%s", domainDn, lacpEnhancedLagPolClassName))
+	if err != nil {
+		if fmt.Sprintf("%s", err) == "Error retrieving Object: Object may not exist" {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var policies []v1alpha1.EnhancedLagPolicy
+	for _, l := range models.ListFromContainer(cont, lacpEnhancedLagPolClassName) {
+		policies = append(policies, v1alpha1.EnhancedLagPolicy{
+			Name:              models.G(l, "name"),
+			Mode:              models.G(l, "mode"),
+			LoadBalancingMode: models.G(l, "lbmode"),
+			NumberOfLinks:     models.G(l, "numLinks"),
+		})
+	}
+	sort.Slice(policies, func(i, j int) bool { return policies[i].Name < policies[j].Name })
+	return policies, nil
+}
+
+// ReconcileEnhancedLagPolicies creates or updates the desired enhanced LAG
+// policies and removes the ones that are no longer desired.
+func ReconcileEnhancedLagPolicies(a *aciclient.Client, domainDn string, desired []v1alpha1.EnhancedLagPolicy) error {
+	observed, err := ReadEnhancedLagPolicies(a, domainDn)
+	if err != nil {
+		return err
+	}
+	keep := map[string]bool{}
+	for _, p := range desired {
+		keep[p.Name] = true
+		if err := a.Save(NewEnhancedLagPolicy(domainDn, p)); err != nil {
+			return err
+		}
+	}
+	for _, p := range observed {
+		if !keep[p.Name] {
+			if err := a.DeleteByDn(NewEnhancedLagPolicy(domainDn, p).DistinguishedName, lacpEnhancedLagPolClassName); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// VlanPoolDn returns the DN of the VLAN pool with the supplied name and
+// allocation mode.
+func VlanPoolDn(name, allocationMode string) string {
+	return fmt.Sprintf("uni/infra/vlanns-[%s]-%s", name, allocationMode)
+}
+
+// VlanPoolFromDn returns the name and allocation mode of the VLAN pool with
+// the supplied DN.
+func VlanPoolFromDn(dn string) (string, string) {
+	rn := strings.TrimPrefix(dn, "uni/infra/vlanns-[")
+	i := strings.LastIndex(rn, "]-")
+	if i < 0 {
+		return "", ""
+	}
+	return rn[:i], rn[i+2:]
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.VMMDomain, t models.VMMDomainAttributes) bool {
+
+	vlanPool := ""
+	vlanPoolAllocationMode := s.Spec.ForProvider.VlanPoolAllocationMode
+	dn := fmt.Sprintf("uni/vmmp-VMware/dom-%s", s.Spec.ForProvider.Name)
+	infraRsVlanNsData, err := a.ReadRelationinfraRsVlanNsFromVMMDomain(dn)
+	if err == nil && infraRsVlanNsData != nil {
+		vlanPool, vlanPoolAllocationMode = VlanPoolFromDn(infraRsVlanNsData.(string))
+	}
+
+	lags, err := ReadEnhancedLagPolicies(a, dn)
+	if err != nil {
+		return false
+	}
+
+	observed := &v1alpha1.VMMDomainParameters{
+		Name:                   t.Name,
+		NameAlias:              t.NameAlias,
+		AccessMode:             t.AccessMode,
+		VlanPool:               vlanPool,
+		VlanPoolAllocationMode: vlanPoolAllocationMode,
+		EnhancedLagPolicies:    lags,
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(x, y v1alpha1.EnhancedLagPolicy) bool { return x.Name < y.Name }))
+}
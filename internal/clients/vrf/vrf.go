@@ -1,17 +1,198 @@
 package vrf
 
 import (
-	"github.com/ciscoecosystem/aci-go-client/v2/models"
+	"fmt"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+	securitydomainutil "github.com/jgomezve/provider-aci/internal/clients/securitydomain"
+)
+
+const (
+	FvCtxClassName                  = "fvCtx"
+	fvRsCtxToEigrpCtxAfPolClassName = "fvRsCtxToEigrpCtxAfPol"
+	vzAnyClassName                  = "vzAny"
+	vzRsAnyToProvClassName          = "vzRsAnyToProv"
+	vzRsAnyToConsClassName          = "vzRsAnyToCons"
 )
 
-func IsUptoDate(s v1alpha1.VrfParameters, t models.VRFAttributes) bool {
+var equateParameters = []cmp.Option{
+	cmpopts.EquateEmpty(),
+	cmpopts.SortSlices(func(x, y string) bool { return x < y }),
+	cmpopts.SortSlices(func(x, y v1alpha1.VrfEigrpAddressFamilyPolicy) bool { return x.AddressFamily < y.AddressFamily }),
+}
+
+// A policyRelation is a relation of a VRF to a policy by name.
+type policyRelation struct {
+	className string
+	rn        string
+	attr      string
+	policy    func(p *v1alpha1.VrfParameters) *string
+}
+
+var policyRelations = []policyRelation{
+	{"fvRsBgpCtxPol", "rsbgpCtxPol", "tnBgpCtxPolName", func(p *v1alpha1.VrfParameters) *string { return &p.BgpTimersPolicy }},
+	{"fvRsOspfCtxPol", "rsospfCtxPol", "tnOspfCtxPolName", func(p *v1alpha1.VrfParameters) *string { return &p.OspfTimersPolicy }},
+	{"fvRsCtxToExtRouteTagPol", "rsctxToExtRouteTagPol", "tnL3extRouteTagPolName", func(p *v1alpha1.VrfParameters) *string { return &p.RouteTagPolicy }},
+	{"fvRsCtxToEpRet", "rsctxToEpRet", "tnFvEpRetPolName", func(p *v1alpha1.VrfParameters) *string { return &p.EpRetentionPolicy }},
+	{"fvRsCtxMonPol", "rsCtxMonPol", "tnMonEPGPolName", func(p *v1alpha1.VrfParameters) *string { return &p.MonitoringPolicy }},
+}
+
+// VrfDn returns the DN of the VRF with the supplied name of the supplied
+// tenant.
+func VrfDn(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/ctx-%s", tenant, name)
+}
+
+func vzAnyDn(vrfDn string) string {
+	return fmt.Sprintf("%s/any", vrfDn)
+}
+
+// NewVrf returns the fvCtx of the supplied VRF.
+func NewVrf(p v1alpha1.VrfParameters) *mo.Object {
+	return mo.NewObject(FvCtxClassName, VrfDn(p.Tenant, p.Name), map[string]string{
+		"name":                p.Name,
+		"nameAlias":           p.NameAlias,
+		"descr":               p.Description,
+		"pcEnfPref":           p.PcEnfPref,
+		"pcEnfDir":            p.PcEnfDir,
+		"ipDataPlaneLearning": p.IpDataPlaneLearning,
+		"knwMcastAct":         p.KnwMcastAct,
+		"bdEnforcedEnable":    p.BdEnforcedEnable,
+	})
+}
+
+func newContractRefs(className, prefix, anyDn string, contracts []string) []*mo.Object {
+	refs := make([]*mo.Object, 0, len(contracts))
+	for _, c := range contracts {
+		refs = append(refs, mo.NewObject(className, fmt.Sprintf("%s/%s-%s", anyDn, prefix, c), map[string]string{
+			"tnVzBrCPName": c,
+		}))
+	}
+	return refs
+}
+
+// ReconcileRelations converges the policies and the vzAny of the VRF with
+// the supplied DN.
+func ReconcileRelations(a *aciclient.Client, vrfDn string, p v1alpha1.VrfParameters) error {
+	for _, r := range policyRelations {
+		if err := a.Save(mo.NewObject(r.className, fmt.Sprintf("%s/%s", vrfDn, r.rn), map[string]string{
+			r.attr: *r.policy(&p),
+		})); err != nil {
+			return err
+		}
+	}
+	eigrp := make([]*mo.Object, 0, len(p.EigrpAddressFamilyPolicies))
+	for _, e := range p.EigrpAddressFamilyPolicies {
+		eigrp = append(eigrp, mo.NewObject(fvRsCtxToEigrpCtxAfPolClassName, fmt.Sprintf("%s/rsctxToEigrpCtxAfPol-[%s]-%s", vrfDn, e.Policy, e.AddressFamily), map[string]string{
+			"af":                  e.AddressFamily,
+			"tnEigrpCtxAfPolName": e.Policy,
+		}))
+	}
+	if err := mo.ReconcileChildren(a, vrfDn, fvRsCtxToEigrpCtxAfPolClassName, eigrp); err != nil {
+		return err
+	}
+
+	anyDn := vzAnyDn(vrfDn)
+	if err := a.Save(mo.NewObject(vzAnyClassName, anyDn, map[string]string{
+		"prefGrMemb": p.VzAny.PreferredGroup,
+	})); err != nil {
+		return err
+	}
+	if err := mo.ReconcileChildren(a, anyDn, vzRsAnyToProvClassName, newContractRefs(vzRsAnyToProvClassName, "rsanyToProv", anyDn, p.VzAny.ProvidedContracts)); err != nil {
+		return err
+	}
+	return mo.ReconcileChildren(a, anyDn, vzRsAnyToConsClassName, newContractRefs(vzRsAnyToConsClassName, "rsanyToCons", anyDn, p.VzAny.ConsumedContracts))
+}
+
+func readContracts(a *aciclient.Client, anyDn, className string) ([]string, error) {
+	refs, err := mo.ReadChildren(a, anyDn, className)
+	if err != nil {
+		return nil, err
+	}
+	var contracts []string
+	for _, r := range refs {
+		contracts = append(contracts, r["tnVzBrCPName"])
+	}
+	return contracts, nil
+}
+
+// readRelations reads the policies and the vzAny of the VRF with the supplied
+// DN into the supplied parameters.
+func readRelations(a *aciclient.Client, vrfDn string, observed *v1alpha1.VrfParameters) error {
+	for _, r := range policyRelations {
+		name, err := mo.ReadAttribute(a, fmt.Sprintf("%s/%s", vrfDn, r.rn), r.className, r.attr)
+		if err != nil {
+			return err
+		}
+		*r.policy(observed) = name
+	}
+	eigrp, err := mo.ReadChildren(a, vrfDn, fvRsCtxToEigrpCtxAfPolClassName)
+	if err != nil {
+		return err
+	}
+	for _, e := range eigrp {
+		observed.EigrpAddressFamilyPolicies = append(observed.EigrpAddressFamilyPolicies, v1alpha1.VrfEigrpAddressFamilyPolicy{
+			AddressFamily: e["af"],
+			Policy:        e["tnEigrpCtxAfPolName"],
+		})
+	}
+
+	anyDn := vzAnyDn(vrfDn)
+	if observed.VzAny.PreferredGroup, err = mo.ReadAttribute(a, anyDn, vzAnyClassName, "prefGrMemb"); err != nil {
+		return err
+	}
+	if observed.VzAny.ProvidedContracts, err = readContracts(a, anyDn, vzRsAnyToProvClassName); err != nil {
+		return err
+	}
+	observed.VzAny.ConsumedContracts, err = readContracts(a, anyDn, vzRsAnyToConsClassName)
+	return err
+}
+
+// RelationsUpToDate returns whether the policies and the vzAny of the VRF
+// with the supplied DN match the supplied parameters, that is whether
+// ReconcileRelations would change nothing.
+func RelationsUpToDate(a *aciclient.Client, vrfDn string, p v1alpha1.VrfParameters) bool {
+	observed := &v1alpha1.VrfParameters{}
+	if err := readRelations(a, vrfDn, observed); err != nil {
+		return false
+	}
+	desired := &v1alpha1.VrfParameters{
+		EigrpAddressFamilyPolicies: p.EigrpAddressFamilyPolicies,
+		VzAny:                      p.VzAny,
+	}
+	for _, r := range policyRelations {
+		*r.policy(desired) = *r.policy(&p)
+	}
+	return cmp.Equal(observed, desired, equateParameters...)
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.Vrf, t map[string]string) bool {
+
+	dn := VrfDn(s.Spec.ForProvider.Tenant, s.Spec.ForProvider.Name)
+	securityDomains, err := securitydomainutil.DomainRefs(a, dn)
+	if err != nil {
+		return false
+	}
+
 	observed := &v1alpha1.VrfParameters{
-		Tenant:    s.Tenant,
-		Name:      t.Name,
-		NameAlias: t.NameAlias,
+		Tenant:              s.Spec.ForProvider.Tenant,
+		Name:                t["name"],
+		NameAlias:           t["nameAlias"],
+		Description:         t["descr"],
+		PcEnfPref:           t["pcEnfPref"],
+		PcEnfDir:            t["pcEnfDir"],
+		IpDataPlaneLearning: t["ipDataPlaneLearning"],
+		KnwMcastAct:         t["knwMcastAct"],
+		BdEnforcedEnable:    t["bdEnforcedEnable"],
+		SecurityDomains:     securityDomains,
+	}
+	if err := readRelations(a, dn, observed); err != nil {
+		return false
 	}
 
-	return cmp.Equal(observed, &s)
+	return cmp.Equal(observed, &s.Spec.ForProvider, equateParameters...)
 }
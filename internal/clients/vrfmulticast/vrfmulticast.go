@@ -0,0 +1,219 @@
+package vrfmulticast
+
+import (
+	"fmt"
+	"strings"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+	vrfutil "github.com/jgomezve/provider-aci/internal/clients/vrf"
+)
+
+const (
+	PimCtxPClassName                 = "pimCtxP"
+	pimStaticRPPolClassName          = "pimStaticRPPol"
+	pimFabricRPPolClassName          = "pimFabricRPPol"
+	pimStaticRPEntryPolClassName     = "pimStaticRPEntryPol"
+	pimRPGrpRangePolClassName        = "pimRPGrpRangePol"
+	pimAutoRPPolClassName            = "pimAutoRPPol"
+	pimBSRPPolClassName              = "pimBSRPPol"
+	pimASMPatPolClassName            = "pimASMPatPol"
+	pimSharedRangePolClassName       = "pimSharedRangePol"
+	pimSSMPatPolClassName            = "pimSSMPatPol"
+	pimSSMRangePolClassName          = "pimSSMRangePol"
+	rtdmcRsFilterToRtMapPolClassName = "rtdmcRsFilterToRtMapPol"
+
+	forwardingEnabled  = "enabled"
+	forwardingDisabled = "disabled"
+	forward            = "forward"
+)
+
+// MulticastDn returns the DN of the PIM configuration of the VRF with the
+// supplied name of the supplied tenant.
+func MulticastDn(tenant, vrf string) string {
+	return fmt.Sprintf("%s/pimctxp", vrfutil.VrfDn(tenant, vrf))
+}
+
+// RouteMapDn returns the DN of the PIM route map with the supplied name of
+// the supplied tenant, or an empty string if the name is empty.
+func RouteMapDn(tenant, name string) string {
+	if name == "" {
+		return ""
+	}
+	return fmt.Sprintf("uni/tn-%s/rtmap-%s", tenant, name)
+}
+
+func routeMapName(tenant, dn string) string {
+	return strings.TrimPrefix(dn, fmt.Sprintf("uni/tn-%s/rtmap-", tenant))
+}
+
+// PIMEnabled returns whether PIM is enabled on the VRF with the supplied name
+// of the supplied tenant.
+func PIMEnabled(a *aciclient.Client, tenant, vrf string) (bool, error) {
+	pimCtxP, err := mo.Read(a, MulticastDn(tenant, vrf), PimCtxPClassName)
+	if err != nil {
+		return false, err
+	}
+	return pimCtxP != nil, nil
+}
+
+// NewMulticast returns the pimCtxP of the supplied VRF multicast
+// configuration.
+func NewMulticast(p v1alpha1.VrfMulticastParameters) *mo.Object {
+	return mo.NewObject(PimCtxPClassName, MulticastDn(p.Tenant, p.Vrf), map[string]string{
+		"mtu":  p.Mtu,
+		"ctrl": strings.Join(p.Control, ","),
+	})
+}
+
+func forwardingCtrl(forwarding string) string {
+	if forwarding == forwardingEnabled {
+		return forward
+	}
+	return ""
+}
+
+func forwardingFromCtrl(ctrl string) string {
+	if strings.Contains(ctrl, forward) {
+		return forwardingEnabled
+	}
+	return forwardingDisabled
+}
+
+func rpEntryDn(parentDn, address string) string {
+	return fmt.Sprintf("%s/staticrpent-[%s]", parentDn, address)
+}
+
+// reconcileRPs converges the rendezvous points of the container of the
+// supplied class with the supplied DN.
+func reconcileRPs(a *aciclient.Client, tenant, dn, className string, rps []v1alpha1.MulticastRP) error {
+	if err := a.Save(mo.NewObject(className, dn, map[string]string{})); err != nil {
+		return err
+	}
+	entries := make([]*mo.Object, 0, len(rps))
+	for _, rp := range rps {
+		entries = append(entries, mo.NewObject(pimStaticRPEntryPolClassName, rpEntryDn(dn, rp.Address), map[string]string{
+			"rpIp": rp.Address,
+		}))
+	}
+	if err := mo.ReconcileChildren(a, dn, pimStaticRPEntryPolClassName, entries); err != nil {
+		return err
+	}
+	for _, rp := range rps {
+		rangeDn := fmt.Sprintf("%s/rpgrprange", rpEntryDn(dn, rp.Address))
+		if err := a.Save(mo.NewObject(pimRPGrpRangePolClassName, rangeDn, map[string]string{})); err != nil {
+			return err
+		}
+		if err := mo.SaveRelation(a, fmt.Sprintf("%s/rsfilterToRtMapPol", rangeDn), rtdmcRsFilterToRtMapPolClassName, RouteMapDn(tenant, rp.GroupRouteMap)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readRPs(a *aciclient.Client, tenant, dn string) ([]v1alpha1.MulticastRP, error) {
+	entries, err := mo.ReadChildren(a, dn, pimStaticRPEntryPolClassName)
+	if err != nil {
+		return nil, err
+	}
+	var rps []v1alpha1.MulticastRP
+	for _, e := range entries {
+		routeMap, err := mo.ReadRelation(a, fmt.Sprintf("%s/rpgrprange/rsfilterToRtMapPol", e["dn"]), rtdmcRsFilterToRtMapPolClassName)
+		if err != nil {
+			return nil, err
+		}
+		rps = append(rps, v1alpha1.MulticastRP{Address: e["rpIp"], GroupRouteMap: routeMapName(tenant, routeMap)})
+	}
+	return rps, nil
+}
+
+// reconcileRange points the range of the supplied class under the pattern of
+// the supplied class with the supplied DN to the supplied route map.
+func reconcileRange(a *aciclient.Client, tenant, patternDn, patternClassName, rangeRn, rangeClassName, routeMap string) error {
+	if err := a.Save(mo.NewObject(patternClassName, patternDn, map[string]string{})); err != nil {
+		return err
+	}
+	rangeDn := fmt.Sprintf("%s/%s", patternDn, rangeRn)
+	if err := a.Save(mo.NewObject(rangeClassName, rangeDn, map[string]string{})); err != nil {
+		return err
+	}
+	return mo.SaveRelation(a, fmt.Sprintf("%s/rsfilterToRtMapPol", rangeDn), rtdmcRsFilterToRtMapPolClassName, RouteMapDn(tenant, routeMap))
+}
+
+// ReconcileChildren converges the rendezvous points and the ranges of the
+// PIM configuration with the supplied DN.
+func ReconcileChildren(a *aciclient.Client, dn string, p v1alpha1.VrfMulticastParameters) error {
+	if err := reconcileRPs(a, p.Tenant, fmt.Sprintf("%s/staticrp", dn), pimStaticRPPolClassName, p.StaticRPs); err != nil {
+		return err
+	}
+	if err := reconcileRPs(a, p.Tenant, fmt.Sprintf("%s/fabricrp", dn), pimFabricRPPolClassName, p.FabricRPs); err != nil {
+		return err
+	}
+	if err := a.Save(mo.NewObject(pimAutoRPPolClassName, fmt.Sprintf("%s/autorp", dn), map[string]string{
+		"ctrl": forwardingCtrl(p.AutoRPForwarding),
+	})); err != nil {
+		return err
+	}
+	if err := a.Save(mo.NewObject(pimBSRPPolClassName, fmt.Sprintf("%s/bsrp", dn), map[string]string{
+		"ctrl": forwardingCtrl(p.BSRForwarding),
+	})); err != nil {
+		return err
+	}
+	if err := reconcileRange(a, p.Tenant, fmt.Sprintf("%s/asmpat", dn), pimASMPatPolClassName, "sharedrange", pimSharedRangePolClassName, p.ASMSharedRangeRouteMap); err != nil {
+		return err
+	}
+	return reconcileRange(a, p.Tenant, fmt.Sprintf("%s/ssmpat", dn), pimSSMPatPolClassName, "ssmrange", pimSSMRangePolClassName, p.SSMRangeRouteMap)
+}
+
+func IsUptoDate(a *aciclient.Client, s *v1alpha1.VrfMulticast, t map[string]string) bool {
+
+	p := s.Spec.ForProvider
+	dn := MulticastDn(p.Tenant, p.Vrf)
+	staticRPs, err := readRPs(a, p.Tenant, fmt.Sprintf("%s/staticrp", dn))
+	if err != nil {
+		return false
+	}
+	fabricRPs, err := readRPs(a, p.Tenant, fmt.Sprintf("%s/fabricrp", dn))
+	if err != nil {
+		return false
+	}
+	autoRP, err := mo.ReadAttribute(a, fmt.Sprintf("%s/autorp", dn), pimAutoRPPolClassName, "ctrl")
+	if err != nil {
+		return false
+	}
+	bsr, err := mo.ReadAttribute(a, fmt.Sprintf("%s/bsrp", dn), pimBSRPPolClassName, "ctrl")
+	if err != nil {
+		return false
+	}
+	asm, err := mo.ReadRelation(a, fmt.Sprintf("%s/asmpat/sharedrange/rsfilterToRtMapPol", dn), rtdmcRsFilterToRtMapPolClassName)
+	if err != nil {
+		return false
+	}
+	ssm, err := mo.ReadRelation(a, fmt.Sprintf("%s/ssmpat/ssmrange/rsfilterToRtMapPol", dn), rtdmcRsFilterToRtMapPolClassName)
+	if err != nil {
+		return false
+	}
+
+	var control []string
+	if t["ctrl"] != "" {
+		control = strings.Split(t["ctrl"], ",")
+	}
+	observed := &v1alpha1.VrfMulticastParameters{
+		Tenant:                 p.Tenant,
+		Vrf:                    p.Vrf,
+		Mtu:                    t["mtu"],
+		Control:                control,
+		StaticRPs:              staticRPs,
+		FabricRPs:              fabricRPs,
+		AutoRPForwarding:       forwardingFromCtrl(autoRP),
+		BSRForwarding:          forwardingFromCtrl(bsr),
+		ASMSharedRangeRouteMap: routeMapName(p.Tenant, asm),
+		SSMRangeRouteMap:       routeMapName(p.Tenant, ssm),
+	}
+
+	return cmp.Equal(observed, &s.Spec.ForProvider, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(x, y string) bool { return x < y }),
+		cmpopts.SortSlices(func(x, y v1alpha1.MulticastRP) bool { return x.Address < y.Address }))
+}
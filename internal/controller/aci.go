@@ -21,10 +21,53 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/jgomezve/provider-aci/internal/controller/applicationprofile"
+	"github.com/jgomezve/provider-aci/internal/controller/authprovidergroup"
 	"github.com/jgomezve/provider-aci/internal/controller/bridgedomain"
+	"github.com/jgomezve/provider-aci/internal/controller/classquery"
 	"github.com/jgomezve/provider-aci/internal/controller/config"
+	"github.com/jgomezve/provider-aci/internal/controller/configexportpolicy"
+	"github.com/jgomezve/provider-aci/internal/controller/configrollback"
+	"github.com/jgomezve/provider-aci/internal/controller/deviceselectionpolicy"
+	"github.com/jgomezve/provider-aci/internal/controller/dhcpoptionpolicy"
+	"github.com/jgomezve/provider-aci/internal/controller/dhcprelaypolicy"
+	"github.com/jgomezve/provider-aci/internal/controller/dnsprofile"
 	"github.com/jgomezve/provider-aci/internal/controller/endpointgroup"
+	"github.com/jgomezve/provider-aci/internal/controller/firmwaregroup"
+	"github.com/jgomezve/provider-aci/internal/controller/ipslamonitoringpolicy"
+	"github.com/jgomezve/provider-aci/internal/controller/kubernetesvmmdomain"
+	"github.com/jgomezve/provider-aci/internal/controller/l4l7device"
+	"github.com/jgomezve/provider-aci/internal/controller/ldapprovider"
+	"github.com/jgomezve/provider-aci/internal/controller/localuser"
+	"github.com/jgomezve/provider-aci/internal/controller/logindomain"
+	"github.com/jgomezve/provider-aci/internal/controller/maintenancegroup"
+	"github.com/jgomezve/provider-aci/internal/controller/managedobject"
+	"github.com/jgomezve/provider-aci/internal/controller/matchrule"
+	"github.com/jgomezve/provider-aci/internal/controller/netflowexporterpolicy"
+	"github.com/jgomezve/provider-aci/internal/controller/netflowmonitorpolicy"
+	"github.com/jgomezve/provider-aci/internal/controller/netflowrecordpolicy"
+	"github.com/jgomezve/provider-aci/internal/controller/nodemanagementaddress"
+	"github.com/jgomezve/provider-aci/internal/controller/ntppolicy"
+	"github.com/jgomezve/provider-aci/internal/controller/radiusprovider"
+	"github.com/jgomezve/provider-aci/internal/controller/rbacrule"
+	"github.com/jgomezve/provider-aci/internal/controller/redirectpolicy"
+	"github.com/jgomezve/provider-aci/internal/controller/routecontrolprofile"
+	"github.com/jgomezve/provider-aci/internal/controller/securitydomain"
+	"github.com/jgomezve/provider-aci/internal/controller/servicegraphtemplate"
+	"github.com/jgomezve/provider-aci/internal/controller/setrule"
+	"github.com/jgomezve/provider-aci/internal/controller/snmppolicy"
+	"github.com/jgomezve/provider-aci/internal/controller/spandestinationgroup"
+	"github.com/jgomezve/provider-aci/internal/controller/spansourcegroup"
+	"github.com/jgomezve/provider-aci/internal/controller/sysloggroup"
+	"github.com/jgomezve/provider-aci/internal/controller/tacacsplusprovider"
+	"github.com/jgomezve/provider-aci/internal/controller/tenantconfig"
+	"github.com/jgomezve/provider-aci/internal/controller/tenantpolicy"
+	"github.com/jgomezve/provider-aci/internal/controller/tracklist"
+	"github.com/jgomezve/provider-aci/internal/controller/trackmember"
+	"github.com/jgomezve/provider-aci/internal/controller/vmmcontroller"
+	"github.com/jgomezve/provider-aci/internal/controller/vmmcredential"
+	"github.com/jgomezve/provider-aci/internal/controller/vmmdomain"
 	"github.com/jgomezve/provider-aci/internal/controller/vrf"
+	"github.com/jgomezve/provider-aci/internal/controller/vrfmulticast"
 )
 
 // Setup creates all Aci controllers with the supplied logger and adds them to
@@ -36,6 +79,49 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		bridgedomain.Setup,
 		applicationprofile.Setup,
 		endpointgroup.Setup,
+		vmmdomain.Setup,
+		vmmcontroller.Setup,
+		vmmcredential.Setup,
+		kubernetesvmmdomain.Setup,
+		ntppolicy.Setup,
+		dnsprofile.Setup,
+		sysloggroup.Setup,
+		snmppolicy.Setup,
+		nodemanagementaddress.Setup,
+		firmwaregroup.Setup,
+		maintenancegroup.Setup,
+		configexportpolicy.Setup,
+		configrollback.Setup,
+		securitydomain.Setup,
+		localuser.Setup,
+		rbacrule.Setup,
+		ldapprovider.Setup,
+		radiusprovider.Setup,
+		tacacsplusprovider.Setup,
+		authprovidergroup.Setup,
+		logindomain.Setup,
+		l4l7device.Setup,
+		servicegraphtemplate.Setup,
+		deviceselectionpolicy.Setup,
+		redirectpolicy.Setup,
+		ipslamonitoringpolicy.Setup,
+		trackmember.Setup,
+		tracklist.Setup,
+		matchrule.Setup,
+		setrule.Setup,
+		routecontrolprofile.Setup,
+		dhcprelaypolicy.Setup,
+		dhcpoptionpolicy.Setup,
+		tenantconfig.Setup,
+		tenantpolicy.Setup,
+		vrfmulticast.Setup,
+		spansourcegroup.Setup,
+		spandestinationgroup.Setup,
+		netflowrecordpolicy.Setup,
+		netflowexporterpolicy.Setup,
+		netflowmonitorpolicy.Setup,
+		managedobject.Setup,
+		classquery.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -39,7 +39,10 @@ import (
 	"github.com/jgomezve/provider-aci/apis/application-management/v1alpha1"
 	apisv1alpha1 "github.com/jgomezve/provider-aci/apis/v1alpha1"
 	applicationprofileutil "github.com/jgomezve/provider-aci/internal/clients/applicationprofile"
+	securitydomainutil "github.com/jgomezve/provider-aci/internal/clients/securitydomain"
+	"github.com/jgomezve/provider-aci/internal/clients/snapshot"
 	"github.com/jgomezve/provider-aci/internal/features"
+	"github.com/jgomezve/provider-aci/internal/subscription"
 )
 
 const (
@@ -49,6 +52,7 @@ const (
 	errGetCreds              = "cannot get credentials"
 
 	errNewClient = "cannot create new Service"
+	errSnapshot  = "cannot snapshot tenant before change"
 )
 
 // A NoOpService does nothing.
@@ -67,23 +71,26 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.ApplicationProfileGroupVersionKind),
 		managed.WithExternalConnecter(&connector{
 			kube:         mgr.GetClient(),
 			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			recorder:     recorder,
 			newServiceFn: aciclient.NewClient}),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...))
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		WithEventFilter(resource.DesiredStateChanged()).
-		For(&v1alpha1.ApplicationProfile{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		For(&v1alpha1.ApplicationProfile{})
+	return subscription.Complete(mgr, o, b, v1alpha1.ApplicationProfileGroupVersionKind, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
 
 // A connector is expected to produce an ExternalClient when its Connect method
@@ -91,6 +98,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube         client.Client
 	usage        resource.Tracker
+	recorder     event.Recorder
 	newServiceFn func(clientUrl, username string, options ...aciclient.Option) *aciclient.Client
 }
 
@@ -139,7 +147,7 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
-	return &external{apicClient: svc}, nil
+	return &external{apicClient: svc, snapshotter: snapshot.NewSnapshotter(svc, c.kube, c.recorder, pc)}, nil
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
@@ -148,6 +156,9 @@ type external struct {
 	// A 'client' used to connect to the external resource API. In practice this
 	// would be something like an AWS SDK client.
 	apicClient *aciclient.Client
+	// snapshotter takes a snapshot of the tenant before destructive changes,
+	// if enabled in the ProviderConfig.
+	snapshotter *snapshot.Snapshotter
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -174,7 +185,14 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 	// LateInitializer not required for ACI
 
+	securityDomains, err := securitydomainutil.DomainRefs(c.apicClient, dn)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
 	cr.SetConditions(xpv1.Available())
+
+	cr.Status.AtProvider.Dn = fvAp.DistinguishedName
 	return managed.ExternalObservation{
 		// // Return false when the external resource does not exist. This lets
 		// // the managed resource reconciler know that it needs to call Create to
@@ -184,7 +202,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		// // Return false when the external resource exists, but it not up to date
 		// // with the desired managed resource state. This lets the managed
 		// // resource reconciler know that it needs to call Update.
-		ResourceUpToDate: applicationprofileutil.IsUptoDate(cr.Spec.ForProvider, fvAp.ApplicationProfileAttributes),
+		ResourceUpToDate: applicationprofileutil.IsUptoDate(cr.Spec.ForProvider, fvAp.ApplicationProfileAttributes, securityDomains),
 
 		// // Return any details that may be required to connect to the external
 		// // resource. These will be stored as the connection secret.
@@ -208,6 +226,9 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, "Cannot create Application Profile")
 	}
+	if err := securitydomainutil.ReconcileDomainRefs(c.apicClient, fvAp.DistinguishedName, cr.Spec.ForProvider.SecurityDomains); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "Cannot update Application Profile security domains")
+	}
 
 	return managed.ExternalCreation{
 		// Optionally return any details that may be required to connect to the
@@ -231,6 +252,9 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot Update Application Profile")
 	}
+	if err := securitydomainutil.ReconcileDomainRefs(c.apicClient, fvAp.DistinguishedName, cr.Spec.ForProvider.SecurityDomains); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot update Application Profile security domains")
+	}
 	return managed.ExternalUpdate{
 		// Optionally return any details that may be required to connect to the
 		// external resource. These will be stored as the connection secret.
@@ -246,6 +270,9 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 
 	cr.SetConditions(xpv1.Deleting())
 	dn := fmt.Sprintf("uni/tn-%s/ap-%s", cr.Spec.ForProvider.Tenant, cr.Name)
+	if err := c.snapshotter.Take(ctx, cr, cr.Spec.ForProvider.Tenant, fmt.Sprintf("deleting %s", dn)); err != nil {
+		return errors.Wrap(err, errSnapshot)
+	}
 	err := c.apicClient.DeleteByDn(dn, "fvAp")
 	if err != nil {
 		return err
@@ -0,0 +1,143 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authprovidergroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	groupDn   = "uni/userext/radiusext/radiusprovidergroup-corp"
+	groupPath = "/api/node/mo/" + groupDn + ".json"
+)
+
+func authProviderGroup(protocol string, providers ...v1alpha1.ProviderRef) *v1alpha1.AuthProviderGroup {
+	return &v1alpha1.AuthProviderGroup{Spec: v1alpha1.AuthProviderGroupSpec{ForProvider: v1alpha1.AuthProviderGroupParameters{
+		Name:      "corp",
+		Protocol:  protocol,
+		Providers: providers,
+	}}}
+}
+
+// apic returns a fake APIC with the RADIUS provider group corp of the
+// providers 10.0.0.1 and 10.0.0.2.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet(groupPath, `{"totalCount":"1","imdata":[{"aaaRadiusProviderGroup":{"attributes":{"dn":"`+groupDn+`","name":"corp","descr":""}}}]}`)
+	s.RespondChildren(groupPath, `{"totalCount":"2","imdata":[
+{"aaaProviderRef":{"attributes":{"dn":"`+groupDn+`/providerref-10.0.0.2","name":"10.0.0.2","order":"2"}}},
+{"aaaProviderRef":{"attributes":{"dn":"`+groupDn+`/providerref-10.0.0.1","name":"10.0.0.1","order":"1"}}}]}`)
+	return s
+}
+
+var (
+	first  = v1alpha1.ProviderRef{Name: "10.0.0.1", Order: 1}
+	second = v1alpha1.ProviderRef{Name: "10.0.0.2", Order: 2}
+)
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotAuthProviderGroup": {
+			reason: "An error should be returned if the managed resource is not a AuthProviderGroup",
+			want: want{
+				err: errors.New(errNotAuthProviderGroup),
+			},
+		},
+		"UpToDate": {
+			reason: "The providers should be compared whatever their order in the list",
+			mg:     authProviderGroup("radius", second, first),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"OrderChanged": {
+			reason: "A provider tried in another order should be drift",
+			mg:     authProviderGroup("radius", v1alpha1.ProviderRef{Name: "10.0.0.1", Order: 2}, v1alpha1.ProviderRef{Name: "10.0.0.2", Order: 1}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"OtherProtocol": {
+			reason: "The group of another protocol is another object that does not exist",
+			mg:     authProviderGroup("tacacs", first, second),
+			want: want{
+				o: managed.ExternalObservation{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	s := apic()
+	defer s.Close()
+
+	e := external{apicClient: s.APICClient()}
+	if _, err := e.Update(context.Background(), authProviderGroup("radius", second)); err != nil {
+		t.Fatalf("e.Update(...): %s", err)
+	}
+	posts := s.Posts()
+	if diff := cmp.Diff([]string{groupDn + "/providerref-10.0.0.2"}, fakeapic.Saved(posts, "aaaProviderRef")); diff != "" {
+		t.Errorf("e.Update(...): -want saved, +got saved:\n%s\n", diff)
+	}
+	if diff := cmp.Diff([]string{groupDn + "/providerref-10.0.0.1"}, fakeapic.Deleted(posts)); diff != "" {
+		t.Errorf("e.Update(...): only the provider that is no longer desired should be deleted: -want, +got:\n%s\n", diff)
+	}
+}
@@ -34,12 +34,15 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
-	"github.com/ciscoecosystem/aci-go-client/v2/models"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
 	apisv1alpha1 "github.com/jgomezve/provider-aci/apis/v1alpha1"
 	bridgedomainutil "github.com/jgomezve/provider-aci/internal/clients/bridgedomain"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+	securitydomainutil "github.com/jgomezve/provider-aci/internal/clients/securitydomain"
+	"github.com/jgomezve/provider-aci/internal/clients/snapshot"
 	"github.com/jgomezve/provider-aci/internal/features"
+	"github.com/jgomezve/provider-aci/internal/subscription"
 )
 
 const (
@@ -49,6 +52,9 @@ const (
 	errGetCreds        = "cannot get credentials"
 
 	errNewClient = "cannot create new Service"
+	errSnapshot  = "cannot snapshot tenant before change"
+
+	errInvalidMulticast = "invalid bridge domain multicast"
 )
 
 // A NoOpService does nothing.
@@ -67,23 +73,26 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.BridgeDomainGroupVersionKind),
 		managed.WithExternalConnecter(&connector{
 			kube:         mgr.GetClient(),
 			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			recorder:     recorder,
 			newServiceFn: aciclient.NewClient}),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...))
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		WithEventFilter(resource.DesiredStateChanged()).
-		For(&v1alpha1.BridgeDomain{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		For(&v1alpha1.BridgeDomain{})
+	return subscription.Complete(mgr, o, b, v1alpha1.BridgeDomainGroupVersionKind, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
 
 // A connector is expected to produce an ExternalClient when its Connect method
@@ -91,6 +100,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube         client.Client
 	usage        resource.Tracker
+	recorder     event.Recorder
 	newServiceFn func(clientUrl, username string, options ...aciclient.Option) *aciclient.Client
 }
 
@@ -138,7 +148,7 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
-	return &external{apicClient: svc}, nil
+	return &external{apicClient: svc, snapshotter: snapshot.NewSnapshotter(svc, c.kube, c.recorder, pc)}, nil
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
@@ -147,6 +157,9 @@ type external struct {
 	// A 'client' used to connect to the external resource API. In practice this
 	// would be something like an AWS SDK client.
 	apicClient *aciclient.Client
+	// snapshotter takes a snapshot of the tenant before destructive changes,
+	// if enabled in the ProviderConfig.
+	snapshotter *snapshot.Snapshotter
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -155,25 +168,19 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotBridgeDomain)
 	}
 
-	dn := fmt.Sprintf("uni/tn-%s/BD-%s", cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Name)
-	fvBdCont, err := c.apicClient.Get(dn)
-
+	dn := bridgedomainutil.BridgeDomainDn(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Name)
+	fvBd, err := mo.Read(c.apicClient, dn, bridgedomainutil.FvBDClassName)
 	if err != nil {
-		if fmt.Sprintf("%s", err) != "Error retrieving Object: Object may not exist" {
-			return managed.ExternalObservation{}, err
-		}
+		return managed.ExternalObservation{}, err
 	}
-	if count := models.G(fvBdCont, "totalCount"); count == "0" {
+	if fvBd == nil {
 		return managed.ExternalObservation{}, nil
 	}
-	fvBd := models.BridgeDomainFromContainer(fvBdCont)
-
-	if fvBd.DistinguishedName == "" {
-		return managed.ExternalObservation{}, fmt.Errorf("bridge domain %s not found", dn)
-	}
 	// LateInitializer not required for ACI
 
 	cr.SetConditions(xpv1.Available())
+
+	cr.Status.AtProvider.Dn = fvBd["dn"]
 	return managed.ExternalObservation{
 		// // Return false when the external resource does not exist. This lets
 		// // the managed resource reconciler know that it needs to call Create to
@@ -183,7 +190,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		// // Return false when the external resource exists, but it not up to date
 		// // with the desired managed resource state. This lets the managed
 		// // resource reconciler know that it needs to call Update.
-		ResourceUpToDate: bridgedomainutil.IsUptoDate(c.apicClient, cr, fvBd.BridgeDomainAttributes),
+		ResourceUpToDate: bridgedomainutil.IsUptoDate(c.apicClient, cr, fvBd),
 
 		// // Return any details that may be required to connect to the external
 		// // resource. These will be stored as the connection secret.
@@ -200,16 +207,14 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	fmt.Printf("Creating: %+v", cr)
 	cr.SetConditions(xpv1.Creating())
 
-	fvBdAttr := models.BridgeDomainAttributes{}
-	fvBdAttr.ArpFlood = cr.Spec.ForProvider.ArpFlood
-	fvBd := models.NewBridgeDomain(fmt.Sprintf("BD-%s", cr.Spec.ForProvider.Name), fmt.Sprintf("uni/tn-%s", cr.Spec.ForProvider.Tenant), "", fvBdAttr)
-	err := c.apicClient.Save(fvBd)
-	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, "Cannot create Bridge Domain")
+	if err := bridgedomainutil.ValidateMulticast(c.apicClient, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errInvalidMulticast)
 	}
-	err = c.apicClient.CreateRelationfvRsCtxFromBridgeDomain(fvBd.DistinguishedName, cr.Spec.ForProvider.Vrf)
-	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, "Cannot create association with VRF")
+	// The bridge domain is created in a single transaction with its VRF
+	// association and all its other children, so that no partial bridge
+	// domain is left behind if any of them is rejected.
+	if err := mo.PostTree(c.apicClient, bridgedomainutil.BridgeDomainDn(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Name), bridgedomainutil.NewTree(cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "Cannot create Bridge Domain")
 	}
 
 	return managed.ExternalCreation{
@@ -226,18 +231,34 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	fmt.Printf("Updating: %+v", cr)
-	fvBdAttr := models.BridgeDomainAttributes{}
-	fvBdAttr.ArpFlood = cr.Spec.ForProvider.ArpFlood
-	fvBd := models.NewBridgeDomain(fmt.Sprintf("BD-%s", cr.Spec.ForProvider.Name), fmt.Sprintf("uni/tn-%s", cr.Spec.ForProvider.Tenant), "", fvBdAttr)
+	if err := bridgedomainutil.ValidateMulticast(c.apicClient, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errInvalidMulticast)
+	}
+	dn := bridgedomainutil.BridgeDomainDn(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Name)
+	if bridgedomainutil.VrfName(c.apicClient, dn) != cr.Spec.ForProvider.Vrf || !bridgedomainutil.RelationsUpToDate(c.apicClient, dn, cr.Spec.ForProvider) {
+		if err := c.snapshotter.Take(ctx, cr, cr.Spec.ForProvider.Tenant, fmt.Sprintf("changing the VRF or the relations of %s", dn)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSnapshot)
+		}
+	}
+	fvBd := bridgedomainutil.NewBridgeDomain(cr.Spec.ForProvider)
 	fvBd.Status = "modified"
 	err := c.apicClient.Save(fvBd)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot update Bridge Domain")
 	}
-	err = c.apicClient.CreateRelationfvRsCtxFromBridgeDomain(fvBd.DistinguishedName, cr.Spec.ForProvider.Vrf)
+	err = c.apicClient.CreateRelationfvRsCtxFromBridgeDomain(fvBd.Dn, cr.Spec.ForProvider.Vrf)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot update association with VRF")
 	}
+	if err := bridgedomainutil.ReconcileRelations(c.apicClient, fvBd.Dn, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot update Bridge Domain relations")
+	}
+	if err := bridgedomainutil.ReconcileDHCPLabels(c.apicClient, fvBd.Dn, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot update Bridge Domain DHCP labels")
+	}
+	if err := securitydomainutil.ReconcileDomainRefs(c.apicClient, fvBd.Dn, cr.Spec.ForProvider.SecurityDomains); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot update Bridge Domain security domains")
+	}
 	return managed.ExternalUpdate{
 		// Optionally return any details that may be required to connect to the
 		// external resource. These will be stored as the connection secret.
@@ -252,8 +273,11 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 	}
 
 	cr.SetConditions(xpv1.Deleting())
-	dn := fmt.Sprintf("uni/tn-%s/BD-%s", cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Name)
-	err := c.apicClient.DeleteByDn(dn, "fvBD")
+	dn := bridgedomainutil.BridgeDomainDn(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Name)
+	if err := c.snapshotter.Take(ctx, cr, cr.Spec.ForProvider.Tenant, fmt.Sprintf("deleting %s", dn)); err != nil {
+		return errors.Wrap(err, errSnapshot)
+	}
+	err := c.apicClient.DeleteByDn(dn, bridgedomainutil.FvBDClassName)
 	if err != nil {
 		return err
 	}
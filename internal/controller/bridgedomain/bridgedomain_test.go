@@ -19,12 +19,21 @@ package bridgedomain
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	apisv1alpha1 "github.com/jgomezve/provider-aci/apis/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+	"github.com/jgomezve/provider-aci/internal/clients/snapshot"
 )
 
 // Unlike many Kubernetes projects Crossplane does not use third party testing
@@ -72,3 +81,185 @@ func TestObserve(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateAtomic(t *testing.T) {
+	type args struct {
+		mg   *v1alpha1.BridgeDomain
+		fail string
+	}
+
+	type want struct {
+		err      error
+		vrf      string
+		children []string
+	}
+
+	bd := &v1alpha1.BridgeDomain{Spec: v1alpha1.BridgeDomainSpec{ForProvider: v1alpha1.BridgeDomainParameters{
+		Name:   "web",
+		Tenant: "crossplane",
+		Vrf:    "prod",
+	}}}
+	withChildren := bd.DeepCopy()
+	withChildren.Spec.ForProvider.L3Outs = []string{"internet"}
+	withChildren.Spec.ForProvider.IgmpInterfacePolicy = "igmp"
+	withChildren.Spec.ForProvider.DHCPLabels = []v1alpha1.DHCPLabel{{Name: "relay", Scope: "tenant", OptionPolicy: "default"}}
+	withChildren.Spec.ForProvider.SecurityDomains = []string{"web"}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Created": {
+			reason: "The bridge domain should be created with its VRF association and its relations in a single POST",
+			args: args{
+				mg: bd.DeepCopy(),
+			},
+			want: want{
+				vrf:      "prod",
+				children: []string{"fvRsBdToEpRet", "fvRsCtx", "fvRsIgmpsn"},
+			},
+		},
+		"CreatedWithChildren": {
+			reason: "The L3Outs, IGMP interface, DHCP labels and security domains of the bridge domain should be created in the same POST",
+			args: args{
+				mg: withChildren,
+			},
+			want: want{
+				vrf:      "prod",
+				children: []string{"aaaDomainRef", "dhcpLbl", "fvRsBDToOut", "fvRsBdToEpRet", "fvRsCtx", "fvRsIgmpsn", "igmpIfP"},
+			},
+		},
+		"VrfAssociationFails": {
+			reason: "Nothing else should be created if the APIC rejects the bridge domain with its VRF association",
+			args: args{
+				mg:   bd.DeepCopy(),
+				fail: "Invalid VRF prod",
+			},
+			want: want{
+				err:      errors.Wrap(errors.New("Invalid VRF prod"), "Cannot create Bridge Domain"),
+				vrf:      "prod",
+				children: []string{"fvRsBdToEpRet", "fvRsCtx", "fvRsIgmpsn"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apic := fakeapic.New()
+			defer apic.Close()
+			if tc.args.fail != "" {
+				apic.FailPost("/api/node/mo/uni/tn-crossplane/BD-web.json", tc.args.fail)
+			}
+
+			e := external{apicClient: apic.APICClient()}
+			_, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			posts := apic.Posts()
+			if len(posts) != 1 {
+				t.Fatalf("\n%s\ne.Create(...): want 1 POST, got %d", tc.reason, len(posts))
+			}
+			if diff := cmp.Diff(tc.want.vrf, fakeapic.ChildAttribute(posts[0].Body, "fvBD", "fvRsCtx", "tnFvCtxName")); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want VRF, +got VRF:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.children, fakeapic.ChildClasses(posts[0].Body, "fvBD")); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want children, +got children:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// exports returns how many tenant snapshots were triggered by the supplied
+// POSTs.
+func exports(posts []fakeapic.Request) int {
+	n := 0
+	for _, p := range posts {
+		if fakeapic.Attribute(p.Body, "configExportP", "adminSt") == "triggered" {
+			n++
+		}
+	}
+	return n
+}
+
+// changed returns whether the supplied POSTs changed the bridge domain.
+func changed(posts []fakeapic.Request) bool {
+	for _, p := range posts {
+		if fakeapic.Attribute(p.Body, "fvBD", "dn") != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		exports int
+		// changed is whether the bridge domain was changed before the
+		// snapshot completed.
+		changed bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		p      v1alpha1.BridgeDomainParameters
+		want   want
+	}{
+		"RelationsUpToDate": {
+			reason: "No snapshot should be taken if the VRF and the relations of the bridge domain would not change",
+			p:      v1alpha1.BridgeDomainParameters{Tenant: "crossplane", Name: "web", Vrf: "prod"},
+			want:   want{changed: true},
+		},
+		"VrfChanged": {
+			reason: "A snapshot should be taken before the VRF of the bridge domain is changed",
+			p:      v1alpha1.BridgeDomainParameters{Tenant: "crossplane", Name: "web", Vrf: "dev"},
+			want:   want{exports: 1},
+		},
+		"L3OutChanged": {
+			reason: "A snapshot should be taken before the L3Outs of the bridge domain are changed",
+			p:      v1alpha1.BridgeDomainParameters{Tenant: "crossplane", Name: "web", Vrf: "prod", L3Outs: []string{"internet"}},
+			want:   want{exports: 1},
+		},
+		"EpRetentionPolicyChanged": {
+			reason: "A snapshot should be taken before the endpoint retention policy of the bridge domain is changed",
+			p:      v1alpha1.BridgeDomainParameters{Tenant: "crossplane", Name: "web", Vrf: "prod", EpRetentionPolicy: "short"},
+			want:   want{exports: 1},
+		},
+		"IgmpSnoopPolicyChanged": {
+			reason: "A snapshot should be taken before the IGMP snooping policy of the bridge domain is changed",
+			p:      v1alpha1.BridgeDomainParameters{Tenant: "crossplane", Name: "web", Vrf: "prod", IgmpSnoopPolicy: "snoop"},
+			want:   want{exports: 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apic := fakeapic.New()
+			defer apic.Close()
+			apic.RespondGet("/api/node/class/uni/tn-crossplane/BD-web/fvRsCtx.json",
+				`{"totalCount":"1","imdata":[{"fvRsCtx":{"attributes":{"dn":"uni/tn-crossplane/BD-web/rsctx","tDn":"uni/tn-crossplane/ctx-prod"}}}]}`)
+
+			kube := &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}
+			pc := &apisv1alpha1.ProviderConfig{Spec: apisv1alpha1.ProviderConfigSpec{Snapshot: &apisv1alpha1.SnapshotConfig{BeforeChanges: true}}}
+			e := external{
+				apicClient:  apic.APICClient(),
+				snapshotter: snapshot.NewSnapshotter(apic.APICClient(), kube, event.NewNopRecorder(), pc),
+			}
+			cr := &v1alpha1.BridgeDomain{ObjectMeta: metav1.ObjectMeta{Name: "web"}, Spec: v1alpha1.BridgeDomainSpec{ForProvider: tc.p}}
+
+			// The fake APIC never completes a snapshot, the change has to
+			// wait for it until the reconcile times out.
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			_, _ = e.Update(ctx, cr)
+			posts := apic.Posts()
+			if got := exports(posts); got != tc.want.exports {
+				t.Errorf("\n%s\ne.Update(...): want %d snapshots, got %d", tc.reason, tc.want.exports, got)
+			}
+			if got := changed(posts); got != tc.want.changed {
+				t.Errorf("\n%s\ne.Update(...): want bridge domain changed %t, got %t", tc.reason, tc.want.changed, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package classquery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/rest/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+// bridgeDomains is the answer of the APIC to a query of the bridge domains:
+// three match, in no particular order, and the APIC does not page them.
+const bridgeDomains = `{"totalCount":"3","imdata":[
+{"fvBD":{"attributes":{"dn":"uni/tn-web/BD-db","name":"db","arpFlood":"no"}}},
+{"fvBD":{"attributes":{"dn":"uni/tn-web/BD-app","name":"app","arpFlood":"yes"}}},
+{"fvBD":{"attributes":{"dn":"uni/tn-web/BD-web","name":"web","arpFlood":"no"}}}]}`
+
+func classQuery(maxResults int) *v1alpha1.ClassQuery {
+	return &v1alpha1.ClassQuery{Spec: v1alpha1.ClassQuerySpec{ForProvider: v1alpha1.ClassQueryParameters{
+		ClassName:  "fvBD",
+		Attributes: []string{"dn", "name"},
+		MaxResults: maxResults,
+	}}}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	now := metav1.Now()
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotClassQuery": {
+			reason: "An error should be returned if the managed resource is not a ClassQuery",
+			want: want{
+				err: errors.New(errNotClassQuery),
+			},
+		},
+		"Deleted": {
+			reason: "A deleted ClassQuery should not exist so that its deletion completes",
+			mg:     &v1alpha1.ClassQuery{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &now}},
+			want: want{
+				o: managed.ExternalObservation{},
+			},
+		},
+		"Results": {
+			reason: "The first objects by DN should be published with only their projected attributes, with the number of matching objects",
+			mg:     classQuery(2),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{
+					"totalCount": []byte("3"),
+					"0.dn":       []byte("uni/tn-web/BD-app"),
+					"0.name":     []byte("app"),
+					"1.dn":       []byte("uni/tn-web/BD-db"),
+					"1.name":     []byte("db"),
+				}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := fakeapic.New()
+			defer s.Close()
+			s.RespondGet("/api/node/class/fvBD.json", bridgeDomains)
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestObserveStatus(t *testing.T) {
+	s := fakeapic.New()
+	defer s.Close()
+	s.RespondGet("/api/node/class/fvBD.json", bridgeDomains)
+
+	e := external{apicClient: s.APICClient()}
+	cr := classQuery(2)
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("e.Observe(...): %s", err)
+	}
+	want := v1alpha1.ClassQueryObservation{
+		TotalCount: 3,
+		Results:    []map[string]string{{"dn": "uni/tn-web/BD-app", "name": "app"}, {"dn": "uni/tn-web/BD-db", "name": "db"}},
+	}
+	if diff := cmp.Diff(want, cr.Status.AtProvider); diff != "" {
+		t.Errorf("e.Observe(...): -want status, +got status:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configexportpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+func configExportPolicy(scheduler string) *v1alpha1.ConfigExportPolicy {
+	return &v1alpha1.ConfigExportPolicy{Spec: v1alpha1.ConfigExportPolicySpec{ForProvider: v1alpha1.ConfigExportPolicyParameters{
+		Name:                "daily",
+		Format:              "json",
+		Snapshot:            "yes",
+		IncludeSecureFields: "yes",
+		MaxSnapshotCount:    "10",
+		Scheduler:           scheduler,
+	}}}
+}
+
+// apic returns a fake APIC with the export policy daily, scheduled by the
+// scheduler nightly, and two of its jobs, not sorted by execution time.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet("/api/node/mo/uni/fabric/configexp-daily.json",
+		`{"totalCount":"1","imdata":[{"configExportP":{"attributes":{"dn":"uni/fabric/configexp-daily","name":"daily","descr":"","format":"json","targetDn":"","snapshot":"yes","includeSecureFields":"yes","maxSnapshotCount":"10"}}}]}`)
+	s.RespondGet("/api/node/mo/uni/fabric/configexp-daily/rsexportScheduler.json",
+		`{"totalCount":"1","imdata":[{"configRsExportScheduler":{"attributes":{"dn":"uni/fabric/configexp-daily/rsexportScheduler","tnTrigSchedPName":"nightly"}}}]}`)
+	s.RespondGet("/api/node/mo/uni/fabric/configexp-daily/rsremotePath.json",
+		`{"totalCount":"1","imdata":[{"configRsRemotePath":{"attributes":{"dn":"uni/fabric/configexp-daily/rsremotePath","tnFileRemotePathName":""}}}]}`)
+	s.RespondChildren("/api/node/mo/uni/backupst/jobs-[uni/fabric/configexp-daily].json", `{"totalCount":"2","imdata":[
+{"configJob":{"attributes":{"dn":"uni/backupst/jobs-[uni/fabric/configexp-daily]/run-2","name":"run-2","operSt":"success","details":"","fileName":"ce_daily-2.tar.gz","executeTime":"2022-10-02T00:00:00.000+00:00","lastStepDescr":"Export completed"}}},
+{"configJob":{"attributes":{"dn":"uni/backupst/jobs-[uni/fabric/configexp-daily]/run-1","name":"run-1","operSt":"fail-remote","details":"Remote host unreachable","fileName":"","executeTime":"2022-10-01T00:00:00.000+00:00","lastStepDescr":"Uploading"}}}]}`)
+	return s
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o       managed.ExternalObservation
+		lastJob v1alpha1.ConfigJobStatus
+		err     error
+	}
+
+	lastJob := v1alpha1.ConfigJobStatus{
+		Name:                "run-2",
+		Status:              "success",
+		FileName:            "ce_daily-2.tar.gz",
+		ExecuteTime:         "2022-10-02T00:00:00.000+00:00",
+		LastStepDescription: "Export completed",
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotConfigExportPolicy": {
+			reason: "An error should be returned if the managed resource is not a ConfigExportPolicy",
+			want: want{
+				err: errors.New(errNotConfigExportPolicy),
+			},
+		},
+		"UpToDate": {
+			reason: "The policy should be up to date and report its last job",
+			mg:     configExportPolicy("nightly"),
+			want: want{
+				o:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+				lastJob: lastJob,
+			},
+		},
+		"SchedulerChanged": {
+			reason: "A policy scheduled by another scheduler should be drift",
+			mg:     configExportPolicy("weekly"),
+			want: want{
+				o:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+				lastJob: lastJob,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.ConfigExportPolicy); ok {
+				if diff := cmp.Diff(tc.want.lastJob, cr.Status.AtProvider.LastJob); diff != "" {
+					t.Errorf("\n%s\ne.Observe(...): -want last job, +got last job:\n%s\n", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	s := apic()
+	defer s.Close()
+
+	e := external{apicClient: s.APICClient()}
+	if _, err := e.Update(context.Background(), configExportPolicy("weekly")); err != nil {
+		t.Fatalf("e.Update(...): %s", err)
+	}
+	var scheduler string
+	for _, p := range s.Posts() {
+		if v := fakeapic.Attribute(p.Body, "configRsExportScheduler", "tnTrigSchedPName"); v != "" {
+			scheduler = v
+		}
+	}
+	if scheduler != "weekly" {
+		t.Errorf("e.Update(...): want the policy pointed to the scheduler weekly, got %q", scheduler)
+	}
+}
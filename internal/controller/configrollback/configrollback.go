@@ -0,0 +1,275 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configrollback
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	apisv1alpha1 "github.com/jgomezve/provider-aci/apis/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/annotation"
+	configexportpolicyutil "github.com/jgomezve/provider-aci/internal/clients/configexportpolicy"
+	configrollbackutil "github.com/jgomezve/provider-aci/internal/clients/configrollback"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+	"github.com/jgomezve/provider-aci/internal/features"
+	"github.com/jgomezve/provider-aci/internal/subscription"
+)
+
+const (
+	errNotConfigRollback = "managed resource is not a ConfigRollback custom resource"
+	errTrackPCUsage      = "cannot track ProviderConfig usage"
+	errGetPC             = "cannot get ProviderConfig"
+	errGetCreds          = "cannot get credentials"
+
+	errNewClient     = "cannot create new Service"
+	errRecordTrigger = "cannot record the rollback trigger"
+)
+
+// A NoOpService does nothing.
+type NoOpService struct{}
+
+// var (
+// 	newNoOpService = func(_ []byte) (interface{}, error) { return &NoOpService{}, nil }
+// )
+
+// Setup adds a controller that reconciles ConfigRollback managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ConfigRollbackGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ConfigRollbackGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: aciclient.NewClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.ConfigRollback{})
+	return subscription.Complete(mgr, o, b, v1alpha1.ConfigRollbackGroupVersionKind, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(clientUrl, username string, options ...aciclient.Option) *aciclient.Client
+}
+
+type SecretData struct {
+	Url      string `json:"url"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Insecure bool   `json:"insecure"`
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+
+	var secretData SecretData
+	cr, ok := mg.(*v1alpha1.ConfigRollback)
+	if !ok {
+		return nil, errors.New(errNotConfigRollback)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	err = json.Unmarshal(data, &secretData)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := c.newServiceFn(secretData.Url, secretData.Username, aciclient.Password(secretData.Password), aciclient.Insecure(secretData.Insecure))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{apicClient: svc, kube: c.kube}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	apicClient *aciclient.Client
+	// The trigger of the rollback is recorded in an annotation.
+	kube client.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ConfigRollback)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotConfigRollback)
+	}
+
+	dn := configrollbackutil.PolicyDn(cr.Spec.ForProvider.Name)
+	configImportP, err := mo.Read(c.apicClient, dn, configrollbackutil.ConfigImportPClassName)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if configImportP == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	// LateInitializer not required for ACI
+
+	lastJob, err := configexportpolicyutil.ReadLastJob(c.apicClient, dn)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "Cannot read rollback job status")
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	cr.Status.AtProvider.Dn = configImportP["dn"]
+	cr.Status.AtProvider.LastJob = lastJob
+	cr.Status.AtProvider.LastTrigger = configrollbackutil.LastTrigger(cr)
+	return managed.ExternalObservation{
+		// // Return false when the external resource does not exist. This lets
+		// // the managed resource reconciler know that it needs to call Create to
+		// // (re)create the resource, or that it has successfully been deleted.
+		ResourceExists: true,
+
+		// // Return false when the external resource exists, but it not up to date
+		// // with the desired managed resource state. This lets the managed
+		// // resource reconciler know that it needs to call Update.
+		ResourceUpToDate: configrollbackutil.IsUptoDate(cr, configImportP),
+
+		// // Return any details that may be required to connect to the external
+		// // resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ConfigRollback)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotConfigRollback)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	trigger, err := c.recordTrigger(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	err = c.apicClient.Save(configrollbackutil.NewPolicy(cr.Spec.ForProvider, trigger))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "Cannot create Config Rollback")
+	}
+
+	return managed.ExternalCreation{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ConfigRollback)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotConfigRollback)
+	}
+
+	trigger, err := c.recordTrigger(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	configImportP := configrollbackutil.NewPolicy(cr.Spec.ForProvider, trigger)
+	configImportP.Status = "modified"
+	err = c.apicClient.Save(configImportP)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot update Config Rollback")
+	}
+	return managed.ExternalUpdate{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ConfigRollback)
+	if !ok {
+		return errors.New(errNotConfigRollback)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	err := c.apicClient.DeleteByDn(configrollbackutil.PolicyDn(cr.Spec.ForProvider.Name), configrollbackutil.ConfigImportPClassName)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// recordTrigger returns whether the rollback has to be run. If so, the
+// trigger is recorded before the rollback is run, so that it is run at most
+// once per trigger even if the status or the rest of the reconcile is lost.
+// A rollback that then fails to run is only retried with a new trigger.
+func (c *external) recordTrigger(ctx context.Context, cr *v1alpha1.ConfigRollback) (bool, error) {
+	if !configrollbackutil.TriggerPending(cr) {
+		return false, nil
+	}
+	err := annotation.Persist(ctx, c.kube, cr, map[string]string{configrollbackutil.AnnotationKeyLastTrigger: cr.Spec.ForProvider.Trigger})
+	if err != nil {
+		return false, errors.Wrap(err, errRecordTrigger)
+	}
+	cr.Status.AtProvider.LastTrigger = cr.Spec.ForProvider.Trigger
+	return true, nil
+}
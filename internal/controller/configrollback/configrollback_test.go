@@ -0,0 +1,215 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configrollback
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	configrollbackutil "github.com/jgomezve/provider-aci/internal/clients/configrollback"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	policyPath = "/api/node/mo/uni/fabric/configimp-rollback.json"
+	policyDn   = "uni/fabric/configimp-rollback"
+
+	policy = `{"totalCount":"1","imdata":[{"configImportP":{"attributes":{"dn":"uni/fabric/configimp-rollback","name":"rollback","descr":"","fileName":"ce2_snapshot.tar.gz","importType":"replace","importMode":"atomic"}}}]}`
+)
+
+// configRollback returns a ConfigRollback with the supplied trigger, the
+// supplied recorded trigger annotation, if any, and the supplied trigger in
+// its status.
+func configRollback(trigger, annotated, status string) *v1alpha1.ConfigRollback {
+	cr := &v1alpha1.ConfigRollback{
+		ObjectMeta: metav1.ObjectMeta{Name: "rollback"},
+		Spec: v1alpha1.ConfigRollbackSpec{ForProvider: v1alpha1.ConfigRollbackParameters{
+			Name:       "rollback",
+			Snapshot:   "ce2_snapshot.tar.gz",
+			ImportType: "replace",
+			ImportMode: "atomic",
+			Trigger:    trigger,
+		}},
+	}
+	if annotated != "" {
+		cr.SetAnnotations(map[string]string{configrollbackutil.AnnotationKeyLastTrigger: annotated})
+	}
+	cr.Status.AtProvider.LastTrigger = status
+	return cr
+}
+
+// triggered returns whether any of the supplied POSTs ran the rollback.
+func triggered(posts []fakeapic.Request) bool {
+	for _, p := range posts {
+		if fakeapic.Attribute(p.Body, configrollbackutil.ConfigImportPClassName, "dn") == policyDn &&
+			fakeapic.Attribute(p.Body, configrollbackutil.ConfigImportPClassName, "adminSt") == "triggered" {
+			return true
+		}
+	}
+	return false
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		lastTrigger string
+		upToDate    bool
+		err         error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotConfigRollback": {
+			reason: "An error should be returned if the managed resource is not a ConfigRollback",
+			want: want{
+				err: errors.New(errNotConfigRollback),
+			},
+		},
+		"StatusLost": {
+			reason: "The last trigger should be read from the annotation if the status was lost, and not be pending",
+			mg:     configRollback("v1", "v1", ""),
+			want: want{
+				lastTrigger: "v1",
+				upToDate:    true,
+			},
+		},
+		"TriggerPending": {
+			reason: "A new trigger should make the rollback not up to date",
+			mg:     configRollback("v2", "v1", "v1"),
+			want: want{
+				lastTrigger: "v1",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apic := fakeapic.New()
+			defer apic.Close()
+			apic.RespondGet(policyPath, policy)
+
+			e := external{apicClient: apic.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			if !got.ResourceExists || got.ResourceUpToDate != tc.want.upToDate {
+				t.Errorf("\n%s\ne.Observe(...): want exists and up to date %t, got %+v", tc.reason, tc.want.upToDate, got)
+			}
+			cr := tc.mg.(*v1alpha1.ConfigRollback)
+			if diff := cmp.Diff(tc.want.lastTrigger, cr.Status.AtProvider.LastTrigger); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want last trigger, +got last trigger:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		recorded  string
+		triggered bool
+		err       error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.ConfigRollback
+		update error
+		want   want
+	}{
+		"Resync": {
+			reason: "An update with an unchanged trigger should not run the rollback again",
+			mg:     configRollback("v1", "v1", "v1"),
+		},
+		"StatusLost": {
+			reason: "An update with the trigger recorded in the annotation should not run the rollback again, even if the status was lost",
+			mg:     configRollback("v1", "v1", ""),
+		},
+		"Untriggered": {
+			reason: "An update without trigger should never run the rollback",
+			mg:     configRollback("", "", ""),
+		},
+		"NewTrigger": {
+			reason: "A new trigger should be recorded before the rollback is run",
+			mg:     configRollback("v2", "v1", "v1"),
+			want: want{
+				recorded:  "v2",
+				triggered: true,
+			},
+		},
+		"RecordFailed": {
+			reason: "The rollback should not be run if the trigger cannot be recorded",
+			mg:     configRollback("v2", "v1", "v1"),
+			update: errBoom,
+			want: want{
+				err: errors.Wrap(errBoom, errRecordTrigger),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apic := fakeapic.New()
+			defer apic.Close()
+
+			recorded := ""
+			kube := &test.MockClient{MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+				if len(apic.Posts()) > 0 {
+					t.Errorf("\n%s\ne.Update(...): the trigger should be recorded before any change", tc.reason)
+				}
+				recorded = obj.GetAnnotations()[configrollbackutil.AnnotationKeyLastTrigger]
+				return tc.update
+			}}
+
+			e := external{apicClient: apic.APICClient(), kube: kube}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if tc.update == nil && recorded != tc.want.recorded {
+				t.Errorf("\n%s\ne.Update(...): want recorded trigger %q, got %q", tc.reason, tc.want.recorded, recorded)
+			}
+			if got := triggered(apic.Posts()); got != tc.want.triggered {
+				t.Errorf("\n%s\ne.Update(...): want triggered %t, got %t", tc.reason, tc.want.triggered, got)
+			}
+		})
+	}
+}
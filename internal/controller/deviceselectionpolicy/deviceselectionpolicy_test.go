@@ -0,0 +1,189 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deviceselectionpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/services/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const ctxDn = "uni/tn-web/ldevCtx-c-web-g-fw-n-N1"
+
+func deviceSelectionPolicy(interfaces ...v1alpha1.DeviceSelectionInterface) *v1alpha1.DeviceSelectionPolicy {
+	return &v1alpha1.DeviceSelectionPolicy{Spec: v1alpha1.DeviceSelectionPolicySpec{ForProvider: v1alpha1.DeviceSelectionPolicyParameters{
+		Tenant:     "web",
+		Contract:   "web",
+		Graph:      "fw",
+		Node:       "N1",
+		Device:     "asa",
+		Interfaces: interfaces,
+	}}}
+}
+
+// relation returns the JSON body of the relation of the supplied class with
+// the supplied DN to tDn.
+func relation(className, dn, tDn string) string {
+	return `{"totalCount":"1","imdata":[{"` + className + `":{"attributes":{"dn":"` + dn + `","tDn":"` + tDn + `"}}}]}`
+}
+
+// apic returns a fake APIC with the device selection policy of the node N1
+// of the graph fw of the contract web, selecting the device asa. Its
+// consumer connector redirects the traffic of the bridge domain web to the
+// redirect policy pbr, its provider connector is in the bridge domain app.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet("/api/node/mo/"+ctxDn+".json",
+		`{"totalCount":"1","imdata":[{"vnsLDevCtx":{"attributes":{"dn":"`+ctxDn+`","ctrctNameOrLbl":"web","graphNameOrLbl":"fw","nodeNameOrLbl":"N1","descr":""}}}]}`)
+	s.RespondGet("/api/node/mo/"+ctxDn+"/rsLDevCtxToLDev.json",
+		relation("vnsRsLDevCtxToLDev", ctxDn+"/rsLDevCtxToLDev", "uni/tn-web/lDevVip-asa"))
+	s.RespondChildren("/api/node/mo/"+ctxDn+".json", `{"totalCount":"2","imdata":[
+{"vnsLIfCtx":{"attributes":{"dn":"`+ctxDn+`/lIfCtx-c-provider","connNameOrLbl":"provider"}}},
+{"vnsLIfCtx":{"attributes":{"dn":"`+ctxDn+`/lIfCtx-c-consumer","connNameOrLbl":"consumer"}}}]}`)
+	for _, i := range []struct{ connector, bd, redirect string }{{"consumer", "web", "pbr"}, {"provider", "app", ""}} {
+		dn := ctxDn + "/lIfCtx-c-" + i.connector
+		s.RespondGet("/api/node/mo/"+dn+"/rsLIfCtxToLIf.json",
+			relation("vnsRsLIfCtxToLIf", dn+"/rsLIfCtxToLIf", "uni/tn-web/lDevVip-asa/lIf-"+i.connector))
+		s.RespondGet("/api/node/mo/"+dn+"/rsLIfCtxToBD.json",
+			relation("vnsRsLIfCtxToBD", dn+"/rsLIfCtxToBD", "uni/tn-web/BD-"+i.bd))
+		if i.redirect != "" {
+			s.RespondGet("/api/node/mo/"+dn+"/rsLIfCtxToSvcRedirectPol.json",
+				relation("vnsRsLIfCtxToSvcRedirectPol", dn+"/rsLIfCtxToSvcRedirectPol", "uni/tn-web/svcCont/svcRedirectPol-"+i.redirect))
+		}
+	}
+	return s
+}
+
+var (
+	consumer = v1alpha1.DeviceSelectionInterface{Connector: "consumer", LogicalInterface: "consumer", BridgeDomain: "web", RedirectPolicy: "pbr"}
+	provider = v1alpha1.DeviceSelectionInterface{Connector: "provider", LogicalInterface: "provider", BridgeDomain: "app"}
+)
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotDeviceSelectionPolicy": {
+			reason: "An error should be returned if the managed resource is not a DeviceSelectionPolicy",
+			want: want{
+				err: errors.New(errNotDeviceSelectionPolicy),
+			},
+		},
+		"UpToDate": {
+			reason: "The interfaces and their relations should be read back by name",
+			mg:     deviceSelectionPolicy(provider, consumer),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"RedirectPolicyChanged": {
+			reason: "An interface redirecting to another redirect policy should be drift",
+			mg: deviceSelectionPolicy(provider, v1alpha1.DeviceSelectionInterface{
+				Connector: "consumer", LogicalInterface: "consumer", BridgeDomain: "web", RedirectPolicy: "pbr-backup",
+			}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"InterfaceRemoved": {
+			reason: "An interface that is no longer desired should be drift",
+			mg:     deviceSelectionPolicy(consumer),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		mg      *v1alpha1.DeviceSelectionPolicy
+		deleted []string
+	}{
+		"RedirectPolicyRemoved": {
+			reason: "The redirect policy relation of an interface should be deleted if none is desired",
+			mg: deviceSelectionPolicy(provider, v1alpha1.DeviceSelectionInterface{
+				Connector: "consumer", LogicalInterface: "consumer", BridgeDomain: "web",
+			}),
+			deleted: []string{
+				ctxDn + "/lIfCtx-c-consumer/rsLIfCtxToSvcRedirectPol",
+				ctxDn + "/lIfCtx-c-provider/rsLIfCtxToSvcRedirectPol",
+			},
+		},
+		"InterfaceRemoved": {
+			reason:  "Only the interface that is no longer desired should be deleted",
+			mg:      deviceSelectionPolicy(consumer),
+			deleted: []string{ctxDn + "/lIfCtx-c-provider"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.deleted, fakeapic.Deleted(s.Posts())); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dhcpoptionpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const optionPolicyDn = "uni/tn-web/dhcpoptpol-web"
+
+func optionPolicy(options ...v1alpha1.DHCPOption) *v1alpha1.DHCPOptionPolicy {
+	return &v1alpha1.DHCPOptionPolicy{Spec: v1alpha1.DHCPOptionPolicySpec{ForProvider: v1alpha1.DHCPOptionPolicyParameters{
+		Name:    "web",
+		Tenant:  "web",
+		Options: options,
+	}}}
+}
+
+// apic returns a fake APIC with the option policy web, which sets the DNS
+// server and the domain name options.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet("/api/node/mo/"+optionPolicyDn+".json",
+		`{"totalCount":"1","imdata":[{"dhcpOptionPol":{"attributes":{"dn":"`+optionPolicyDn+`","name":"web","descr":""}}}]}`)
+	s.RespondChildren("/api/node/mo/"+optionPolicyDn+".json", `{"totalCount":"2","imdata":[
+{"dhcpOption":{"attributes":{"dn":"`+optionPolicyDn+`/opt-domain","name":"domain","id":"15","data":"example.com"}}},
+{"dhcpOption":{"attributes":{"dn":"`+optionPolicyDn+`/opt-dns","name":"dns","id":"6","data":"10.0.0.53"}}}]}`)
+	return s
+}
+
+var (
+	dns    = v1alpha1.DHCPOption{Name: "dns", ID: "6", Data: "10.0.0.53"}
+	domain = v1alpha1.DHCPOption{Name: "domain", ID: "15", Data: "example.com"}
+)
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotDHCPOptionPolicy": {
+			reason: "An error should be returned if the managed resource is not a DHCPOptionPolicy",
+			want: want{
+				err: errors.New(errNotDHCPOptionPolicy),
+			},
+		},
+		"UpToDate": {
+			reason: "The options should match whatever order the APIC returns them in",
+			mg:     optionPolicy(dns, domain),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"DataChanged": {
+			reason: "An option with other data should be drift",
+			mg:     optionPolicy(v1alpha1.DHCPOption{Name: "dns", ID: "6", Data: "10.0.0.54"}, domain),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"OptionRemoved": {
+			reason: "An option that is no longer desired should be drift",
+			mg:     optionPolicy(dns),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	s := apic()
+	defer s.Close()
+
+	e := external{apicClient: s.APICClient()}
+	if _, err := e.Update(context.Background(), optionPolicy(dns)); err != nil {
+		t.Fatalf("e.Update(...): %s", err)
+	}
+	posts := s.Posts()
+	if diff := cmp.Diff([]string{optionPolicyDn + "/opt-dns"}, fakeapic.Saved(posts, "dhcpOption")); diff != "" {
+		t.Errorf("e.Update(...): the desired option should be saved: -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff([]string{optionPolicyDn + "/opt-domain"}, fakeapic.Deleted(posts)); diff != "" {
+		t.Errorf("e.Update(...): only the option that is no longer desired should be deleted: -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dhcprelaypolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	relayDn = "uni/tn-web/relayp-dhcp"
+	primary = "uni/tn-common/ap-infra/epg-dhcp"
+	backup  = "uni/tn-common/ap-infra/epg-dhcp-backup"
+)
+
+func relayPolicy(providers ...v1alpha1.DHCPProvider) *v1alpha1.DHCPRelayPolicy {
+	return &v1alpha1.DHCPRelayPolicy{Spec: v1alpha1.DHCPRelayPolicySpec{ForProvider: v1alpha1.DHCPRelayPolicyParameters{
+		Name:      "dhcp",
+		Tenant:    "web",
+		Owner:     "tenant",
+		Providers: providers,
+	}}}
+}
+
+// apic returns a fake APIC with the relay policy dhcp, which relays to a
+// primary and a backup DHCP server.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet("/api/node/mo/"+relayDn+".json",
+		`{"totalCount":"1","imdata":[{"dhcpRelayP":{"attributes":{"dn":"`+relayDn+`","name":"dhcp","descr":"","owner":"tenant","mode":"visible"}}}]}`)
+	s.RespondChildren("/api/node/mo/"+relayDn+".json", `{"totalCount":"2","imdata":[
+{"dhcpRsProv":{"attributes":{"dn":"`+relayDn+`/rsprov-[`+backup+`]","tDn":"`+backup+`","addr":"10.0.0.11"}}},
+{"dhcpRsProv":{"attributes":{"dn":"`+relayDn+`/rsprov-[`+primary+`]","tDn":"`+primary+`","addr":"10.0.0.10"}}}]}`)
+	return s
+}
+
+var (
+	primaryServer = v1alpha1.DHCPProvider{Dn: primary, ServerIP: "10.0.0.10"}
+	backupServer  = v1alpha1.DHCPProvider{Dn: backup, ServerIP: "10.0.0.11"}
+)
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotDHCPRelayPolicy": {
+			reason: "An error should be returned if the managed resource is not a DHCPRelayPolicy",
+			want: want{
+				err: errors.New(errNotDHCPRelayPolicy),
+			},
+		},
+		"UpToDate": {
+			reason: "The DHCP servers should match whatever order the APIC returns them in",
+			mg:     relayPolicy(primaryServer, backupServer),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"ServerIPChanged": {
+			reason: "A DHCP server with another address should be drift",
+			mg:     relayPolicy(v1alpha1.DHCPProvider{Dn: primary, ServerIP: "10.0.0.20"}, backupServer),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"ServerRemoved": {
+			reason: "A DHCP server that is no longer desired should be drift",
+			mg:     relayPolicy(primaryServer),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	s := apic()
+	defer s.Close()
+
+	e := external{apicClient: s.APICClient()}
+	if _, err := e.Update(context.Background(), relayPolicy(v1alpha1.DHCPProvider{Dn: primary, ServerIP: "10.0.0.20"})); err != nil {
+		t.Fatalf("e.Update(...): %s", err)
+	}
+	posts := s.Posts()
+	if diff := cmp.Diff([]string{relayDn + "/rsprov-[" + primary + "]"}, fakeapic.Saved(posts, "dhcpRsProv")); diff != "" {
+		t.Errorf("e.Update(...): the desired DHCP server should be saved: -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff([]string{relayDn + "/rsprov-[" + backup + "]"}, fakeapic.Deleted(posts)); diff != "" {
+		t.Errorf("e.Update(...): only the DHCP server that is no longer desired should be deleted: -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dnsprofile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/fabric/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const profilePath = "/api/node/mo/uni/fabric/dnsp-default.json"
+
+// children are the providers and the domains of the DNS profile default, not
+// sorted like the APIC does not sort them.
+const children = `{"totalCount":"3","imdata":[
+{"dnsProv":{"attributes":{"dn":"uni/fabric/dnsp-default/prov-[10.0.0.2]","addr":"10.0.0.2","preferred":"no"}}},
+{"dnsProv":{"attributes":{"dn":"uni/fabric/dnsp-default/prov-[10.0.0.1]","addr":"10.0.0.1","preferred":"yes"}}},
+{"dnsDomain":{"attributes":{"dn":"uni/fabric/dnsp-default/dom-example.com","name":"example.com","isDefault":"yes"}}}]}`
+
+func dnsProfile(providers ...v1alpha1.DNSProvider) *v1alpha1.DNSProfile {
+	return &v1alpha1.DNSProfile{Spec: v1alpha1.DNSProfileSpec{ForProvider: v1alpha1.DNSProfileParameters{
+		Name:          "default",
+		ManagementEPG: "oob-default",
+		Providers:     providers,
+		Domains:       []v1alpha1.DNSDomain{{Name: "example.com", IsDefault: "yes"}},
+	}}}
+}
+
+// apic returns a fake APIC with the DNS profile default and its children.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet(profilePath, `{"totalCount":"1","imdata":[{"dnsProfile":{"attributes":{"dn":"uni/fabric/dnsp-default","name":"default","descr":"","nameAlias":""}}}]}`)
+	s.RespondChildren(profilePath, children)
+	s.RespondGet("/api/node/mo/uni/fabric/dnsp-default/rsProfileToEpg.json",
+		`{"totalCount":"1","imdata":[{"dnsRsProfileToEpg":{"attributes":{"dn":"uni/fabric/dnsp-default/rsProfileToEpg","tDn":"uni/tn-mgmt/mgmtp-default/oob-default"}}}]}`)
+	return s
+}
+
+var (
+	primary   = v1alpha1.DNSProvider{Address: "10.0.0.1", Preferred: "yes"}
+	secondary = v1alpha1.DNSProvider{Address: "10.0.0.2", Preferred: "no"}
+)
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotDNSProfile": {
+			reason: "An error should be returned if the managed resource is not a DNSProfile",
+			want: want{
+				err: errors.New(errNotDNSProfile),
+			},
+		},
+		"UpToDate": {
+			reason: "The providers should be compared whatever their order",
+			mg:     dnsProfile(secondary, primary),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"ProviderRemoved": {
+			reason: "A provider that is no longer desired should be drift",
+			mg:     dnsProfile(primary),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"ProviderChanged": {
+			reason: "A provider with other attributes should be drift",
+			mg:     dnsProfile(primary, v1alpha1.DNSProvider{Address: "10.0.0.2", Preferred: "yes"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		saved   []string
+		deleted []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.DNSProfile
+		want   want
+	}{
+		"ProviderRemoved": {
+			reason: "Only the provider that is no longer desired should be deleted",
+			mg:     dnsProfile(primary),
+			want: want{
+				saved:   []string{"uni/fabric/dnsp-default/prov-[10.0.0.1]"},
+				deleted: []string{"uni/fabric/dnsp-default/prov-[10.0.0.2]"},
+			},
+		},
+		"ProviderAdded": {
+			reason: "A new provider should be created without deleting the others",
+			mg:     dnsProfile(primary, secondary, v1alpha1.DNSProvider{Address: "10.0.0.3", Preferred: "no"}),
+			want: want{
+				saved: []string{
+					"uni/fabric/dnsp-default/prov-[10.0.0.1]",
+					"uni/fabric/dnsp-default/prov-[10.0.0.2]",
+					"uni/fabric/dnsp-default/prov-[10.0.0.3]",
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): %s", tc.reason, err)
+			}
+			posts := s.Posts()
+			if diff := cmp.Diff(tc.want.saved, fakeapic.Saved(posts, "dnsProv")); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want saved, +got saved:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, fakeapic.Deleted(posts)); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
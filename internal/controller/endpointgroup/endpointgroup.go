@@ -34,12 +34,15 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
-	"github.com/ciscoecosystem/aci-go-client/v2/models"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/jgomezve/provider-aci/apis/application-management/v1alpha1"
 	apisv1alpha1 "github.com/jgomezve/provider-aci/apis/v1alpha1"
 	endpointgrouputil "github.com/jgomezve/provider-aci/internal/clients/endpointgroup"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+	securitydomainutil "github.com/jgomezve/provider-aci/internal/clients/securitydomain"
+	"github.com/jgomezve/provider-aci/internal/clients/snapshot"
 	"github.com/jgomezve/provider-aci/internal/features"
+	"github.com/jgomezve/provider-aci/internal/subscription"
 )
 
 const (
@@ -49,6 +52,9 @@ const (
 	errGetCreds         = "cannot get credentials"
 
 	errNewClient = "cannot create new Service"
+
+	errSnapshot    = "cannot snapshot tenant before change"
+	errInvalidUSeg = "invalid uSeg criteria"
 )
 
 // A NoOpService does nothing.
@@ -67,23 +73,26 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.EndpointGroupGroupVersionKind),
 		managed.WithExternalConnecter(&connector{
 			kube:         mgr.GetClient(),
 			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			recorder:     recorder,
 			newServiceFn: aciclient.NewClient}),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...))
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		WithEventFilter(resource.DesiredStateChanged()).
-		For(&v1alpha1.EndpointGroup{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		For(&v1alpha1.EndpointGroup{})
+	return subscription.Complete(mgr, o, b, v1alpha1.EndpointGroupGroupVersionKind, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
 
 // A connector is expected to produce an ExternalClient when its Connect method
@@ -91,6 +100,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube         client.Client
 	usage        resource.Tracker
+	recorder     event.Recorder
 	newServiceFn func(clientUrl, username string, options ...aciclient.Option) *aciclient.Client
 }
 
@@ -139,7 +149,7 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
-	return &external{apicClient: svc}, nil
+	return &external{apicClient: svc, snapshotter: snapshot.NewSnapshotter(svc, c.kube, c.recorder, pc)}, nil
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
@@ -148,6 +158,9 @@ type external struct {
 	// A 'client' used to connect to the external resource API. In practice this
 	// would be something like an AWS SDK client.
 	apicClient *aciclient.Client
+	// snapshotter takes a snapshot of the tenant before destructive changes,
+	// if enabled in the ProviderConfig.
+	snapshotter *snapshot.Snapshotter
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -156,25 +169,18 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotEndpointGroup)
 	}
 
-	dn := fmt.Sprintf("uni/tn-%s/ap-%s/epg-%s", cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.ApplicationProfile, cr.Name)
-	fvAEPgCont, err := c.apicClient.Get(dn)
-
+	fvAEPg, err := mo.Read(c.apicClient, endpointgrouputil.EndpointGroupDn(cr), endpointgrouputil.FvAEPgClassName)
 	if err != nil {
-		if fmt.Sprintf("%s", err) != "Error retrieving Object: Object may not exist" {
-			return managed.ExternalObservation{}, err
-		}
+		return managed.ExternalObservation{}, err
 	}
-	if count := models.G(fvAEPgCont, "totalCount"); count == "0" {
+	if fvAEPg == nil {
 		return managed.ExternalObservation{}, nil
 	}
-	fvAEPg := models.ApplicationEPGFromContainer(fvAEPgCont)
-
-	if fvAEPg.DistinguishedName == "" {
-		return managed.ExternalObservation{}, fmt.Errorf("endpoint group domain %s not found", dn)
-	}
 	// LateInitializer not required for ACI
 
 	cr.SetConditions(xpv1.Available())
+
+	cr.Status.AtProvider.Dn = fvAEPg["dn"]
 	return managed.ExternalObservation{
 		// // Return false when the external resource does not exist. This lets
 		// // the managed resource reconciler know that it needs to call Create to
@@ -184,7 +190,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		// // Return false when the external resource exists, but it not up to date
 		// // with the desired managed resource state. This lets the managed
 		// // resource reconciler know that it needs to call Update.
-		ResourceUpToDate: endpointgrouputil.IsUptoDate(c.apicClient, cr, fvAEPg.ApplicationEPGAttributes),
+		ResourceUpToDate: endpointgrouputil.IsUptoDate(c.apicClient, cr, fvAEPg),
 
 		// // Return any details that may be required to connect to the external
 		// // resource. These will be stored as the connection secret.
@@ -201,16 +207,24 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	fmt.Printf("Creating: %+v", cr)
 	cr.SetConditions(xpv1.Creating())
 
-	fvAEPgAttr := models.ApplicationEPGAttributes{}
-	fvAEPgAttr.PrefGrMemb = cr.Spec.ForProvider.PreferedGroup
-	fvAEPg := models.NewApplicationEPG(fmt.Sprintf("epg-%s", cr.Name), fmt.Sprintf("uni/tn-%s/ap-%s", cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.ApplicationProfile), "", fvAEPgAttr)
-	err := c.apicClient.Save(fvAEPg)
-	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, "Cannot create Endpoint Group")
+	if err := endpointgrouputil.ValidateUSeg(cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errInvalidUSeg)
 	}
-	err = c.apicClient.CreateRelationfvRsBdFromApplicationEPG(fvAEPg.DistinguishedName, cr.Spec.ForProvider.BridgeDomain)
-	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, "Cannot create association with Bridge Domain")
+	// A renamed or moved EPG is a new EPG for the APIC. The EPG it was is
+	// deleted first, so that it is not left behind without an owner.
+	if stale := endpointgrouputil.StaleDn(cr); stale != "" {
+		if err := c.snapshotter.Take(ctx, cr, endpointgrouputil.Tenant(stale), fmt.Sprintf("deleting %s", stale)); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errSnapshot)
+		}
+		if err := c.apicClient.DeleteByDn(stale, endpointgrouputil.FvAEPgClassName); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, "Cannot delete renamed Endpoint Group")
+		}
+	}
+	// The EPG is created in a single transaction with its bridge domain
+	// association and all its other children, so that no partial EPG is
+	// left behind if any of them is rejected.
+	if err := mo.PostTree(c.apicClient, endpointgrouputil.EndpointGroupDn(cr), endpointgrouputil.NewTree(cr)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "Cannot create Endpoint Group")
 	}
 
 	return managed.ExternalCreation{
@@ -227,18 +241,34 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	fmt.Printf("Updating: %+v", cr)
-	fvAEPgAttr := models.ApplicationEPGAttributes{}
-	fvAEPgAttr.PrefGrMemb = cr.Spec.ForProvider.PreferedGroup
-	fvAEPg := models.NewApplicationEPG(fmt.Sprintf("epg-%s", cr.Name), fmt.Sprintf("uni/tn-%s/ap-%s", cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.ApplicationProfile), "", fvAEPgAttr)
+	if err := endpointgrouputil.ValidateUSeg(cr.Spec.ForProvider); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errInvalidUSeg)
+	}
+	dn := endpointgrouputil.EndpointGroupDn(cr)
+	if endpointgrouputil.BridgeDomainName(c.apicClient, dn) != cr.Spec.ForProvider.BridgeDomain || !endpointgrouputil.RelationsUpToDate(c.apicClient, dn, cr.Spec.ForProvider) {
+		if err := c.snapshotter.Take(ctx, cr, cr.Spec.ForProvider.Tenant, fmt.Sprintf("changing the bridge domain or the relations of %s", dn)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSnapshot)
+		}
+	}
+	fvAEPg := endpointgrouputil.NewEndpointGroup(cr)
 	fvAEPg.Status = "modified"
 	err := c.apicClient.Save(fvAEPg)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot Update Endpoint Group")
 	}
-	err = c.apicClient.CreateRelationfvRsBdFromApplicationEPG(fvAEPg.DistinguishedName, cr.Spec.ForProvider.BridgeDomain)
+	err = c.apicClient.CreateRelationfvRsBdFromApplicationEPG(fvAEPg.Dn, cr.Spec.ForProvider.BridgeDomain)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot update association with Bridge Domain")
 	}
+	if err := endpointgrouputil.ReconcileRelations(c.apicClient, fvAEPg.Dn, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot update Endpoint Group relations")
+	}
+	if err := endpointgrouputil.ReconcileUSegCriteria(c.apicClient, fvAEPg.Dn, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot update uSeg criteria")
+	}
+	if err := securitydomainutil.ReconcileDomainRefs(c.apicClient, fvAEPg.Dn, cr.Spec.ForProvider.SecurityDomains); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot update Endpoint Group security domains")
+	}
 	return managed.ExternalUpdate{
 		// Optionally return any details that may be required to connect to the
 		// external resource. These will be stored as the connection secret.
@@ -253,8 +283,11 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 	}
 
 	cr.SetConditions(xpv1.Deleting())
-	dn := fmt.Sprintf("uni/tn-%s/ap-%s/epg-%s", cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.ApplicationProfile, cr.Name)
-	err := c.apicClient.DeleteByDn(dn, "fvAEPg")
+	dn := endpointgrouputil.EndpointGroupDn(cr)
+	if err := c.snapshotter.Take(ctx, cr, cr.Spec.ForProvider.Tenant, fmt.Sprintf("deleting %s", dn)); err != nil {
+		return errors.Wrap(err, errSnapshot)
+	}
+	err := c.apicClient.DeleteByDn(dn, endpointgrouputil.FvAEPgClassName)
 	if err != nil {
 		return err
 	}
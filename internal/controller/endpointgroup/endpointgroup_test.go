@@ -19,12 +19,21 @@ package endpointgroup
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/application-management/v1alpha1"
+	apisv1alpha1 "github.com/jgomezve/provider-aci/apis/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+	"github.com/jgomezve/provider-aci/internal/clients/snapshot"
 )
 
 // Unlike many Kubernetes projects Crossplane does not use third party testing
@@ -35,39 +44,414 @@ import (
 // https://github.com/golang/go/wiki/TestComments
 // https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
 
+const (
+	epgPath = "/api/node/mo/uni/tn-crossplane/ap-shop/epg-web.json"
+	bdPath  = "/api/node/class/uni/tn-crossplane/ap-shop/epg-web/fvRsBd.json"
+	crtrnDn = "uni/tn-crossplane/ap-shop/epg-web/crtrn"
+	fvRsBd  = `{"totalCount":"1","imdata":[{"fvRsBd":{"attributes":{"dn":"uni/tn-crossplane/ap-shop/epg-web/rsbd","tDn":"uni/tn-crossplane/BD-web"}}}]}`
+)
+
+// endpointGroup returns the EndpointGroup web of the application profile shop
+// with the supplied parameters.
+func endpointGroup(p v1alpha1.EndpointGroupParameters) *v1alpha1.EndpointGroup {
+	p.Tenant, p.ApplicationProfile, p.BridgeDomain = "crossplane", "shop", "web"
+	return &v1alpha1.EndpointGroup{ObjectMeta: metav1.ObjectMeta{Name: "web"}, Spec: v1alpha1.EndpointGroupSpec{ForProvider: p}}
+}
+
 func TestObserve(t *testing.T) {
-	type fields struct {
-		// service interface{}
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		// criteria are the uSeg criteria of the EPG, if any.
+		criteria string
+		want     want
+	}{
+		"NotEndpointGroup": {
+			reason: "An error should be returned if the managed resource is not a EndpointGroup",
+			want: want{
+				err: errors.New(errNotEndpointGroup),
+			},
+		},
+		"StaleUSegCriteria": {
+			reason:   "An EPG with uSeg criteria should not be up to date if the EndpointGroup sets none",
+			mg:       endpointGroup(v1alpha1.EndpointGroupParameters{Description: "shop"}),
+			criteria: `{"totalCount":"1","imdata":[{"fvCrtrn":{"attributes":{"dn":"uni/tn-crossplane/ap-shop/epg-web/crtrn","match":"any","prec":"0"}}}]}`,
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"DefaultName": {
+			reason: "The observed name of the EPG should match the name of the EndpointGroup if none is set",
+			mg:     endpointGroup(v1alpha1.EndpointGroupParameters{Description: "shop"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"Name": {
+			reason: "The observed name of the EPG should match the name set",
+			mg:     endpointGroup(v1alpha1.EndpointGroupParameters{Name: "web", Description: "shop"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"Drifted": {
+			reason: "An EPG whose attributes differ from the EndpointGroup should not be up to date",
+			mg:     endpointGroup(v1alpha1.EndpointGroupParameters{Description: "web shop"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apic := fakeapic.New()
+			defer apic.Close()
+			apic.RespondGet(epgPath, `{"totalCount":"1","imdata":[{"fvAEPg":{"attributes":{"dn":"uni/tn-crossplane/ap-shop/epg-web","name":"web","descr":"shop"}}}]}`)
+			apic.RespondGet(bdPath, fvRsBd)
+			if tc.criteria != "" {
+				apic.RespondGet("/api/node/mo/"+crtrnDn+".json", tc.criteria)
+			}
+
+			e := external{apicClient: apic.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
 	}
+}
 
+func TestCreate(t *testing.T) {
 	type args struct {
 		ctx context.Context
 		mg  resource.Managed
 	}
 
 	type want struct {
-		o   managed.ExternalObservation
+		o   managed.ExternalCreation
 		err error
 	}
 
 	cases := map[string]struct {
 		reason string
-		fields fields
 		args   args
 		want   want
 	}{
-		// TODO: Add test cases.
+		"NotEndpointGroup": {
+			reason: "An error should be returned if the managed resource is not a EndpointGroup",
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				err: errors.New(errNotEndpointGroup),
+			},
+		},
+		"USegCriteriaOnBaseEPG": {
+			reason: "An error should be returned if uSeg criteria are set on an EPG that is not attribute based",
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.EndpointGroup{Spec: v1alpha1.EndpointGroupSpec{ForProvider: v1alpha1.EndpointGroupParameters{
+					Name:           "web",
+					IsAttrBasedEPg: "no",
+					USegCriteria:   &v1alpha1.USegCriteria{Match: "any"},
+				}}},
+			},
+			want: want{
+				err: errors.Wrap(errors.New("uSeg criteria require isAttrBasedEPg to be yes"), errInvalidUSeg),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
 			e := external{apicClient: nil}
-			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			got, err := e.Create(tc.args.ctx, tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
 			}
 			if diff := cmp.Diff(tc.want.o, got); diff != "" {
-				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreateAtomic(t *testing.T) {
+	type args struct {
+		mg   *v1alpha1.EndpointGroup
+		fail string
+	}
+
+	type want struct {
+		err          error
+		bridgeDomain string
+		children     []string
+	}
+
+	epg := &v1alpha1.EndpointGroup{Spec: v1alpha1.EndpointGroupSpec{ForProvider: v1alpha1.EndpointGroupParameters{
+		Name:               "web",
+		Tenant:             "crossplane",
+		ApplicationProfile: "shop",
+		BridgeDomain:       "frontend",
+		IsAttrBasedEPg:     "no",
+	}}}
+	withChildren := epg.DeepCopy()
+	withChildren.Spec.ForProvider.IsAttrBasedEPg = "yes"
+	withChildren.Spec.ForProvider.IntraEpgContracts = []string{"deny"}
+	withChildren.Spec.ForProvider.USegCriteria = &v1alpha1.USegCriteria{Match: "any", IPAttributes: []v1alpha1.USegIPAttribute{{Name: "web", IP: "10.0.0.1"}}}
+	withChildren.Spec.ForProvider.SecurityDomains = []string{"web"}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Created": {
+			reason: "The EPG should be created with its bridge domain association and its relations in a single POST",
+			args: args{
+				mg: epg.DeepCopy(),
+			},
+			want: want{
+				bridgeDomain: "frontend",
+				children:     []string{"fvRsAEPgMonPol", "fvRsBd"},
+			},
+		},
+		"CreatedWithChildren": {
+			reason: "The intra EPG contracts, uSeg criteria and security domains of the EPG should be created in the same POST",
+			args: args{
+				mg: withChildren,
+			},
+			want: want{
+				bridgeDomain: "frontend",
+				children:     []string{"aaaDomainRef", "fvCrtrn", "fvRsAEPgMonPol", "fvRsBd", "fvRsIntraEpg"},
+			},
+		},
+		"BridgeDomainAssociationFails": {
+			reason: "Nothing else should be created if the APIC rejects the EPG with its bridge domain association",
+			args: args{
+				mg:   epg.DeepCopy(),
+				fail: "Invalid bridge domain frontend",
+			},
+			want: want{
+				err:          errors.Wrap(errors.New("Invalid bridge domain frontend"), "Cannot create Endpoint Group"),
+				bridgeDomain: "frontend",
+				children:     []string{"fvRsAEPgMonPol", "fvRsBd"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apic := fakeapic.New()
+			defer apic.Close()
+			if tc.args.fail != "" {
+				apic.FailPost("/api/node/mo/uni/tn-crossplane/ap-shop/epg-web.json", tc.args.fail)
+			}
+
+			e := external{apicClient: apic.APICClient()}
+			_, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			posts := apic.Posts()
+			if len(posts) != 1 {
+				t.Fatalf("\n%s\ne.Create(...): want 1 POST, got %d", tc.reason, len(posts))
+			}
+			if diff := cmp.Diff(tc.want.bridgeDomain, fakeapic.ChildAttribute(posts[0].Body, "fvAEPg", "fvRsBd", "tnFvBDName")); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want bridge domain, +got bridge domain:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.children, fakeapic.ChildClasses(posts[0].Body, "fvAEPg")); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want children, +got children:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreateRenamed(t *testing.T) {
+	epg := func(observedDn string) *v1alpha1.EndpointGroup {
+		cr := &v1alpha1.EndpointGroup{Spec: v1alpha1.EndpointGroupSpec{ForProvider: v1alpha1.EndpointGroupParameters{
+			Name:               "web",
+			Tenant:             "crossplane",
+			ApplicationProfile: "shop",
+			BridgeDomain:       "frontend",
+		}}}
+		cr.Status.AtProvider.Dn = observedDn
+		return cr
+	}
+
+	cases := map[string]struct {
+		reason  string
+		mg      *v1alpha1.EndpointGroup
+		deleted []string
+	}{
+		"NeverObserved": {
+			reason: "Nothing should be deleted when an EPG is created for the first time",
+			mg:     epg(""),
+		},
+		"Recreated": {
+			reason: "Nothing should be deleted when an EPG deleted from the APIC is created again",
+			mg:     epg("uni/tn-crossplane/ap-shop/epg-web"),
+		},
+		"Renamed": {
+			reason:  "The EPG the EndpointGroup had before it was renamed should be deleted",
+			mg:      epg("uni/tn-crossplane/ap-shop/epg-frontend"),
+			deleted: []string{"uni/tn-crossplane/ap-shop/epg-frontend"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apic := fakeapic.New()
+			defer apic.Close()
+
+			e := external{apicClient: apic.APICClient()}
+			if _, err := e.Create(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\n%s\ne.Create(...): %s", tc.reason, err)
+			}
+			posts := apic.Posts()
+			if diff := cmp.Diff([]string{"uni/tn-crossplane/ap-shop/epg-web"}, fakeapic.Saved(posts, "fvAEPg")); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want created, +got created:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.deleted, fakeapic.Deleted(posts)); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdateUSegCriteria(t *testing.T) {
+	type want struct {
+		// status is the status of the uSeg criteria posted.
+		status string
+		match  string
+	}
+
+	cases := map[string]struct {
+		reason string
+		p      v1alpha1.EndpointGroupParameters
+		want   want
+	}{
+		"NoUSegCriteria": {
+			reason: "The uSeg criteria of the EPG should be deleted if the EndpointGroup sets none",
+			want:   want{status: "deleted"},
+		},
+		"USegCriteria": {
+			reason: "The uSeg criteria of the EndpointGroup should be saved",
+			p:      v1alpha1.EndpointGroupParameters{IsAttrBasedEPg: "yes", USegCriteria: &v1alpha1.USegCriteria{Match: "all"}},
+			want:   want{status: "created, modified", match: "all"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apic := fakeapic.New()
+			defer apic.Close()
+			apic.RespondGet(bdPath, fvRsBd)
+
+			e := external{apicClient: apic.APICClient()}
+			if _, err := e.Update(context.Background(), endpointGroup(tc.p)); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): %s", tc.reason, err)
+			}
+			var got *want
+			for _, p := range apic.Posts() {
+				if fakeapic.Attribute(p.Body, "fvCrtrn", "dn") == crtrnDn {
+					got = &want{status: fakeapic.Attribute(p.Body, "fvCrtrn", "status"), match: fakeapic.Attribute(p.Body, "fvCrtrn", "match")}
+				}
+			}
+			if got == nil {
+				t.Fatalf("\n%s\ne.Update(...): no uSeg criteria posted", tc.reason)
+			}
+			if *got != tc.want {
+				t.Errorf("\n%s\ne.Update(...): want uSeg criteria %+v, got %+v", tc.reason, tc.want, *got)
+			}
+		})
+	}
+}
+
+// exports returns how many tenant snapshots were triggered by the supplied
+// POSTs.
+func exports(posts []fakeapic.Request) int {
+	n := 0
+	for _, p := range posts {
+		if fakeapic.Attribute(p.Body, "configExportP", "adminSt") == "triggered" {
+			n++
+		}
+	}
+	return n
+}
+
+// changed returns whether the supplied POSTs changed the EPG.
+func changed(posts []fakeapic.Request) bool {
+	for _, p := range posts {
+		if fakeapic.Attribute(p.Body, "fvAEPg", "dn") != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		exports int
+		// changed is whether the EPG was changed before the snapshot
+		// completed.
+		changed bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		p      v1alpha1.EndpointGroupParameters
+		want   want
+	}{
+		"RelationsUpToDate": {
+			reason: "No snapshot should be taken if the bridge domain and the relations of the EPG would not change",
+			want:   want{changed: true},
+		},
+		"IntraEpgContractChanged": {
+			reason: "A snapshot should be taken before the intra EPG contracts of the EPG are changed",
+			p:      v1alpha1.EndpointGroupParameters{IntraEpgContracts: []string{"deny"}},
+			want:   want{exports: 1},
+		},
+		"MonitoringPolicyChanged": {
+			reason: "A snapshot should be taken before the monitoring policy of the EPG is changed",
+			p:      v1alpha1.EndpointGroupParameters{MonitoringPolicy: "strict"},
+			want:   want{exports: 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apic := fakeapic.New()
+			defer apic.Close()
+			apic.RespondGet(bdPath, fvRsBd)
+
+			kube := &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}
+			pc := &apisv1alpha1.ProviderConfig{Spec: apisv1alpha1.ProviderConfigSpec{Snapshot: &apisv1alpha1.SnapshotConfig{BeforeChanges: true}}}
+			e := external{
+				apicClient:  apic.APICClient(),
+				snapshotter: snapshot.NewSnapshotter(apic.APICClient(), kube, event.NewNopRecorder(), pc),
+			}
+
+			// The fake APIC never completes a snapshot, the change has to
+			// wait for it until the reconcile times out.
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			_, _ = e.Update(ctx, endpointGroup(tc.p))
+			posts := apic.Posts()
+			if got := exports(posts); got != tc.want.exports {
+				t.Errorf("\n%s\ne.Update(...): want %d snapshots, got %d", tc.reason, tc.want.exports, got)
+			}
+			if got := changed(posts); got != tc.want.changed {
+				t.Errorf("\n%s\ne.Update(...): want EPG changed %t, got %t", tc.reason, tc.want.changed, got)
 			}
 		})
 	}
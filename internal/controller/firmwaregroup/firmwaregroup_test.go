@@ -0,0 +1,185 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firmwaregroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const groupPath = "/api/node/mo/uni/fabric/fwgrp-spines.json"
+
+func firmwareGroup(version string, blocks ...v1alpha1.NodeBlock) *v1alpha1.FirmwareGroup {
+	return &v1alpha1.FirmwareGroup{Spec: v1alpha1.FirmwareGroupSpec{ForProvider: v1alpha1.FirmwareGroupParameters{
+		Name:                "spines",
+		Version:             version,
+		IgnoreCompatibility: "no",
+		NodeBlocks:          blocks,
+	}}}
+}
+
+// apic returns a fake APIC with the firmware group spines of the nodes 201 to
+// 202 and 211, pointed to its policy of the version n9000-15.2(7f). The
+// relation to the policy is only there if related is true.
+func apic(related bool) *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet(groupPath, `{"totalCount":"1","imdata":[{"firmwareFwGrp":{"attributes":{"dn":"uni/fabric/fwgrp-spines","name":"spines","descr":"","type":"range"}}}]}`)
+	s.RespondChildren(groupPath, `{"totalCount":"2","imdata":[
+{"fabricNodeBlk":{"attributes":{"dn":"uni/fabric/fwgrp-spines/nodeblk-b","name":"b","from_":"211","to_":"211"}}},
+{"fabricNodeBlk":{"attributes":{"dn":"uni/fabric/fwgrp-spines/nodeblk-a","name":"a","from_":"201","to_":"202"}}}]}`)
+	s.RespondGet("/api/node/mo/uni/fabric/fwpol-spines.json",
+		`{"totalCount":"1","imdata":[{"firmwareFwP":{"attributes":{"dn":"uni/fabric/fwpol-spines","name":"spines","version":"n9000-15.2(7f)","ignoreCompat":"no"}}}]}`)
+	if related {
+		s.RespondGet("/api/node/mo/uni/fabric/fwgrp-spines/rsfwgrpp.json",
+			`{"totalCount":"1","imdata":[{"firmwareRsFwgrpp":{"attributes":{"dn":"uni/fabric/fwgrp-spines/rsfwgrpp","tnFirmwareFwPName":"spines"}}}]}`)
+	}
+	return s
+}
+
+var (
+	a = v1alpha1.NodeBlock{Name: "a", From: 201, To: 202}
+	b = v1alpha1.NodeBlock{Name: "b", From: 211, To: 211}
+)
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		mg      resource.Managed
+		related bool
+		want    want
+	}{
+		"NotFirmwareGroup": {
+			reason: "An error should be returned if the managed resource is not a FirmwareGroup",
+			want: want{
+				err: errors.New(errNotFirmwareGroup),
+			},
+		},
+		"UpToDate": {
+			reason:  "The node blocks should be compared whatever their order",
+			mg:      firmwareGroup("n9000-15.2(7f)", b, a),
+			related: true,
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"VersionChanged": {
+			reason:  "A new target version should be drift",
+			mg:      firmwareGroup("n9000-16.0(3d)", a, b),
+			related: true,
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"NodeBlockRemoved": {
+			reason:  "A node block that is no longer desired should be drift",
+			mg:      firmwareGroup("n9000-15.2(7f)", a),
+			related: true,
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"PolicyNotRelated": {
+			reason: "A group that does not point to its policy should not be up to date",
+			mg:     firmwareGroup("n9000-15.2(7f)", a, b),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic(tc.related)
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	s := apic(true)
+	defer s.Close()
+
+	e := external{apicClient: s.APICClient()}
+	if _, err := e.Update(context.Background(), firmwareGroup("n9000-16.0(3d)", a)); err != nil {
+		t.Fatalf("e.Update(...): %s", err)
+	}
+	posts := s.Posts()
+	var version, policy string
+	for _, p := range posts {
+		if v := fakeapic.Attribute(p.Body, "firmwareFwP", "version"); v != "" {
+			version = v
+		}
+		if v := fakeapic.Attribute(p.Body, "firmwareRsFwgrpp", "tnFirmwareFwPName"); v != "" {
+			policy = v
+		}
+	}
+	if version != "n9000-16.0(3d)" {
+		t.Errorf("e.Update(...): want the policy version n9000-16.0(3d), got %q", version)
+	}
+	if policy != "spines" {
+		t.Errorf("e.Update(...): want the group pointed to the policy spines, got %q", policy)
+	}
+	if diff := cmp.Diff([]string{"uni/fabric/fwgrp-spines/nodeblk-b"}, fakeapic.Deleted(posts)); diff != "" {
+		t.Errorf("e.Update(...): only the node block that is no longer desired should be deleted: -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	s := apic(true)
+	defer s.Close()
+
+	e := external{apicClient: s.APICClient()}
+	if err := e.Delete(context.Background(), firmwareGroup("n9000-15.2(7f)", a, b)); err != nil {
+		t.Fatalf("e.Delete(...): %s", err)
+	}
+	want := []string{"uni/fabric/fwgrp-spines", "uni/fabric/fwpol-spines"}
+	if diff := cmp.Diff(want, fakeapic.Deleted(s.Posts())); diff != "" {
+		t.Errorf("e.Delete(...): the group and its policy should be deleted: -want, +got:\n%s\n", diff)
+	}
+}
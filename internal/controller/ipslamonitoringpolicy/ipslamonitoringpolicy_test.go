@@ -0,0 +1,112 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipslamonitoringpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/services/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+func ipslaMonitoringPolicy(tenant, frequency string) *v1alpha1.IPSLAMonitoringPolicy {
+	return &v1alpha1.IPSLAMonitoringPolicy{Spec: v1alpha1.IPSLAMonitoringPolicySpec{ForProvider: v1alpha1.IPSLAMonitoringPolicyParameters{
+		Name:             "icmp",
+		Tenant:           tenant,
+		SlaType:          "icmp",
+		SlaPort:          "0",
+		SlaFrequency:     frequency,
+		DetectMultiplier: "3",
+		Threshold:        "900",
+		Timeout:          "900",
+	}}}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotIPSLAMonitoringPolicy": {
+			reason: "An error should be returned if the managed resource is not a IPSLAMonitoringPolicy",
+			want: want{
+				err: errors.New(errNotIPSLAMonitoringPolicy),
+			},
+		},
+		"UpToDate": {
+			reason: "A policy probing at the desired frequency should be up to date",
+			mg:     ipslaMonitoringPolicy("web", "60"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"FrequencyChanged": {
+			reason: "A policy probing at another frequency should be drift",
+			mg:     ipslaMonitoringPolicy("web", "30"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"OtherTenant": {
+			reason: "The policy of another tenant is another object that does not exist",
+			mg:     ipslaMonitoringPolicy("db", "60"),
+			want: want{
+				o: managed.ExternalObservation{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := fakeapic.New()
+			defer s.Close()
+			s.RespondGet("/api/node/mo/uni/tn-web/ipslaMonitoringPol-icmp.json",
+				`{"totalCount":"1","imdata":[{"fvIPSLAMonitoringPol":{"attributes":{"dn":"uni/tn-web/ipslaMonitoringPol-icmp","name":"icmp","descr":"","slaType":"icmp","slaPort":"0","slaFrequency":"60","slaDetectMultiplier":"3","threshold":"900","timeout":"900"}}}]}`)
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
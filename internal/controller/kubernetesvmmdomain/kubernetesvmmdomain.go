@@ -0,0 +1,364 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesvmmdomain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/ciscoecosystem/aci-go-client/v2/models"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	apisv1alpha1 "github.com/jgomezve/provider-aci/apis/v1alpha1"
+	"github.com/jgomezve/provider-aci/apis/vmm/v1alpha1"
+	kubernetesvmmdomainutil "github.com/jgomezve/provider-aci/internal/clients/kubernetesvmmdomain"
+	vmmdomainutil "github.com/jgomezve/provider-aci/internal/clients/vmmdomain"
+	"github.com/jgomezve/provider-aci/internal/features"
+	"github.com/jgomezve/provider-aci/internal/subscription"
+)
+
+const (
+	errNotKubernetesVMMDomain = "managed resource is not a KubernetesVMMDomain custom resource"
+	errTrackPCUsage           = "cannot track ProviderConfig usage"
+	errGetPC                  = "cannot get ProviderConfig"
+	errGetCreds               = "cannot get credentials"
+
+	errNewClient = "cannot create new Service"
+)
+
+// A NoOpService does nothing.
+type NoOpService struct{}
+
+// var (
+// 	newNoOpService = func(_ []byte) (interface{}, error) { return &NoOpService{}, nil }
+// )
+
+// Setup adds a controller that reconciles KubernetesVMMDomain managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.KubernetesVMMDomainGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.KubernetesVMMDomainGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: aciclient.NewClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.KubernetesVMMDomain{})
+	return subscription.Complete(mgr, o, b, v1alpha1.KubernetesVMMDomainGroupVersionKind, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(clientUrl, username string, options ...aciclient.Option) *aciclient.Client
+}
+
+type SecretData struct {
+	Url      string `json:"url"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Insecure bool   `json:"insecure"`
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+
+	var secretData SecretData
+	cr, ok := mg.(*v1alpha1.KubernetesVMMDomain)
+	if !ok {
+		return nil, errors.New(errNotKubernetesVMMDomain)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	err = json.Unmarshal(data, &secretData)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := c.newServiceFn(secretData.Url, secretData.Username, aciclient.Password(secretData.Password), aciclient.Insecure(secretData.Insecure))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{apicClient: svc}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	apicClient *aciclient.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.KubernetesVMMDomain)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotKubernetesVMMDomain)
+	}
+
+	dn := kubernetesvmmdomainutil.DomainDn(cr.Spec.ForProvider.Name)
+	vmmDomPCont, err := c.apicClient.Get(dn)
+
+	if err != nil {
+		if fmt.Sprintf("%s", err) != "Error retrieving Object: Object may not exist" {
+			return managed.ExternalObservation{}, err
+		}
+	}
+	if count := models.G(vmmDomPCont, "totalCount"); count == "0" {
+		return managed.ExternalObservation{}, nil
+	}
+	vmmDomP := models.VMMDomainFromContainer(vmmDomPCont)
+
+	if vmmDomP.DistinguishedName == "" {
+		return managed.ExternalObservation{}, fmt.Errorf("Kubernetes VMM domain %s not found", dn)
+	}
+	// LateInitializer not required for ACI
+
+	cr.SetConditions(xpv1.Available())
+
+	cr.Status.AtProvider.Dn = vmmDomP.DistinguishedName
+	return managed.ExternalObservation{
+		// // Return false when the external resource does not exist. This lets
+		// // the managed resource reconciler know that it needs to call Create to
+		// // (re)create the resource, or that it has successfully been deleted.
+		ResourceExists: true,
+
+		// // Return false when the external resource exists, but it not up to date
+		// // with the desired managed resource state. This lets the managed
+		// // resource reconciler know that it needs to call Update.
+		ResourceUpToDate: kubernetesvmmdomainutil.IsUptoDate(c.apicClient, cr, vmmDomP.VMMDomainAttributes),
+
+		// // Return any details that may be required to connect to the external
+		// // resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.KubernetesVMMDomain)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotKubernetesVMMDomain)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	vmmDomP := newKubernetesVMMDomain(cr)
+	err := c.apicClient.Save(vmmDomP)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "Cannot create Kubernetes VMM Domain")
+	}
+	if err := c.saveChildren(vmmDomP.DistinguishedName, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	return managed.ExternalCreation{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.KubernetesVMMDomain)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotKubernetesVMMDomain)
+	}
+
+	vmmDomP := newKubernetesVMMDomain(cr)
+	vmmDomP.Status = "modified"
+	err := c.apicClient.Save(vmmDomP)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot update Kubernetes VMM Domain")
+	}
+	if err := c.saveChildren(vmmDomP.DistinguishedName, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.KubernetesVMMDomain)
+	if !ok {
+		return errors.New(errNotKubernetesVMMDomain)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	for _, subnetDn := range []string{
+		kubernetesvmmdomainutil.SubnetDn(cr.Spec.ForProvider.NodeSubnet),
+		kubernetesvmmdomainutil.SubnetDn(cr.Spec.ForProvider.ServiceSubnet),
+		cr.Status.AtProvider.NodeSubnetDn,
+		cr.Status.AtProvider.ServiceSubnetDn,
+	} {
+		if err := c.deleteSubnet(subnetDn); err != nil {
+			return err
+		}
+	}
+	dn := kubernetesvmmdomainutil.DomainDn(cr.Spec.ForProvider.Name)
+	err := c.apicClient.DeleteByDn(dn, "vmmDomP")
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func newKubernetesVMMDomain(cr *v1alpha1.KubernetesVMMDomain) *models.VMMDomain {
+	vmmDomPAttr := models.VMMDomainAttributes{}
+	vmmDomPAttr.Name = cr.Spec.ForProvider.Name
+	vmmDomPAttr.NameAlias = cr.Spec.ForProvider.NameAlias
+	vmmDomPAttr.Mode = "k8s"
+	vmmDomPAttr.EnfPref = "sw"
+	vmmDomPAttr.EncapMode = cr.Spec.ForProvider.EncapMode
+	vmmDomPAttr.PrefEncapMode = cr.Spec.ForProvider.EncapMode
+	vmmDomPAttr.McastAddr = cr.Spec.ForProvider.MulticastAddress
+	return models.NewVMMDomain(fmt.Sprintf("dom-%s", cr.Spec.ForProvider.Name), "uni/vmmp-Kubernetes", vmmDomPAttr)
+}
+
+func newKubernetesVMMController(domainDn string, cr *v1alpha1.KubernetesVMMDomain) *models.VMMController {
+	vmmCtrlrPAttr := models.VMMControllerAttributes{}
+	vmmCtrlrPAttr.Name = cr.Spec.ForProvider.Controller.Name
+	vmmCtrlrPAttr.HostOrIp = cr.Spec.ForProvider.Controller.HostOrIP
+	vmmCtrlrPAttr.RootContName = cr.Spec.ForProvider.Controller.ClusterName
+	vmmCtrlrPAttr.Mode = "k8s"
+	vmmCtrlrPAttr.Scope = "kubernetes"
+	return models.NewVMMController(fmt.Sprintf("ctrlr-%s", cr.Spec.ForProvider.Controller.Name), domainDn, "", vmmCtrlrPAttr)
+}
+
+// saveChildren converges the pool associations, the controller and the
+// cluster subnets of the Kubernetes VMM domain with the supplied DN.
+func (c *external) saveChildren(dn string, cr *v1alpha1.KubernetesVMMDomain) error {
+	if cr.Spec.ForProvider.VlanPool != "" {
+		err := c.apicClient.CreateRelationinfraRsVlanNsFromVMMDomain(dn, vmmdomainutil.VlanPoolDn(cr.Spec.ForProvider.VlanPool, cr.Spec.ForProvider.VlanPoolAllocationMode))
+		if err != nil {
+			return errors.Wrap(err, "Cannot create association with VLAN Pool")
+		}
+	} else {
+		err := c.apicClient.DeleteRelationinfraRsVlanNsFromVMMDomain(dn)
+		if err != nil {
+			return errors.Wrap(err, "Cannot remove association with VLAN Pool")
+		}
+	}
+	if cr.Spec.ForProvider.MulticastPool != "" {
+		err := c.apicClient.CreateRelationvmmRsDomMcastAddrNsFromVMMDomain(dn, kubernetesvmmdomainutil.MulticastPoolDn(cr.Spec.ForProvider.MulticastPool))
+		if err != nil {
+			return errors.Wrap(err, "Cannot create association with Multicast Address Pool")
+		}
+	} else {
+		err := c.apicClient.DeleteRelationvmmRsDomMcastAddrNsFromVMMDomain(dn)
+		if err != nil {
+			return errors.Wrap(err, "Cannot remove association with Multicast Address Pool")
+		}
+	}
+	err := c.apicClient.Save(newKubernetesVMMController(dn, cr))
+	if err != nil {
+		return errors.Wrap(err, "Cannot save Kubernetes VMM Controller")
+	}
+
+	nodeSubnetDn, err := c.saveSubnet(cr.Spec.ForProvider.NodeSubnet, cr.Status.AtProvider.NodeSubnetDn)
+	if err != nil {
+		return errors.Wrap(err, "Cannot save node subnet")
+	}
+	cr.Status.AtProvider.NodeSubnetDn = nodeSubnetDn
+	serviceSubnetDn, err := c.saveSubnet(cr.Spec.ForProvider.ServiceSubnet, cr.Status.AtProvider.ServiceSubnetDn)
+	if err != nil {
+		return errors.Wrap(err, "Cannot save service subnet")
+	}
+	cr.Status.AtProvider.ServiceSubnetDn = serviceSubnetDn
+	return nil
+}
+
+// saveSubnet creates the desired cluster subnet, removes the one recorded
+// with lastDn if it is no longer desired and returns the DN of the subnet.
+func (c *external) saveSubnet(desired *v1alpha1.ClusterSubnet, lastDn string) (string, error) {
+	if kubernetesvmmdomainutil.IsStale(lastDn, desired) {
+		if err := c.deleteSubnet(lastDn); err != nil {
+			return lastDn, err
+		}
+	}
+	if desired == nil {
+		return "", nil
+	}
+	fvSubnetAttr := models.SubnetAttributes{}
+	fvSubnetAttr.Ip = desired.Gateway
+	fvSubnetAttr.Scope = "public"
+	fvSubnet := models.NewSubnet(fmt.Sprintf("subnet-[%s]", desired.Gateway), fmt.Sprintf("uni/tn-%s/BD-%s", desired.Tenant, desired.BridgeDomain), "", fvSubnetAttr)
+	if err := c.apicClient.Save(fvSubnet); err != nil {
+		return lastDn, err
+	}
+	return fvSubnet.DistinguishedName, nil
+}
+
+func (c *external) deleteSubnet(dn string) error {
+	if dn == "" {
+		return nil
+	}
+	exists, err := kubernetesvmmdomainutil.Exists(c.apicClient, dn)
+	if err != nil || !exists {
+		return err
+	}
+	return c.apicClient.DeleteByDn(dn, models.FvsubnetClassName)
+}
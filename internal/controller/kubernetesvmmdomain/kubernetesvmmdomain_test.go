@@ -0,0 +1,192 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesvmmdomain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/vmm/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	nodeSubnetDn = "uni/tn-k8s/BD-nodes/subnet-[10.1.0.1/16]"
+	oldSubnetDn  = "uni/tn-k8s/BD-nodes/subnet-[10.0.0.1/16]"
+)
+
+var nodeSubnet = &v1alpha1.ClusterSubnet{Tenant: "k8s", BridgeDomain: "nodes", Gateway: "10.1.0.1/16"}
+
+// kubernetesVMMDomain returns the Kubernetes VMM domain k8s with the supplied
+// node subnet, which recorded the supplied node subnet DN when last
+// reconciled.
+func kubernetesVMMDomain(subnet *v1alpha1.ClusterSubnet, lastSubnetDn string) *v1alpha1.KubernetesVMMDomain {
+	return &v1alpha1.KubernetesVMMDomain{
+		Spec: v1alpha1.KubernetesVMMDomainSpec{ForProvider: v1alpha1.KubernetesVMMDomainParameters{
+			Name:                   "k8s",
+			EncapMode:              "vxlan",
+			VlanPoolAllocationMode: "dynamic",
+			Controller:             v1alpha1.KubernetesVMMController{Name: "k8s", HostOrIP: "10.1.0.10", ClusterName: "k8s"},
+			NodeSubnet:             subnet,
+		}},
+		Status: v1alpha1.KubernetesVMMDomainStatus{AtProvider: v1alpha1.KubernetesVMMDomainObservation{NodeSubnetDn: lastSubnetDn}},
+	}
+}
+
+// apic returns a fake APIC with the Kubernetes VMM domain k8s, its controller
+// and the supplied subnets.
+func apic(subnetDns ...string) *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet("/api/node/mo/uni/vmmp-Kubernetes/dom-k8s.json",
+		`{"totalCount":"1","imdata":[{"vmmDomP":{"attributes":{"dn":"uni/vmmp-Kubernetes/dom-k8s","name":"k8s","nameAlias":"","encapMode":"vxlan","mcastAddr":""}}}]}`)
+	s.RespondGet("/api/node/mo/uni/vmmp-Kubernetes/dom-k8s/ctrlr-k8s.json",
+		`{"totalCount":"1","imdata":[{"vmmCtrlrP":{"attributes":{"dn":"uni/vmmp-Kubernetes/dom-k8s/ctrlr-k8s","name":"k8s","hostOrIp":"10.1.0.10","rootContName":"k8s"}}}]}`)
+	for _, dn := range subnetDns {
+		s.RespondGet("/api/node/mo/"+dn+".json", `{"totalCount":"1","imdata":[{"fvSubnet":{"attributes":{"dn":"`+dn+`"}}}]}`)
+	}
+	return s
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		mg      resource.Managed
+		subnets []string
+		want    want
+	}{
+		"NotKubernetesVMMDomain": {
+			reason: "An error should be returned if the managed resource is not a KubernetesVMMDomain",
+			want: want{
+				err: errors.New(errNotKubernetesVMMDomain),
+			},
+		},
+		"UpToDate": {
+			reason:  "A Kubernetes VMM domain with its controller and node subnet should be up to date",
+			mg:      kubernetesVMMDomain(nodeSubnet, nodeSubnetDn),
+			subnets: []string{nodeSubnetDn},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"SubnetMissing": {
+			reason: "A Kubernetes VMM domain whose node subnet does not exist should not be up to date",
+			mg:     kubernetesVMMDomain(nodeSubnet, nodeSubnetDn),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"SubnetStale": {
+			reason:  "A Kubernetes VMM domain whose previous node subnet has to be removed should not be up to date",
+			mg:      kubernetesVMMDomain(nodeSubnet, oldSubnetDn),
+			subnets: []string{nodeSubnetDn, oldSubnetDn},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic(tc.subnets...)
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		subnetDn string
+		deleted  []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.KubernetesVMMDomain
+		want   want
+	}{
+		"SubnetMoved": {
+			reason: "The previous node subnet should be deleted and the new one recorded",
+			mg:     kubernetesVMMDomain(nodeSubnet, oldSubnetDn),
+			want: want{
+				subnetDn: nodeSubnetDn,
+				deleted: []string{
+					oldSubnetDn,
+					"uni/vmmp-Kubernetes/dom-k8s/rsdomMcastAddrNs",
+					"uni/vmmp-Kubernetes/dom-k8s/rsvlanNs",
+				},
+			},
+		},
+		"SubnetRemoved": {
+			reason: "The node subnet should be deleted and forgotten if none is desired",
+			mg:     kubernetesVMMDomain(nil, nodeSubnetDn),
+			want: want{
+				deleted: []string{
+					nodeSubnetDn,
+					"uni/vmmp-Kubernetes/dom-k8s/rsdomMcastAddrNs",
+					"uni/vmmp-Kubernetes/dom-k8s/rsvlanNs",
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic(nodeSubnetDn, oldSubnetDn)
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.subnetDn, tc.mg.Status.AtProvider.NodeSubnetDn); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want node subnet DN, +got node subnet DN:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, fakeapic.Deleted(s.Posts())); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package l4l7device
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/services/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	deviceDn = "uni/tn-web/lDevVip-asa"
+	cIfDn    = deviceDn + "/cDev-asa1/cIf-[eth1/1]"
+	lIfDn    = deviceDn + "/lIf-inside"
+	pathDn   = "topology/pod-1/paths-101/pathep-[eth1/10]"
+)
+
+// l4l7Device returns the physical device asa whose logical interface inside
+// uses the concrete interface eth1/1 of asa1, attached to the supplied path.
+func l4l7Device(path string, concreteInterfaces ...string) *v1alpha1.L4L7Device {
+	return &v1alpha1.L4L7Device{Spec: v1alpha1.L4L7DeviceSpec{ForProvider: v1alpha1.L4L7DeviceParameters{
+		Name:         "asa",
+		Tenant:       "web",
+		DeviceType:   "PHYSICAL",
+		ServiceType:  "FW",
+		FunctionType: "GoTo",
+		ContextAware: "single-Context",
+		Domain:       "services",
+		ConcreteDevices: []v1alpha1.ConcreteDevice{{
+			Name:       "asa1",
+			Interfaces: []v1alpha1.ConcreteInterface{{Name: "eth1/1", PathDn: path}},
+		}},
+		LogicalInterfaces: []v1alpha1.LogicalInterface{{
+			Name:               "inside",
+			Encap:              "vlan-10",
+			ConcreteInterfaces: concreteInterfaces,
+		}},
+	}}}
+}
+
+// apic returns a fake APIC with the device of l4l7Device(pathDn, "asa1/eth1/1").
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet("/api/node/mo/"+deviceDn+".json",
+		`{"totalCount":"1","imdata":[{"vnsLDevVip":{"attributes":{"dn":"`+deviceDn+`","name":"asa","descr":"","devtype":"PHYSICAL","svcType":"FW","funcType":"GoTo","contextAware":"single-Context","managed":"no"}}}]}`)
+	s.RespondGet("/api/node/mo/"+deviceDn+"/rsALDevToPhysDomP.json",
+		`{"totalCount":"1","imdata":[{"vnsRsALDevToPhysDomP":{"attributes":{"dn":"`+deviceDn+`/rsALDevToPhysDomP","tDn":"uni/phys-services"}}}]}`)
+	s.RespondChildren("/api/node/mo/"+deviceDn+".json", `{"totalCount":"2","imdata":[
+{"vnsCDev":{"attributes":{"dn":"`+deviceDn+`/cDev-asa1","name":"asa1","vmName":"","vcenterName":""}}},
+{"vnsLIf":{"attributes":{"dn":"`+lIfDn+`","name":"inside","encap":"vlan-10"}}}]}`)
+	s.RespondChildren("/api/node/mo/"+deviceDn+"/cDev-asa1.json",
+		`{"totalCount":"1","imdata":[{"vnsCIf":{"attributes":{"dn":"`+cIfDn+`","name":"eth1/1","vnicName":""}}}]}`)
+	s.RespondGet("/api/node/mo/"+cIfDn+"/rsCIfPathAtt.json",
+		`{"totalCount":"1","imdata":[{"vnsRsCIfPathAtt":{"attributes":{"dn":"`+cIfDn+`/rsCIfPathAtt","tDn":"`+pathDn+`"}}}]}`)
+	s.RespondChildren("/api/node/mo/"+lIfDn+".json",
+		`{"totalCount":"1","imdata":[{"vnsRsCIfAttN":{"attributes":{"dn":"`+lIfDn+`/rsCIfAttN-[`+cIfDn+`]","tDn":"`+cIfDn+`"}}}]}`)
+	return s
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotL4L7Device": {
+			reason: "An error should be returned if the managed resource is not a L4L7Device",
+			want: want{
+				err: errors.New(errNotL4L7Device),
+			},
+		},
+		"UpToDate": {
+			reason: "The concrete interfaces of the logical interfaces should be read back in the device/interface form",
+			mg:     l4l7Device(pathDn, "asa1/eth1/1"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"PathChanged": {
+			reason: "A concrete interface attached to another path should be drift",
+			mg:     l4l7Device("topology/pod-1/paths-102/pathep-[eth1/10]", "asa1/eth1/1"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"ConcreteInterfaceRemoved": {
+			reason: "A logical interface that no longer uses a concrete interface should be drift",
+			mg:     l4l7Device(pathDn),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.L4L7Device
+		err    error
+		posted bool
+	}{
+		"Created": {
+			reason: "A device whose logical interfaces use its own concrete interfaces should be created",
+			mg:     l4l7Device(pathDn, "asa1/eth1/1"),
+			posted: true,
+		},
+		"UnknownConcreteInterface": {
+			reason: "A device whose logical interface uses an unknown concrete interface should be rejected before any POST",
+			mg:     l4l7Device(pathDn, "asa2/eth1/1"),
+			err:    errors.Wrap(errors.New("logical interface inside references unknown concrete interface asa2/eth1/1"), errInvalidDevice),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := fakeapic.New()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if posted := len(s.Posts()) > 0; posted != tc.posted {
+				t.Errorf("\n%s\ne.Create(...): want posted %t, got %t", tc.reason, tc.posted, posted)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	s := apic()
+	defer s.Close()
+
+	e := external{apicClient: s.APICClient()}
+	if _, err := e.Update(context.Background(), l4l7Device(pathDn)); err != nil {
+		t.Fatalf("e.Update(...): %s", err)
+	}
+	want := []string{lIfDn + "/rsCIfAttN-[" + cIfDn + "]", deviceDn + "/rsALDevToDomP"}
+	if diff := cmp.Diff(want, fakeapic.Deleted(s.Posts())); diff != "" {
+		t.Errorf("e.Update(...): the unused concrete interface and the VMM domain of a physical device should be deleted: -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ldapprovider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	apisv1alpha1 "github.com/jgomezve/provider-aci/apis/v1alpha1"
+	ldapproviderutil "github.com/jgomezve/provider-aci/internal/clients/ldapprovider"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+	"github.com/jgomezve/provider-aci/internal/clients/secretversion"
+	"github.com/jgomezve/provider-aci/internal/features"
+	"github.com/jgomezve/provider-aci/internal/subscription"
+)
+
+const (
+	errNotLDAPProvider = "managed resource is not a LDAPProvider custom resource"
+	errTrackPCUsage    = "cannot track ProviderConfig usage"
+	errGetPC           = "cannot get ProviderConfig"
+	errGetCreds        = "cannot get credentials"
+
+	errNewClient = "cannot create new Service"
+
+	errGetBindPassword           = "cannot get bind password"
+	errRecordBindPasswordVersion = "cannot record bind password version"
+)
+
+// A NoOpService does nothing.
+type NoOpService struct{}
+
+// var (
+// 	newNoOpService = func(_ []byte) (interface{}, error) { return &NoOpService{}, nil }
+// )
+
+// Setup adds a controller that reconciles LDAPProvider managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.LDAPProviderGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.LDAPProviderGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: aciclient.NewClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.LDAPProvider{})
+	return subscription.Complete(mgr, o, b, v1alpha1.LDAPProviderGroupVersionKind, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(clientUrl, username string, options ...aciclient.Option) *aciclient.Client
+}
+
+type SecretData struct {
+	Url      string `json:"url"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Insecure bool   `json:"insecure"`
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+
+	var secretData SecretData
+	cr, ok := mg.(*v1alpha1.LDAPProvider)
+	if !ok {
+		return nil, errors.New(errNotLDAPProvider)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	err = json.Unmarshal(data, &secretData)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := c.newServiceFn(secretData.Url, secretData.Username, aciclient.Password(secretData.Password), aciclient.Insecure(secretData.Insecure))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{apicClient: svc, kube: c.kube}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	apicClient *aciclient.Client
+	// The bind password is read from a Secret.
+	kube client.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LDAPProvider)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotLDAPProvider)
+	}
+
+	dn := ldapproviderutil.ProviderDn(cr.Spec.ForProvider.Name)
+	aaaLdapProvider, err := mo.Read(c.apicClient, dn, ldapproviderutil.AaaLdapProviderClassName)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if aaaLdapProvider == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	// LateInitializer not required for ACI
+
+	cr.SetConditions(xpv1.Available())
+
+	_, version, err := c.secret(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.Status.AtProvider.Dn = aaaLdapProvider["dn"]
+	return managed.ExternalObservation{
+		// // Return false when the external resource does not exist. This lets
+		// // the managed resource reconciler know that it needs to call Create to
+		// // (re)create the resource, or that it has successfully been deleted.
+		ResourceExists: true,
+
+		// // Return false when the external resource exists, but it not up to date
+		// // with the desired managed resource state. This lets the managed
+		// // resource reconciler know that it needs to call Update.
+		ResourceUpToDate: ldapproviderutil.IsUptoDate(c.apicClient, cr, aaaLdapProvider) && !secretversion.Changed(cr, ldapproviderutil.AnnotationKeyBindPasswordVersion, version),
+
+		// // Return any details that may be required to connect to the external
+		// // resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.LDAPProvider)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotLDAPProvider)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	secret, version, err := c.secret(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	aaaLdapProvider := ldapproviderutil.NewProvider(cr.Spec.ForProvider, string(secret))
+	err = c.apicClient.Save(aaaLdapProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "Cannot create LDAP Provider")
+	}
+	secretversion.Record(cr, ldapproviderutil.AnnotationKeyBindPasswordVersion, version)
+	if err := ldapproviderutil.SaveManagementEPG(c.apicClient, aaaLdapProvider.Dn, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "Cannot update LDAP Provider management EPG")
+	}
+
+	return managed.ExternalCreation{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.LDAPProvider)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotLDAPProvider)
+	}
+
+	secret, version, err := c.secret(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	// The bind password is only pushed when the secret changed.
+	changed := secretversion.Changed(cr, ldapproviderutil.AnnotationKeyBindPasswordVersion, version)
+	value := ""
+	if changed {
+		value = string(secret)
+	}
+	aaaLdapProvider := ldapproviderutil.NewProvider(cr.Spec.ForProvider, value)
+	aaaLdapProvider.Status = "modified"
+	err = c.apicClient.Save(aaaLdapProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot update LDAP Provider")
+	}
+	if changed {
+		if err := secretversion.Persist(ctx, c.kube, cr, ldapproviderutil.AnnotationKeyBindPasswordVersion, version); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRecordBindPasswordVersion)
+		}
+	}
+	if err := ldapproviderutil.SaveManagementEPG(c.apicClient, aaaLdapProvider.Dn, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot update LDAP Provider management EPG")
+	}
+
+	return managed.ExternalUpdate{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.LDAPProvider)
+	if !ok {
+		return errors.New(errNotLDAPProvider)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	err := c.apicClient.DeleteByDn(ldapproviderutil.ProviderDn(cr.Spec.ForProvider.Name), ldapproviderutil.AaaLdapProviderClassName)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// secret returns the bind password of the provider and the version of its
+// Secret, or nothing if the provider has no bind password.
+func (c *external) secret(ctx context.Context, cr *v1alpha1.LDAPProvider) ([]byte, string, error) {
+	if cr.Spec.ForProvider.BindPasswordSecretRef == nil {
+		return nil, "", nil
+	}
+	secret, version, err := secretversion.Read(ctx, c.kube, *cr.Spec.ForProvider.BindPasswordSecretRef)
+	return secret, version, errors.Wrap(err, errGetBindPassword)
+}
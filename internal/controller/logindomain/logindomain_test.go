@@ -0,0 +1,158 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logindomain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const domainDn = "uni/userext/logindomain-corp"
+
+func loginDomain(realm, providerGroup string) *v1alpha1.LoginDomain {
+	return &v1alpha1.LoginDomain{Spec: v1alpha1.LoginDomainSpec{ForProvider: v1alpha1.LoginDomainParameters{
+		Name:          "corp",
+		Realm:         realm,
+		ProviderGroup: providerGroup,
+	}}}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotLoginDomain": {
+			reason: "An error should be returned if the managed resource is not a LoginDomain",
+			want: want{
+				err: errors.New(errNotLoginDomain),
+			},
+		},
+		"UpToDate": {
+			reason: "The realm and provider group of the domain should match",
+			mg:     loginDomain("ldap", "corp-ldap"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"RealmChanged": {
+			reason: "A domain authenticated by another realm should be drift",
+			mg:     loginDomain("radius", "corp-radius"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := fakeapic.New()
+			defer s.Close()
+			s.RespondGet("/api/node/mo/"+domainDn+".json",
+				`{"totalCount":"1","imdata":[{"aaaLoginDomain":{"attributes":{"dn":"`+domainDn+`","name":"corp","descr":"","nameAlias":""}}}]}`)
+			s.RespondGet("/api/node/mo/"+domainDn+"/domainauth.json",
+				`{"totalCount":"1","imdata":[{"aaaDomainAuth":{"attributes":{"dn":"`+domainDn+`/domainauth","realm":"ldap","providerGroup":"corp-ldap"}}}]}`)
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NotLoginDomain": {
+			reason: "An error should be returned if the managed resource is not a LoginDomain",
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				err: errors.New(errNotLoginDomain),
+			},
+		},
+		"NoProviderGroup": {
+			reason: "An error should be returned if a remote realm has no provider group",
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.LoginDomain{Spec: v1alpha1.LoginDomainSpec{ForProvider: v1alpha1.LoginDomainParameters{
+					Name:  "corp",
+					Realm: "ldap",
+				}}},
+			},
+			want: want{
+				err: errors.Wrap(errors.New("a provider group is required for the ldap realm"), errInvalidRealm),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{apicClient: nil}
+			got, err := e.Create(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenancegroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+	maintenancegrouputil "github.com/jgomezve/provider-aci/internal/clients/maintenancegroup"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	groupPath  = "/api/node/mo/uni/fabric/maintgrp-spines.json"
+	policyPath = "/api/node/mo/uni/fabric/maintpol-spines.json"
+	policyDn   = "uni/fabric/maintpol-spines"
+
+	relationPath = "/api/node/mo/uni/fabric/maintgrp-spines/rsmgrpp.json"
+
+	group    = `{"totalCount":"1","imdata":[{"maintMaintGrp":{"attributes":{"dn":"uni/fabric/maintgrp-spines","name":"spines","descr":""}}}]}`
+	policy   = `{"totalCount":"1","imdata":[{"maintMaintP":{"attributes":{"dn":"uni/fabric/maintpol-spines","name":"spines","version":"n9000-15.2(7f)"}}}]}`
+	relation = `{"totalCount":"1","imdata":[{"maintRsMgrpp":{"attributes":{"dn":"uni/fabric/maintgrp-spines/rsmgrpp","tnMaintMaintPName":"spines"}}}]}`
+)
+
+// maintenanceGroup returns a MaintenanceGroup with the supplied trigger, the
+// supplied recorded trigger annotation, if any, and the supplied trigger in
+// its status.
+func maintenanceGroup(trigger, annotated, status string) *v1alpha1.MaintenanceGroup {
+	cr := &v1alpha1.MaintenanceGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "spines"},
+		Spec: v1alpha1.MaintenanceGroupSpec{ForProvider: v1alpha1.MaintenanceGroupParameters{
+			Name:    "spines",
+			Version: "n9000-15.2(7f)",
+			Trigger: trigger,
+		}},
+	}
+	if annotated != "" {
+		cr.SetAnnotations(map[string]string{maintenancegrouputil.AnnotationKeyLastTrigger: annotated})
+	}
+	cr.Status.AtProvider.LastTrigger = status
+	return cr
+}
+
+// triggered returns whether any of the supplied POSTs triggered the
+// maintenance policy.
+func triggered(posts []fakeapic.Request) bool {
+	for _, p := range posts {
+		if fakeapic.Attribute(p.Body, maintenancegrouputil.MaintMaintPClassName, "dn") == policyDn &&
+			fakeapic.Attribute(p.Body, maintenancegrouputil.MaintMaintPClassName, "adminSt") == "triggered" {
+			return true
+		}
+	}
+	return false
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		lastTrigger string
+		upToDate    bool
+		err         error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotMaintenanceGroup": {
+			reason: "An error should be returned if the managed resource is not a MaintenanceGroup",
+			want: want{
+				err: errors.New(errNotMaintenanceGroup),
+			},
+		},
+		"StatusLost": {
+			reason: "The last trigger should be read from the annotation if the status was lost, and not be pending",
+			mg:     maintenanceGroup("v1", "v1", ""),
+			want: want{
+				lastTrigger: "v1",
+				upToDate:    true,
+			},
+		},
+		"TriggerPending": {
+			reason: "A new trigger should make the group not up to date",
+			mg:     maintenanceGroup("v2", "v1", "v1"),
+			want: want{
+				lastTrigger: "v1",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apic := fakeapic.New()
+			defer apic.Close()
+			apic.RespondGet(groupPath, group)
+			apic.RespondGet(policyPath, policy)
+			apic.RespondGet(relationPath, relation)
+
+			e := external{apicClient: apic.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			if !got.ResourceExists || got.ResourceUpToDate != tc.want.upToDate {
+				t.Errorf("\n%s\ne.Observe(...): want exists and up to date %t, got %+v", tc.reason, tc.want.upToDate, got)
+			}
+			cr := tc.mg.(*v1alpha1.MaintenanceGroup)
+			if diff := cmp.Diff(tc.want.lastTrigger, cr.Status.AtProvider.LastTrigger); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want last trigger, +got last trigger:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		recorded  string
+		triggered bool
+		err       error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.MaintenanceGroup
+		update error
+		want   want
+	}{
+		"Resync": {
+			reason: "An update with an unchanged trigger should not start the upgrade again",
+			mg:     maintenanceGroup("v1", "v1", "v1"),
+		},
+		"StatusLost": {
+			reason: "An update with the trigger recorded in the annotation should not start the upgrade again, even if the status was lost",
+			mg:     maintenanceGroup("v1", "v1", ""),
+		},
+		"LegacyStatus": {
+			reason: "An update with the trigger recorded in the status only should not start the upgrade again",
+			mg:     maintenanceGroup("v1", "", "v1"),
+		},
+		"NewTrigger": {
+			reason: "A new trigger should be recorded before the upgrade is started",
+			mg:     maintenanceGroup("v2", "v1", "v1"),
+			want: want{
+				recorded:  "v2",
+				triggered: true,
+			},
+		},
+		"RecordFailed": {
+			reason: "The upgrade should not be started if the trigger cannot be recorded",
+			mg:     maintenanceGroup("v2", "v1", "v1"),
+			update: errBoom,
+			want: want{
+				err: errors.Wrap(errBoom, errRecordTrigger),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apic := fakeapic.New()
+			defer apic.Close()
+
+			recorded := ""
+			kube := &test.MockClient{MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+				if len(apic.Posts()) > 0 {
+					t.Errorf("\n%s\ne.Update(...): the trigger should be recorded before any change", tc.reason)
+				}
+				recorded = obj.GetAnnotations()[maintenancegrouputil.AnnotationKeyLastTrigger]
+				return tc.update
+			}}
+
+			e := external{apicClient: apic.APICClient(), kube: kube}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if tc.update == nil && recorded != tc.want.recorded {
+				t.Errorf("\n%s\ne.Update(...): want recorded trigger %q, got %q", tc.reason, tc.want.recorded, recorded)
+			}
+			if got := triggered(apic.Posts()); got != tc.want.triggered {
+				t.Errorf("\n%s\ne.Update(...): want triggered %t, got %t", tc.reason, tc.want.triggered, got)
+			}
+		})
+	}
+}
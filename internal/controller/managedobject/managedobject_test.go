@@ -0,0 +1,193 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedobject
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/rest/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const apDn = "uni/tn-web/ap-shop"
+
+// applicationProfile returns a ManagedObject of the application profile shop
+// with the supplied description and children.
+func applicationProfile(descr string, children ...v1alpha1.ManagedObjectChild) *v1alpha1.ManagedObject {
+	return &v1alpha1.ManagedObject{Spec: v1alpha1.ManagedObjectSpec{ForProvider: v1alpha1.ManagedObjectParameters{
+		ClassName:  "fvAp",
+		ParentDn:   "uni/tn-web",
+		Rn:         "ap-shop",
+		Attributes: map[string]string{"name": "shop", "descr": descr},
+		Children:   children,
+	}}}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	web := v1alpha1.ManagedObjectChild{ClassName: "fvAEPg", Rn: "epg-web", Attributes: map[string]string{"name": "web"}}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotManagedObject": {
+			reason: "An error should be returned if the managed resource is not a ManagedObject",
+			want: want{
+				err: errors.New(errNotManagedObject),
+			},
+		},
+		"UpToDate": {
+			reason: "Only the desired attributes should be compared, not the ones populated by the APIC",
+			mg:     applicationProfile("online shop", web),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"AttributeChanged": {
+			reason: "Another value of a desired attribute should be drift",
+			mg:     applicationProfile("shop", web),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"ChildMissing": {
+			reason: "A desired child that does not exist should be drift",
+			mg:     applicationProfile("online shop", web, v1alpha1.ManagedObjectChild{ClassName: "fvAEPg", Rn: "epg-db", Attributes: map[string]string{"name": "db"}}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"ChildReadFailed": {
+			reason: "An error should be returned if a desired child cannot be read",
+			mg:     applicationProfile("online shop", web, v1alpha1.ManagedObjectChild{ClassName: "fvAEPg", Rn: "epg-app", Attributes: map[string]string{"name": "app"}}),
+			want: want{
+				err: errors.Wrap(errors.New("Unable to read the EPG"), errReadChildren),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := fakeapic.New()
+			defer s.Close()
+			s.RespondGet("/api/node/mo/"+apDn+".json",
+				`{"totalCount":"1","imdata":[{"fvAp":{"attributes":{"dn":"`+apDn+`","name":"shop","descr":"online shop","prio":"unspecified","uid":"15374"}}}]}`)
+			s.RespondGet("/api/node/mo/"+apDn+"/epg-web.json",
+				`{"totalCount":"1","imdata":[{"fvAEPg":{"attributes":{"dn":"`+apDn+`/epg-web","name":"web","pcEnfPref":"unenforced"}}}]}`)
+			s.FailGet("/api/node/mo/"+apDn+"/epg-app.json", "Unable to read the EPG")
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NotManagedObject": {
+			reason: "An error should be returned if the managed resource is not a ManagedObject",
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				err: errors.New(errNotManagedObject),
+			},
+		},
+		"DnAndRn": {
+			reason: "An error should be returned if both a DN and an RN are set",
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.ManagedObject{Spec: v1alpha1.ManagedObjectSpec{ForProvider: v1alpha1.ManagedObjectParameters{
+					ClassName: "fvTenant",
+					Dn:        "uni/tn-crossplane",
+					Rn:        "tn-crossplane",
+				}}},
+			},
+			want: want{
+				err: errors.Wrap(errors.New("parentDn and rn cannot be set with dn"), errInvalidObject),
+			},
+		},
+		"RnWithoutParentDn": {
+			reason: "An error should be returned if an RN is set without a parent DN",
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.ManagedObject{Spec: v1alpha1.ManagedObjectSpec{ForProvider: v1alpha1.ManagedObjectParameters{
+					ClassName: "fvTenant",
+					Rn:        "tn-crossplane",
+				}}},
+			},
+			want: want{
+				err: errors.Wrap(errors.New("either dn or both parentDn and rn are required"), errInvalidObject),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{apicClient: nil}
+			got, err := e.Create(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package matchrule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const ruleDn = "uni/tn-web/subj-web"
+
+func matchRule(prefixes []string, communities ...string) *v1alpha1.MatchRule {
+	p := v1alpha1.MatchRuleParameters{Name: "web", Tenant: "web"}
+	for _, ip := range prefixes {
+		p.Prefixes = append(p.Prefixes, v1alpha1.PrefixMatch{IP: ip, Aggregate: "no", FromPrefixLength: "0", ToPrefixLength: "0"})
+	}
+	if len(communities) > 0 {
+		term := v1alpha1.CommunityTerm{Name: "customers"}
+		for _, c := range communities {
+			term.Factors = append(term.Factors, v1alpha1.CommunityFactor{Community: c, Scope: "transitive"})
+		}
+		p.CommunityTerms = []v1alpha1.CommunityTerm{term}
+	}
+	return &v1alpha1.MatchRule{Spec: v1alpha1.MatchRuleSpec{ForProvider: p}}
+}
+
+// apic returns a fake APIC with the match rule web, which matches the
+// prefixes 10.0.0.0/8 and 192.168.0.0/16 and the communities of its term
+// customers.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet("/api/node/mo/"+ruleDn+".json",
+		`{"totalCount":"1","imdata":[{"rtctrlSubjP":{"attributes":{"dn":"`+ruleDn+`","name":"web","descr":""}}}]}`)
+	s.RespondChildren("/api/node/mo/"+ruleDn+".json", `{"totalCount":"3","imdata":[
+{"rtctrlMatchRtDest":{"attributes":{"dn":"`+ruleDn+`/dest-[192.168.0.0/16]","ip":"192.168.0.0/16","descr":"","aggregate":"no","fromPfxLen":"0","toPfxLen":"0"}}},
+{"rtctrlMatchRtDest":{"attributes":{"dn":"`+ruleDn+`/dest-[10.0.0.0/8]","ip":"10.0.0.0/8","descr":"","aggregate":"no","fromPfxLen":"0","toPfxLen":"0"}}},
+{"rtctrlMatchCommTerm":{"attributes":{"dn":"`+ruleDn+`/commtrm-customers","name":"customers","descr":""}}}]}`)
+	s.RespondChildren("/api/node/mo/"+ruleDn+"/commtrm-customers.json", `{"totalCount":"2","imdata":[
+{"rtctrlMatchCommFactor":{"attributes":{"dn":"`+ruleDn+`/commtrm-customers/commfct-regular:as2-nn2:65001:200","community":"regular:as2-nn2:65001:200","scope":"transitive"}}},
+{"rtctrlMatchCommFactor":{"attributes":{"dn":"`+ruleDn+`/commtrm-customers/commfct-regular:as2-nn2:65001:100","community":"regular:as2-nn2:65001:100","scope":"transitive"}}}]}`)
+	return s
+}
+
+var prefixes = []string{"10.0.0.0/8", "192.168.0.0/16"}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotMatchRule": {
+			reason: "An error should be returned if the managed resource is not a MatchRule",
+			want: want{
+				err: errors.New(errNotMatchRule),
+			},
+		},
+		"UpToDate": {
+			reason: "The prefixes and communities should match whatever order the APIC returns them in",
+			mg:     matchRule(prefixes, "regular:as2-nn2:65001:100", "regular:as2-nn2:65001:200"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"PrefixRemoved": {
+			reason: "A prefix that is no longer desired should be drift",
+			mg:     matchRule(prefixes[:1], "regular:as2-nn2:65001:100", "regular:as2-nn2:65001:200"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"CommunityRemoved": {
+			reason: "A community that is no longer desired should be drift",
+			mg:     matchRule(prefixes, "regular:as2-nn2:65001:100"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		mg      *v1alpha1.MatchRule
+		deleted []string
+	}{
+		"UpToDate": {
+			reason: "No term should be deleted if all of them are desired",
+			mg:     matchRule(prefixes, "regular:as2-nn2:65001:100", "regular:as2-nn2:65001:200"),
+		},
+		"CommunityRemoved": {
+			reason:  "Only the community removed from the term should be deleted",
+			mg:      matchRule(prefixes, "regular:as2-nn2:65001:100"),
+			deleted: []string{ruleDn + "/commtrm-customers/commfct-regular:as2-nn2:65001:200"},
+		},
+		"TermRemoved": {
+			reason:  "The prefix and the community term that are no longer desired should be deleted",
+			mg:      matchRule(prefixes[1:]),
+			deleted: []string{ruleDn + "/commtrm-customers", ruleDn + "/dest-[10.0.0.0/8]"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.deleted, fakeapic.Deleted(s.Posts())); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package netflowexporterpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/monitoring/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const exporterDn = "uni/tn-web/exporterpol-collector"
+
+func exporterPolicy(vrf string) *v1alpha1.NetflowExporterPolicy {
+	return &v1alpha1.NetflowExporterPolicy{Spec: v1alpha1.NetflowExporterPolicySpec{ForProvider: v1alpha1.NetflowExporterPolicyParameters{
+		Name:               "collector",
+		Tenant:             "web",
+		DestinationAddress: "192.168.1.50",
+		DestinationPort:    "2055",
+		SourceAddress:      "10.0.0.1",
+		Version:            "v9",
+		DSCP:               "CS2",
+		EndpointGroupDn:    "uni/tn-web/ap-monitoring/epg-collectors",
+		Vrf:                vrf,
+	}}}
+}
+
+// apic returns a fake APIC with the exporter policy collector, which exports
+// to a collector of the EPG collectors in the VRF monitoring.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet("/api/node/mo/"+exporterDn+".json",
+		`{"totalCount":"1","imdata":[{"netflowExporterPol":{"attributes":{"dn":"`+exporterDn+`","name":"collector","descr":"","dstAddr":"192.168.1.50","dstPort":"2055","srcAddr":"10.0.0.1","ver":"v9","dscp":"CS2"}}}]}`)
+	s.RespondGet("/api/node/mo/"+exporterDn+"/rsexporterToEPg.json",
+		`{"totalCount":"1","imdata":[{"netflowRsExporterToEPg":{"attributes":{"dn":"`+exporterDn+`/rsexporterToEPg","tDn":"uni/tn-web/ap-monitoring/epg-collectors"}}}]}`)
+	s.RespondGet("/api/node/mo/"+exporterDn+"/rsexporterToCtx.json",
+		`{"totalCount":"1","imdata":[{"netflowRsExporterToCtx":{"attributes":{"dn":"`+exporterDn+`/rsexporterToCtx","tDn":"uni/tn-web/ctx-monitoring"}}}]}`)
+	return s
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotNetflowExporterPolicy": {
+			reason: "An error should be returned if the managed resource is not a NetflowExporterPolicy",
+			want: want{
+				err: errors.New(errNotNetflowExporterPolicy),
+			},
+		},
+		"UpToDate": {
+			reason: "The VRF should be read back by name from the DN of the relation",
+			mg:     exporterPolicy("monitoring"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"VrfRemoved": {
+			reason: "An exporter that is no longer in a VRF should be drift",
+			mg:     exporterPolicy(""),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	s := apic()
+	defer s.Close()
+
+	e := external{apicClient: s.APICClient()}
+	if _, err := e.Update(context.Background(), exporterPolicy("")); err != nil {
+		t.Fatalf("e.Update(...): %s", err)
+	}
+	if diff := cmp.Diff([]string{exporterDn + "/rsexporterToCtx"}, fakeapic.Deleted(s.Posts())); diff != "" {
+		t.Errorf("e.Update(...): the VRF relation should be deleted once the VRF is unset: -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package netflowmonitorpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/monitoring/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const monitorDn = "uni/tn-web/monitorpol-web"
+
+func monitorPolicy(exporters ...string) *v1alpha1.NetflowMonitorPolicy {
+	return &v1alpha1.NetflowMonitorPolicy{Spec: v1alpha1.NetflowMonitorPolicySpec{ForProvider: v1alpha1.NetflowMonitorPolicyParameters{
+		Name:         "web",
+		Tenant:       "web",
+		RecordPolicy: "flows",
+		Exporters:    exporters,
+	}}}
+}
+
+// apic returns a fake APIC with the monitor policy web, which exports the
+// record flows to the exporters primary and backup.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet("/api/node/mo/"+monitorDn+".json",
+		`{"totalCount":"1","imdata":[{"netflowMonitorPol":{"attributes":{"dn":"`+monitorDn+`","name":"web","descr":""}}}]}`)
+	s.RespondGet("/api/node/mo/"+monitorDn+"/rsmonitorToRecord.json",
+		`{"totalCount":"1","imdata":[{"netflowRsMonitorToRecord":{"attributes":{"dn":"`+monitorDn+`/rsmonitorToRecord","tnNetflowRecordPolName":"flows"}}}]}`)
+	s.RespondChildren("/api/node/mo/"+monitorDn+".json", `{"totalCount":"3","imdata":[
+{"netflowRsMonitorToRecord":{"attributes":{"dn":"`+monitorDn+`/rsmonitorToRecord","tnNetflowRecordPolName":"flows"}}},
+{"netflowRsMonitorToExporter":{"attributes":{"dn":"`+monitorDn+`/rsmonitorToExporter-primary","tnNetflowExporterPolName":"primary"}}},
+{"netflowRsMonitorToExporter":{"attributes":{"dn":"`+monitorDn+`/rsmonitorToExporter-backup","tnNetflowExporterPolName":"backup"}}}]}`)
+	return s
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotNetflowMonitorPolicy": {
+			reason: "An error should be returned if the managed resource is not a NetflowMonitorPolicy",
+			want: want{
+				err: errors.New(errNotNetflowMonitorPolicy),
+			},
+		},
+		"UpToDate": {
+			reason: "The exporters should match whatever order the APIC returns them in",
+			mg:     monitorPolicy("backup", "primary"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"ExporterRemoved": {
+			reason: "An exporter that is no longer desired should be drift",
+			mg:     monitorPolicy("primary"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	s := apic()
+	defer s.Close()
+
+	e := external{apicClient: s.APICClient()}
+	if _, err := e.Update(context.Background(), monitorPolicy("primary")); err != nil {
+		t.Fatalf("e.Update(...): %s", err)
+	}
+	if diff := cmp.Diff([]string{monitorDn + "/rsmonitorToExporter-backup"}, fakeapic.Deleted(s.Posts())); diff != "" {
+		t.Errorf("e.Update(...): only the exporter that is no longer desired should be deleted: -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package netflowrecordpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/monitoring/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const recordDn = "uni/tn-web/recordpol-flows"
+
+func recordPolicy(collect, match []string) *v1alpha1.NetflowRecordPolicy {
+	return &v1alpha1.NetflowRecordPolicy{Spec: v1alpha1.NetflowRecordPolicySpec{ForProvider: v1alpha1.NetflowRecordPolicyParameters{
+		Name:    "flows",
+		Tenant:  "web",
+		Collect: collect,
+		Match:   match,
+	}}}
+}
+
+// apic returns a fake APIC with the record policy flows. Like the APIC, it
+// returns the collected and matched fields in its own order.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet("/api/node/mo/"+recordDn+".json",
+		`{"totalCount":"1","imdata":[{"netflowRecordPol":{"attributes":{"dn":"`+recordDn+`","name":"flows","descr":"","collect":"count-bytes,count-pkts","match":"dst-ipv4,src-ipv4"}}}]}`)
+	return s
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotNetflowRecordPolicy": {
+			reason: "An error should be returned if the managed resource is not a NetflowRecordPolicy",
+			want: want{
+				err: errors.New(errNotNetflowRecordPolicy),
+			},
+		},
+		"UpToDate": {
+			reason: "The collected and matched fields should match whatever order they are listed in",
+			mg:     recordPolicy([]string{"count-pkts", "count-bytes"}, []string{"src-ipv4", "dst-ipv4"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"MatchChanged": {
+			reason: "Another matched field should be drift",
+			mg:     recordPolicy([]string{"count-pkts", "count-bytes"}, []string{"src-ipv4", "dst-ipv4", "proto"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	s := apic()
+	defer s.Close()
+
+	e := external{apicClient: s.APICClient()}
+	if _, err := e.Create(context.Background(), recordPolicy([]string{"count-pkts", "count-bytes"}, []string{"src-ipv4"})); err != nil {
+		t.Fatalf("e.Create(...): %s", err)
+	}
+	posts := s.Posts()
+	if len(posts) != 1 {
+		t.Fatalf("e.Create(...): want 1 POST, got %d", len(posts))
+	}
+	if got := fakeapic.Attribute(posts[0].Body, "netflowRecordPol", "collect"); got != "count-pkts,count-bytes" {
+		t.Errorf("e.Create(...): want the collected fields as a comma separated list, got %q", got)
+	}
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodemanagementaddress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/fabric/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const nodeDn = "uni/tn-mgmt/mgmtp-default/oob-default/rsooBStNode-[topology/pod-1/node-101]"
+
+// oobAddress returns the out-of-band NodeManagementAddress of the node 101
+// with the supplied IPv4 gateway.
+func oobAddress(gateway string) *v1alpha1.NodeManagementAddress {
+	return &v1alpha1.NodeManagementAddress{Spec: v1alpha1.NodeManagementAddressSpec{ForProvider: v1alpha1.NodeManagementAddressParameters{
+		NodeID:        101,
+		Pod:           1,
+		Type:          "oob",
+		ManagementEPG: "default",
+		Address:       "10.0.0.11/24",
+		Gateway:       gateway,
+	}}}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotNodeManagementAddress": {
+			reason: "An error should be returned if the managed resource is not a NodeManagementAddress",
+			want: want{
+				err: errors.New(errNotNodeManagementAddress),
+			},
+		},
+		"UpToDate": {
+			reason: "The unspecified IPv6 addresses the APIC reports should match unset ones",
+			mg:     oobAddress("10.0.0.1"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"GatewayChanged": {
+			reason: "Another gateway should be drift",
+			mg:     oobAddress("10.0.0.254"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"InBandNotFound": {
+			reason: "The in-band address of a node with only an out-of-band address should not exist",
+			mg: &v1alpha1.NodeManagementAddress{Spec: v1alpha1.NodeManagementAddressSpec{ForProvider: v1alpha1.NodeManagementAddressParameters{
+				NodeID: 101, Pod: 1, Type: "inb", ManagementEPG: "inband", Address: "10.0.1.11/24", Gateway: "10.0.1.1",
+			}}},
+			want: want{
+				o: managed.ExternalObservation{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := fakeapic.New()
+			defer s.Close()
+			s.RespondGet("/api/node/mo/"+nodeDn+".json",
+				`{"totalCount":"1","imdata":[{"mgmtRsOoBStNode":{"attributes":{"dn":"`+nodeDn+`","tDn":"topology/pod-1/node-101","addr":"10.0.0.11/24","gw":"10.0.0.1","v6Addr":"::","v6Gw":"::"}}}]}`)
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalCreation
+		err error
+	}
+
+	address := func(p v1alpha1.NodeManagementAddressParameters) *v1alpha1.NodeManagementAddress {
+		p.NodeID, p.Pod, p.Type, p.ManagementEPG = 101, 1, "oob", "default"
+		return &v1alpha1.NodeManagementAddress{Spec: v1alpha1.NodeManagementAddressSpec{ForProvider: p}}
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NotNodeManagementAddress": {
+			reason: "An error should be returned if the managed resource is not a NodeManagementAddress",
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				err: errors.New(errNotNodeManagementAddress),
+			},
+		},
+		"NoAddress": {
+			reason: "An error should be returned if neither an IPv4 nor an IPv6 address is set",
+			args: args{
+				ctx: context.Background(),
+				mg:  address(v1alpha1.NodeManagementAddressParameters{}),
+			},
+			want: want{
+				err: errors.Wrap(errors.New("either an IPv4 or an IPv6 address is required"), errInvalidAddress),
+			},
+		},
+		"GatewayOutsideSubnet": {
+			reason: "An error should be returned if the gateway is not in the subnet of the address",
+			args: args{
+				ctx: context.Background(),
+				mg:  address(v1alpha1.NodeManagementAddressParameters{Address: "10.0.0.11/24", Gateway: "10.0.1.1"}),
+			},
+			want: want{
+				err: errors.Wrap(errors.New("gateway 10.0.1.1 is not in the subnet 10.0.0.0/24 of address 10.0.0.11/24"), errInvalidAddress),
+			},
+		},
+		"AddressNotCIDR": {
+			reason: "An error should be returned if the address has no prefix length",
+			args: args{
+				ctx: context.Background(),
+				mg:  address(v1alpha1.NodeManagementAddressParameters{Address: "10.0.0.11", Gateway: "10.0.0.1"}),
+			},
+			want: want{
+				err: errors.Wrap(errors.New("address 10.0.0.11 is not in CIDR notation"), errInvalidAddress),
+			},
+		},
+		"IPv6GatewayOutsideSubnet": {
+			reason: "An error should be returned if the IPv6 gateway is not in the subnet of the IPv6 address",
+			args: args{
+				ctx: context.Background(),
+				mg:  address(v1alpha1.NodeManagementAddressParameters{IPv6Address: "2001:db8::11/64", IPv6Gateway: "2001:db9::1"}),
+			},
+			want: want{
+				err: errors.Wrap(errors.New("gateway 2001:db9::1 is not in the subnet 2001:db8::/64 of address 2001:db8::11/64"), errInvalidAddress),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{apicClient: nil}
+			got, err := e.Create(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ntppolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/fabric/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const policyPath = "/api/node/mo/uni/fabric/time-default.json"
+
+func ntpPolicy(providers ...v1alpha1.NTPProvider) *v1alpha1.NTPPolicy {
+	return &v1alpha1.NTPPolicy{Spec: v1alpha1.NTPPolicySpec{ForProvider: v1alpha1.NTPPolicyParameters{
+		Name:        "default",
+		AdminState:  "enabled",
+		AuthState:   "disabled",
+		ServerState: "disabled",
+		Providers:   providers,
+	}}}
+}
+
+// apic returns a fake APIC with the date and time policy default and its NTP
+// providers a and b, both reached through the out-of-band management EPG.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet(policyPath, `{"totalCount":"1","imdata":[{"datetimePol":{"attributes":{"dn":"uni/fabric/time-default","name":"default","descr":"","nameAlias":"","adminSt":"enabled","authSt":"disabled","serverState":"disabled"}}}]}`)
+	s.RespondChildren(policyPath, `{"totalCount":"2","imdata":[
+{"datetimeNtpProv":{"attributes":{"dn":"uni/fabric/time-default/ntpprov-b","name":"b","preferred":"no","minPoll":"4","maxPoll":"6"}}},
+{"datetimeNtpProv":{"attributes":{"dn":"uni/fabric/time-default/ntpprov-a","name":"a","preferred":"yes","minPoll":"4","maxPoll":"6"}}}]}`)
+	for _, p := range []string{"a", "b"} {
+		dn := "uni/fabric/time-default/ntpprov-" + p + "/rsNtpProvToEpg"
+		s.RespondGet("/api/node/mo/"+dn+".json",
+			`{"totalCount":"1","imdata":[{"datetimeRsNtpProvToEpg":{"attributes":{"dn":"`+dn+`","tDn":"uni/tn-mgmt/mgmtp-default/oob-default"}}}]}`)
+	}
+	return s
+}
+
+var (
+	a = v1alpha1.NTPProvider{Name: "a", Preferred: "yes", MinPoll: "4", MaxPoll: "6", ManagementEPG: "oob-default"}
+	b = v1alpha1.NTPProvider{Name: "b", Preferred: "no", MinPoll: "4", MaxPoll: "6", ManagementEPG: "oob-default"}
+)
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotNTPPolicy": {
+			reason: "An error should be returned if the managed resource is not a NTPPolicy",
+			want: want{
+				err: errors.New(errNotNTPPolicy),
+			},
+		},
+		"UpToDate": {
+			reason: "The providers should be compared whatever their order",
+			mg:     ntpPolicy(b, a),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"ProviderRemoved": {
+			reason: "A provider that is no longer desired should be drift",
+			mg:     ntpPolicy(a),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"ManagementEPGChanged": {
+			reason: "A provider reached through another management EPG should be drift",
+			mg:     ntpPolicy(a, v1alpha1.NTPProvider{Name: "b", Preferred: "no", MinPoll: "4", MaxPoll: "6", ManagementEPG: "inb-default"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		saved   []string
+		deleted []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.NTPPolicy
+		want   want
+	}{
+		"ProviderRemoved": {
+			reason: "Only the provider that is no longer desired should be deleted",
+			mg:     ntpPolicy(a),
+			want: want{
+				saved:   []string{"uni/fabric/time-default/ntpprov-a"},
+				deleted: []string{"uni/fabric/time-default/ntpprov-b"},
+			},
+		},
+		"ManagementEPGRemoved": {
+			reason: "The management EPG relation of a provider should be deleted if none is desired",
+			mg:     ntpPolicy(a, v1alpha1.NTPProvider{Name: "b", Preferred: "no", MinPoll: "4", MaxPoll: "6"}),
+			want: want{
+				saved:   []string{"uni/fabric/time-default/ntpprov-a", "uni/fabric/time-default/ntpprov-b"},
+				deleted: []string{"uni/fabric/time-default/ntpprov-b/rsNtpProvToEpg"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): %s", tc.reason, err)
+			}
+			posts := s.Posts()
+			if diff := cmp.Diff(tc.want.saved, fakeapic.Saved(posts, "datetimeNtpProv")); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want saved, +got saved:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, fakeapic.Deleted(posts)); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package radiusprovider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	apisv1alpha1 "github.com/jgomezve/provider-aci/apis/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+	radiusproviderutil "github.com/jgomezve/provider-aci/internal/clients/radiusprovider"
+	"github.com/jgomezve/provider-aci/internal/clients/secretversion"
+	"github.com/jgomezve/provider-aci/internal/features"
+	"github.com/jgomezve/provider-aci/internal/subscription"
+)
+
+const (
+	errNotRADIUSProvider = "managed resource is not a RADIUSProvider custom resource"
+	errTrackPCUsage      = "cannot track ProviderConfig usage"
+	errGetPC             = "cannot get ProviderConfig"
+	errGetCreds          = "cannot get credentials"
+
+	errNewClient = "cannot create new Service"
+
+	errGetKey           = "cannot get key"
+	errRecordKeyVersion = "cannot record key version"
+)
+
+// A NoOpService does nothing.
+type NoOpService struct{}
+
+// var (
+// 	newNoOpService = func(_ []byte) (interface{}, error) { return &NoOpService{}, nil }
+// )
+
+// Setup adds a controller that reconciles RADIUSProvider managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.RADIUSProviderGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.RADIUSProviderGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: aciclient.NewClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.RADIUSProvider{})
+	return subscription.Complete(mgr, o, b, v1alpha1.RADIUSProviderGroupVersionKind, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(clientUrl, username string, options ...aciclient.Option) *aciclient.Client
+}
+
+type SecretData struct {
+	Url      string `json:"url"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Insecure bool   `json:"insecure"`
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+
+	var secretData SecretData
+	cr, ok := mg.(*v1alpha1.RADIUSProvider)
+	if !ok {
+		return nil, errors.New(errNotRADIUSProvider)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	err = json.Unmarshal(data, &secretData)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := c.newServiceFn(secretData.Url, secretData.Username, aciclient.Password(secretData.Password), aciclient.Insecure(secretData.Insecure))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{apicClient: svc, kube: c.kube}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	apicClient *aciclient.Client
+	// The key is read from a Secret.
+	kube client.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RADIUSProvider)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRADIUSProvider)
+	}
+
+	dn := radiusproviderutil.ProviderDn(cr.Spec.ForProvider.Name)
+	aaaRadiusProvider, err := mo.Read(c.apicClient, dn, radiusproviderutil.AaaRadiusProviderClassName)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if aaaRadiusProvider == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	// LateInitializer not required for ACI
+
+	cr.SetConditions(xpv1.Available())
+
+	_, version, err := c.secret(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.Status.AtProvider.Dn = aaaRadiusProvider["dn"]
+	return managed.ExternalObservation{
+		// // Return false when the external resource does not exist. This lets
+		// // the managed resource reconciler know that it needs to call Create to
+		// // (re)create the resource, or that it has successfully been deleted.
+		ResourceExists: true,
+
+		// // Return false when the external resource exists, but it not up to date
+		// // with the desired managed resource state. This lets the managed
+		// // resource reconciler know that it needs to call Update.
+		ResourceUpToDate: radiusproviderutil.IsUptoDate(c.apicClient, cr, aaaRadiusProvider) && !secretversion.Changed(cr, radiusproviderutil.AnnotationKeyKeyVersion, version),
+
+		// // Return any details that may be required to connect to the external
+		// // resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.RADIUSProvider)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRADIUSProvider)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	secret, version, err := c.secret(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	aaaRadiusProvider := radiusproviderutil.NewProvider(cr.Spec.ForProvider, string(secret))
+	err = c.apicClient.Save(aaaRadiusProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "Cannot create RADIUS Provider")
+	}
+	secretversion.Record(cr, radiusproviderutil.AnnotationKeyKeyVersion, version)
+	if err := radiusproviderutil.SaveManagementEPG(c.apicClient, aaaRadiusProvider.Dn, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "Cannot update RADIUS Provider management EPG")
+	}
+
+	return managed.ExternalCreation{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.RADIUSProvider)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRADIUSProvider)
+	}
+
+	secret, version, err := c.secret(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	// The key is only pushed when the secret changed.
+	changed := secretversion.Changed(cr, radiusproviderutil.AnnotationKeyKeyVersion, version)
+	value := ""
+	if changed {
+		value = string(secret)
+	}
+	aaaRadiusProvider := radiusproviderutil.NewProvider(cr.Spec.ForProvider, value)
+	aaaRadiusProvider.Status = "modified"
+	err = c.apicClient.Save(aaaRadiusProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot update RADIUS Provider")
+	}
+	if changed {
+		if err := secretversion.Persist(ctx, c.kube, cr, radiusproviderutil.AnnotationKeyKeyVersion, version); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRecordKeyVersion)
+		}
+	}
+	if err := radiusproviderutil.SaveManagementEPG(c.apicClient, aaaRadiusProvider.Dn, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot update RADIUS Provider management EPG")
+	}
+
+	return managed.ExternalUpdate{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.RADIUSProvider)
+	if !ok {
+		return errors.New(errNotRADIUSProvider)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	err := c.apicClient.DeleteByDn(radiusproviderutil.ProviderDn(cr.Spec.ForProvider.Name), radiusproviderutil.AaaRadiusProviderClassName)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// secret returns the key of the provider and the version of its Secret.
+func (c *external) secret(ctx context.Context, cr *v1alpha1.RADIUSProvider) ([]byte, string, error) {
+	secret, version, err := secretversion.Read(ctx, c.kube, cr.Spec.ForProvider.KeySecretRef)
+	return secret, version, errors.Wrap(err, errGetKey)
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package radiusprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+	radiusproviderutil "github.com/jgomezve/provider-aci/internal/clients/radiusprovider"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	providerPath = "/api/node/mo/uni/userext/radiusext/radiusprovider-aaa.json"
+	providerDn   = "uni/userext/radiusext/radiusprovider-aaa"
+
+	provider = `{"totalCount":"1","imdata":[{"aaaRadiusProvider":{"attributes":{"dn":"uni/userext/radiusext/radiusprovider-aaa","name":"aaa"}}}]}`
+
+	// pushedVersion is the version of the Secret of the key last pushed to
+	// APIC.
+	pushedVersion = "uid/1"
+)
+
+// newProvider returns a RADIUSProvider whose key was last pushed from the Secret with
+// the supplied version.
+func newProvider(version string) *v1alpha1.RADIUSProvider {
+	return &v1alpha1.RADIUSProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "aaa",
+			Annotations: map[string]string{radiusproviderutil.AnnotationKeyKeyVersion: version},
+		},
+		Spec: v1alpha1.RADIUSProviderSpec{ForProvider: v1alpha1.RADIUSProviderParameters{
+			Name: "aaa",
+			KeySecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Name: "aaa", Namespace: "crossplane-system"},
+				Key:             "key",
+			},
+		}},
+	}
+}
+
+// secret returns a client of the Secret of the key with the supplied UID and
+// resource version.
+func secret(uid, resourceVersion string) *test.MockClient {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			s := obj.(*corev1.Secret)
+			s.SetUID(types.UID(uid))
+			s.SetResourceVersion(resourceVersion)
+			s.Data = map[string][]byte{"key": []byte("s3cr3t")}
+			return nil
+		},
+		MockUpdate: test.NewMockUpdateFn(nil),
+	}
+}
+
+// pushedKey returns the key of the provider pushed by the supplied POSTs, if
+// any.
+func pushedKey(posts []fakeapic.Request) string {
+	for _, p := range posts {
+		if fakeapic.Attribute(p.Body, radiusproviderutil.AaaRadiusProviderClassName, "dn") == providerDn {
+			if key := fakeapic.Attribute(p.Body, radiusproviderutil.AaaRadiusProviderClassName, "key"); key != "" {
+				return key
+			}
+		}
+	}
+	return ""
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		upToDate bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		kube   client.Client
+		want   want
+	}{
+		"NotRADIUSProvider": {
+			reason: "An error should be returned if the managed resource is not a RADIUSProvider",
+			want: want{
+				err: errors.New(errNotRADIUSProvider),
+			},
+		},
+		"SecretUnchanged": {
+			reason: "The provider should be up to date if its Secret did not change since its key was pushed",
+			mg:     newProvider(pushedVersion),
+			kube:   secret("uid", "1"),
+			want: want{
+				upToDate: true,
+			},
+		},
+		"SecretRotated": {
+			reason: "The provider should not be up to date if its Secret changed since its key was pushed",
+			mg:     newProvider(pushedVersion),
+			kube:   secret("uid", "2"),
+		},
+		"SecretReplaced": {
+			reason: "The provider should not be up to date if its Secret was replaced since its key was pushed",
+			mg:     newProvider(pushedVersion),
+			kube:   secret("other", "1"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apic := fakeapic.New()
+			defer apic.Close()
+			apic.RespondGet(providerPath, provider)
+
+			e := external{apicClient: apic.APICClient(), kube: tc.kube}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if err == nil && (!got.ResourceExists || got.ResourceUpToDate != tc.want.upToDate) {
+				t.Errorf("\n%s\ne.Observe(...): want exists and up to date %t, got %+v", tc.reason, tc.want.upToDate, got)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		key     string
+		version string
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.RADIUSProvider
+		kube   client.Client
+		want   want
+	}{
+		"SecretUnchanged": {
+			reason: "The key should not be pushed again if its Secret did not change",
+			mg:     newProvider(pushedVersion),
+			kube:   secret("uid", "1"),
+			want: want{
+				version: pushedVersion,
+			},
+		},
+		"SecretRotated": {
+			reason: "The key should be pushed and the new Secret version recorded if the Secret changed",
+			mg:     newProvider(pushedVersion),
+			kube:   secret("uid", "2"),
+			want: want{
+				key:     "s3cr3t",
+				version: "uid/2",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apic := fakeapic.New()
+			defer apic.Close()
+
+			e := external{apicClient: apic.APICClient(), kube: tc.kube}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.key, pushedKey(apic.Posts())); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want key, +got key:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.version, tc.mg.GetAnnotations()[radiusproviderutil.AnnotationKeyKeyVersion]); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want version, +got version:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
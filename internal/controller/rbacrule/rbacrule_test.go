@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbacrule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const ruleDn = "uni/rbacdb/rule-[uni/tn-web]-dom-web"
+
+func rbacRule(domain, allowWrites string) *v1alpha1.RBACRule {
+	return &v1alpha1.RBACRule{Spec: v1alpha1.RBACRuleSpec{ForProvider: v1alpha1.RBACRuleParameters{
+		ObjectDn:       "uni/tn-web",
+		SecurityDomain: domain,
+		AllowWrites:    allowWrites,
+	}}}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotRBACRule": {
+			reason: "An error should be returned if the managed resource is not a RBACRule",
+			want: want{
+				err: errors.New(errNotRBACRule),
+			},
+		},
+		"UpToDate": {
+			reason: "The rule of the object and the security domain, read by its DN, should be up to date",
+			mg:     rbacRule("web", "yes"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"AllowWritesChanged": {
+			reason: "A rule that no longer allows writes should be drift",
+			mg:     rbacRule("web", "no"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"OtherDomain": {
+			reason: "The rule of another security domain is another object that does not exist",
+			mg:     rbacRule("db", "yes"),
+			want: want{
+				o: managed.ExternalObservation{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := fakeapic.New()
+			defer s.Close()
+			s.RespondGet("/api/node/mo/"+ruleDn+".json",
+				`{"totalCount":"1","imdata":[{"aaaRbacRule":{"attributes":{"dn":"`+ruleDn+`","objectDn":"uni/tn-web","domain":"web","allowWrites":"yes"}}}]}`)
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	s := fakeapic.New()
+	defer s.Close()
+
+	e := external{apicClient: s.APICClient()}
+	if err := e.Delete(context.Background(), rbacRule("web", "yes")); err != nil {
+		t.Fatalf("e.Delete(...): %s", err)
+	}
+	if diff := cmp.Diff([]string{ruleDn}, fakeapic.Deleted(s.Posts())); diff != "" {
+		t.Errorf("e.Delete(...): the rule should be deleted by its DN: -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redirectpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/services/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const polDn = "uni/tn-web/svcCont/svcRedirectPol-pbr"
+
+func redirectPolicy(destinations ...v1alpha1.RedirectDestination) *v1alpha1.RedirectPolicy {
+	return &v1alpha1.RedirectPolicy{Spec: v1alpha1.RedirectPolicySpec{ForProvider: v1alpha1.RedirectPolicyParameters{
+		Name:                  "pbr",
+		Tenant:                "web",
+		HashingAlgorithm:      "sip-dip-prototype",
+		ThresholdEnable:       "no",
+		MinThresholdPercent:   "0",
+		MaxThresholdPercent:   "0",
+		ThresholdDownAction:   "permit",
+		ResilientHashEnabled:  "no",
+		IPSLAMonitoringPolicy: "icmp",
+		Destinations:          destinations,
+	}}}
+}
+
+// apic returns a fake APIC with the redirect policy pbr, monitored by the IP
+// SLA monitoring policy icmp, of the destinations 10.0.0.1 and 10.0.0.2. Like
+// the APIC, it reports the MAC addresses in upper case and an unset ip2 as
+// 0.0.0.0.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet("/api/node/mo/"+polDn+".json",
+		`{"totalCount":"1","imdata":[{"vnsSvcRedirectPol":{"attributes":{"dn":"`+polDn+`","name":"pbr","descr":"","hashingAlgorithm":"sip-dip-prototype","thresholdEnable":"no","minThresholdPercent":"0","maxThresholdPercent":"0","thresholdDownAction":"permit","resilientHashEnabled":"no"}}}]}`)
+	s.RespondGet("/api/node/mo/"+polDn+"/rsIPSLAMonitoringPol.json",
+		`{"totalCount":"1","imdata":[{"vnsRsIPSLAMonitoringPol":{"attributes":{"dn":"`+polDn+`/rsIPSLAMonitoringPol","tDn":"uni/tn-web/ipslaMonitoringPol-icmp"}}}]}`)
+	s.RespondChildren("/api/node/mo/"+polDn+".json", `{"totalCount":"2","imdata":[
+{"vnsRedirectDest":{"attributes":{"dn":"`+polDn+`/RedirectDest_ip-[10.0.0.2]","ip":"10.0.0.2","mac":"00:50:56:AA:00:02","ip2":"0.0.0.0","destName":"","podId":"1"}}},
+{"vnsRedirectDest":{"attributes":{"dn":"`+polDn+`/RedirectDest_ip-[10.0.0.1]","ip":"10.0.0.1","mac":"00:50:56:AA:00:01","ip2":"0.0.0.0","destName":"","podId":"1"}}}]}`)
+	return s
+}
+
+var (
+	first  = v1alpha1.RedirectDestination{IP: "10.0.0.1", MAC: "00:50:56:aa:00:01", PodID: "1"}
+	second = v1alpha1.RedirectDestination{IP: "10.0.0.2", MAC: "00:50:56:aa:00:02", PodID: "1"}
+)
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotRedirectPolicy": {
+			reason: "An error should be returned if the managed resource is not a RedirectPolicy",
+			want: want{
+				err: errors.New(errNotRedirectPolicy),
+			},
+		},
+		"UpToDate": {
+			reason: "The destinations should be matched by IP, whatever the case of their MAC address",
+			mg:     redirectPolicy(second, first),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"MACChanged": {
+			reason: "A destination with another MAC address should be drift",
+			mg:     redirectPolicy(first, v1alpha1.RedirectDestination{IP: "10.0.0.2", MAC: "00:50:56:aa:00:03", PodID: "1"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"IPChanged": {
+			reason: "A destination with another IP should be drift",
+			mg:     redirectPolicy(first, v1alpha1.RedirectDestination{IP: "10.0.0.3", MAC: "00:50:56:aa:00:02", PodID: "1"}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		saved   []string
+		deleted []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.RedirectPolicy
+		want   want
+	}{
+		"MACChanged": {
+			reason: "A destination with another MAC address should be changed in place",
+			mg:     redirectPolicy(first, v1alpha1.RedirectDestination{IP: "10.0.0.2", MAC: "00:50:56:aa:00:03", PodID: "1"}),
+			want: want{
+				saved: []string{polDn + "/RedirectDest_ip-[10.0.0.1]", polDn + "/RedirectDest_ip-[10.0.0.2]"},
+			},
+		},
+		"IPChanged": {
+			reason: "A destination with another IP should be replaced",
+			mg:     redirectPolicy(first, v1alpha1.RedirectDestination{IP: "10.0.0.3", MAC: "00:50:56:aa:00:02", PodID: "1"}),
+			want: want{
+				saved:   []string{polDn + "/RedirectDest_ip-[10.0.0.1]", polDn + "/RedirectDest_ip-[10.0.0.3]"},
+				deleted: []string{polDn + "/RedirectDest_ip-[10.0.0.2]"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): %s", tc.reason, err)
+			}
+			posts := s.Posts()
+			if diff := cmp.Diff(tc.want.saved, fakeapic.Saved(posts, "vnsRedirectDest")); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want saved, +got saved:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, fakeapic.Deleted(posts)); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routecontrolprofile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const profileDn = "uni/tn-web/out-internet/prof-export"
+
+func routeControlProfile(contexts ...v1alpha1.RouteControlContext) *v1alpha1.RouteControlProfile {
+	return &v1alpha1.RouteControlProfile{Spec: v1alpha1.RouteControlProfileSpec{ForProvider: v1alpha1.RouteControlProfileParameters{
+		Name:     "export",
+		Tenant:   "web",
+		L3Out:    "internet",
+		Type:     "combinable",
+		Contexts: contexts,
+	}}}
+}
+
+// apic returns a fake APIC with the route map export of the L3Out internet.
+// Its context deny-bogons comes first, then permit-web, which is the other
+// way around of their DNs.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet("/api/node/mo/"+profileDn+".json",
+		`{"totalCount":"1","imdata":[{"rtctrlProfile":{"attributes":{"dn":"`+profileDn+`","name":"export","descr":"","type":"combinable"}}}]}`)
+	s.RespondChildren("/api/node/mo/"+profileDn+".json", `{"totalCount":"2","imdata":[
+{"rtctrlCtxP":{"attributes":{"dn":"`+profileDn+`/ctx-permit-web","name":"permit-web","descr":"","action":"permit","order":"1"}}},
+{"rtctrlCtxP":{"attributes":{"dn":"`+profileDn+`/ctx-deny-bogons","name":"deny-bogons","descr":"","action":"deny","order":"0"}}}]}`)
+	s.RespondChildren("/api/node/mo/"+profileDn+"/ctx-deny-bogons.json",
+		`{"totalCount":"1","imdata":[{"rtctrlRsCtxPToSubjP":{"attributes":{"dn":"`+profileDn+`/ctx-deny-bogons/rsctxPToSubjP-bogons","tnRtctrlSubjPName":"bogons"}}}]}`)
+	s.RespondChildren("/api/node/mo/"+profileDn+"/ctx-permit-web.json",
+		`{"totalCount":"1","imdata":[{"rtctrlRsCtxPToSubjP":{"attributes":{"dn":"`+profileDn+`/ctx-permit-web/rsctxPToSubjP-web","tnRtctrlSubjPName":"web"}}}]}`)
+	s.RespondGet("/api/node/mo/"+profileDn+"/ctx-permit-web/scp/rsScopeToAttrP.json",
+		`{"totalCount":"1","imdata":[{"rtctrlRsScopeToAttrP":{"attributes":{"dn":"`+profileDn+`/ctx-permit-web/scp/rsScopeToAttrP","tnRtctrlAttrPName":"prepend"}}}]}`)
+	return s
+}
+
+var (
+	denyBogons = v1alpha1.RouteControlContext{Name: "deny-bogons", Action: "deny", MatchRules: []string{"bogons"}}
+	permitWeb  = v1alpha1.RouteControlContext{Name: "permit-web", Action: "permit", MatchRules: []string{"web"}, SetRule: "prepend"}
+)
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotRouteControlProfile": {
+			reason: "An error should be returned if the managed resource is not a RouteControlProfile",
+			want: want{
+				err: errors.New(errNotRouteControlProfile),
+			},
+		},
+		"UpToDate": {
+			reason: "The contexts should be read back in their order, not in the order of their DNs",
+			mg:     routeControlProfile(denyBogons, permitWeb),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"Reordered": {
+			reason: "A route map whose contexts are desired in another order should be drift",
+			mg:     routeControlProfile(permitWeb, denyBogons),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"SetRuleRemoved": {
+			reason: "A context that no longer sets attributes should be drift",
+			mg:     routeControlProfile(denyBogons, v1alpha1.RouteControlContext{Name: "permit-web", Action: "permit", MatchRules: []string{"web"}}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	s := apic()
+	defer s.Close()
+
+	e := external{apicClient: s.APICClient()}
+	mg := routeControlProfile(v1alpha1.RouteControlContext{Name: "permit-web", Action: "permit", MatchRules: []string{"web"}}, denyBogons)
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("e.Update(...): %s", err)
+	}
+	posts := s.Posts()
+	order := map[string]string{}
+	for _, p := range posts {
+		if name := fakeapic.Attribute(p.Body, "rtctrlCtxP", "name"); name != "" {
+			order[name] = fakeapic.Attribute(p.Body, "rtctrlCtxP", "order")
+		}
+	}
+	if diff := cmp.Diff(map[string]string{"permit-web": "0", "deny-bogons": "1"}, order); diff != "" {
+		t.Errorf("e.Update(...): the order of each context should be its position in the list: -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff([]string{profileDn + "/ctx-deny-bogons/scp", profileDn + "/ctx-permit-web/scp"}, fakeapic.Deleted(posts)); diff != "" {
+		t.Errorf("e.Update(...): the scope of the contexts that set no attributes should be deleted: -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitydomain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/admin/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+func securityDomain(name, description string) *v1alpha1.SecurityDomain {
+	return &v1alpha1.SecurityDomain{Spec: v1alpha1.SecurityDomainSpec{ForProvider: v1alpha1.SecurityDomainParameters{
+		Name:        name,
+		Description: description,
+	}}}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotSecurityDomain": {
+			reason: "An error should be returned if the managed resource is not a SecurityDomain",
+			want: want{
+				err: errors.New(errNotSecurityDomain),
+			},
+		},
+		"UpToDate": {
+			reason: "A security domain with the desired description should be up to date",
+			mg:     securityDomain("web", "Web tenants"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"DescriptionChanged": {
+			reason: "A security domain with another description should be drift",
+			mg:     securityDomain("web", "Web and database tenants"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"NotFound": {
+			reason: "A security domain that is not on the APIC should not exist",
+			mg:     securityDomain("db", ""),
+			want: want{
+				o: managed.ExternalObservation{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := fakeapic.New()
+			defer s.Close()
+			s.RespondGet("/api/node/mo/uni/userext/domain-web.json",
+				`{"totalCount":"1","imdata":[{"aaaDomain":{"attributes":{"dn":"uni/userext/domain-web","name":"web","descr":"Web tenants","nameAlias":""}}}]}`)
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	s := fakeapic.New()
+	defer s.Close()
+
+	e := external{apicClient: s.APICClient()}
+	if err := e.Delete(context.Background(), securityDomain("web", "")); err != nil {
+		t.Fatalf("e.Delete(...): %s", err)
+	}
+	if diff := cmp.Diff([]string{"uni/userext/domain-web"}, fakeapic.Deleted(s.Posts())); diff != "" {
+		t.Errorf("e.Delete(...): the security domain should be deleted: -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicegraphtemplate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/services/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	graphDn    = "uni/tn-web/AbsGraph-chain"
+	consumerT  = graphDn + "/AbsTermNodeCon-T1/AbsTConn"
+	providerT  = graphDn + "/AbsTermNodeProv-T2/AbsTConn"
+	fwConsumer = graphDn + "/AbsNode-fw/AbsFConn-consumer"
+	fwProvider = graphDn + "/AbsNode-fw/AbsFConn-provider"
+	lbConsumer = graphDn + "/AbsNode-lb/AbsFConn-consumer"
+	lbProvider = graphDn + "/AbsNode-lb/AbsFConn-provider"
+)
+
+var (
+	fw = v1alpha1.ServiceNode{Name: "fw", Device: "asa", FunctionType: "GoTo", FunctionTemplateType: "FW_ROUTED", RoutingMode: "Redirect"}
+	lb = v1alpha1.ServiceNode{Name: "lb", Device: "f5", FunctionType: "GoTo", FunctionTemplateType: "ADC_ONE_ARM", RoutingMode: "Redirect"}
+
+	webSubject = v1alpha1.ContractSubjectRef{Contract: "web", Subject: "http"}
+)
+
+func serviceGraphTemplate(subjects []v1alpha1.ContractSubjectRef, nodes ...v1alpha1.ServiceNode) *v1alpha1.ServiceGraphTemplate {
+	return &v1alpha1.ServiceGraphTemplate{Spec: v1alpha1.ServiceGraphTemplateSpec{ForProvider: v1alpha1.ServiceGraphTemplateParameters{
+		Name:             "chain",
+		Tenant:           "web",
+		Nodes:            nodes,
+		AdjacencyType:    "L3",
+		ContractSubjects: subjects,
+	}}}
+}
+
+// apic returns a fake APIC with the service graph template chain of the
+// tenant web, which chains the node fw, then the node lb, and is attached to
+// the subject http of the contract web. The graph chain of the tenant other
+// is attached to a subject of its own.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet("/api/node/mo/"+graphDn+".json",
+		`{"totalCount":"1","imdata":[{"vnsAbsGraph":{"attributes":{"dn":"`+graphDn+`","name":"chain","descr":""}}}]}`)
+	s.RespondChildren("/api/node/mo/"+graphDn+".json", `{"totalCount":"5","imdata":[
+{"vnsAbsNode":{"attributes":{"dn":"`+graphDn+`/AbsNode-lb","name":"lb","funcType":"GoTo","funcTemplateType":"ADC_ONE_ARM","routingMode":"Redirect"}}},
+{"vnsAbsNode":{"attributes":{"dn":"`+graphDn+`/AbsNode-fw","name":"fw","funcType":"GoTo","funcTemplateType":"FW_ROUTED","routingMode":"Redirect"}}},
+{"vnsAbsConnection":{"attributes":{"dn":"`+graphDn+`/AbsConnection-C1","name":"C1","adjType":"L3"}}},
+{"vnsAbsConnection":{"attributes":{"dn":"`+graphDn+`/AbsConnection-C2","name":"C2","adjType":"L3"}}},
+{"vnsAbsConnection":{"attributes":{"dn":"`+graphDn+`/AbsConnection-C3","name":"C3","adjType":"L3"}}}]}`)
+	for node, device := range map[string]string{"fw": "asa", "lb": "f5"} {
+		dn := graphDn + "/AbsNode-" + node + "/rsNodeToLDev"
+		s.RespondGet("/api/node/mo/"+dn+".json",
+			`{"totalCount":"1","imdata":[{"vnsRsNodeToLDev":{"attributes":{"dn":"`+dn+`","tDn":"uni/tn-web/lDevVip-`+device+`"}}}]}`)
+	}
+	for conn, tDns := range map[string][]string{"C1": {consumerT, fwConsumer}, "C2": {fwProvider, lbConsumer}, "C3": {lbProvider, providerT}} {
+		dn := graphDn + "/AbsConnection-" + conn
+		var refs []string
+		for _, tDn := range tDns {
+			refs = append(refs, `{"vnsRsAbsConnectionConns":{"attributes":{"dn":"`+dn+`/rsabsConnectionConns-[`+tDn+`]","tDn":"`+tDn+`"}}}`)
+		}
+		s.RespondChildren("/api/node/mo/"+dn+".json", `{"totalCount":"2","imdata":[`+strings.Join(refs, ",")+`]}`)
+	}
+	s.RespondGet("/api/node/class/vzRsSubjGraphAtt.json", `{"totalCount":"2","imdata":[
+{"vzRsSubjGraphAtt":{"attributes":{"dn":"uni/tn-web/brc-web/subj-http/rsSubjGraphAtt","tnVnsAbsGraphName":"chain"}}},
+{"vzRsSubjGraphAtt":{"attributes":{"dn":"uni/tn-other/brc-db/subj-sql/rsSubjGraphAtt","tnVnsAbsGraphName":"chain"}}}]}`)
+	return s
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotServiceGraphTemplate": {
+			reason: "An error should be returned if the managed resource is not a ServiceGraphTemplate",
+			want: want{
+				err: errors.New(errNotServiceGraphTemplate),
+			},
+		},
+		"UpToDate": {
+			reason: "A graph chaining its nodes in order, attached to the subjects of its tenant only, should be up to date",
+			mg:     serviceGraphTemplate([]v1alpha1.ContractSubjectRef{webSubject}, fw, lb),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"NodesReordered": {
+			reason: "A graph chaining its nodes in another order should be drift",
+			mg:     serviceGraphTemplate([]v1alpha1.ContractSubjectRef{webSubject}, lb, fw),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"SubjectRemoved": {
+			reason: "A graph attached to a subject that is no longer desired should be drift",
+			mg:     serviceGraphTemplate(nil, fw, lb),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		mg      *v1alpha1.ServiceGraphTemplate
+		deleted []string
+	}{
+		"UpToDate": {
+			reason: "Nothing should be deleted if the graph is as desired",
+			mg:     serviceGraphTemplate([]v1alpha1.ContractSubjectRef{webSubject}, fw, lb),
+		},
+		"NodesReordered": {
+			reason: "The connections should be rewired to chain the nodes in the new order",
+			mg:     serviceGraphTemplate([]v1alpha1.ContractSubjectRef{webSubject}, lb, fw),
+			deleted: []string{
+				graphDn + "/AbsConnection-C1/rsabsConnectionConns-[" + fwConsumer + "]",
+				graphDn + "/AbsConnection-C2/rsabsConnectionConns-[" + fwProvider + "]",
+				graphDn + "/AbsConnection-C2/rsabsConnectionConns-[" + lbConsumer + "]",
+				graphDn + "/AbsConnection-C3/rsabsConnectionConns-[" + lbProvider + "]",
+			},
+		},
+		"NodeRemoved": {
+			reason: "The node that is no longer desired and its connection should be deleted",
+			mg:     serviceGraphTemplate([]v1alpha1.ContractSubjectRef{webSubject}, fw),
+			deleted: []string{
+				graphDn + "/AbsConnection-C2/rsabsConnectionConns-[" + lbConsumer + "]",
+				graphDn + "/AbsConnection-C3",
+				graphDn + "/AbsNode-lb",
+			},
+		},
+		"SubjectRemoved": {
+			reason:  "Only the attachment of the subject of the tenant that is no longer desired should be deleted",
+			mg:      serviceGraphTemplate(nil, fw, lb),
+			deleted: []string{"uni/tn-web/brc-web/subj-http/rsSubjGraphAtt"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.deleted, fakeapic.Deleted(s.Posts())); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package setrule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const ruleDn = "uni/tn-web/attr-prepend"
+
+func setRule(metric string, asns ...string) *v1alpha1.SetRule {
+	return &v1alpha1.SetRule{Spec: v1alpha1.SetRuleSpec{ForProvider: v1alpha1.SetRuleParameters{
+		Name:          "prepend",
+		Tenant:        "web",
+		Metric:        metric,
+		ASPathPrepend: asns,
+	}}}
+}
+
+// apic returns a fake APIC with the set rule prepend, which sets the metric
+// 100 and prepends 65001 then 65002. The AS numbers are not returned in the
+// order they are prepended.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet("/api/node/mo/"+ruleDn+".json",
+		`{"totalCount":"1","imdata":[{"rtctrlAttrP":{"attributes":{"dn":"`+ruleDn+`","name":"prepend","descr":""}}}]}`)
+	s.RespondGet("/api/node/mo/"+ruleDn+"/smetric.json",
+		`{"totalCount":"1","imdata":[{"rtctrlSetRtMetric":{"attributes":{"dn":"`+ruleDn+`/smetric","metric":"100"}}}]}`)
+	s.RespondChildren("/api/node/mo/"+ruleDn+"/saspath-prepend.json", `{"totalCount":"2","imdata":[
+{"rtctrlSetASPathASN":{"attributes":{"dn":"`+ruleDn+`/saspath-prepend/asn-1","asn":"65002","order":"1"}}},
+{"rtctrlSetASPathASN":{"attributes":{"dn":"`+ruleDn+`/saspath-prepend/asn-0","asn":"65001","order":"0"}}}]}`)
+	return s
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotSetRule": {
+			reason: "An error should be returned if the managed resource is not a SetRule",
+			want: want{
+				err: errors.New(errNotSetRule),
+			},
+		},
+		"UpToDate": {
+			reason: "The AS numbers should be read back in the order they are prepended",
+			mg:     setRule("100", "65001", "65002"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"ASPathReordered": {
+			reason: "AS numbers desired in another order should be drift",
+			mg:     setRule("100", "65002", "65001"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"MetricRemoved": {
+			reason: "A metric that is no longer set should be drift",
+			mg:     setRule("", "65001", "65002"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	s := apic()
+	defer s.Close()
+
+	e := external{apicClient: s.APICClient()}
+	if _, err := e.Update(context.Background(), setRule("", "65002")); err != nil {
+		t.Fatalf("e.Update(...): %s", err)
+	}
+	posts := s.Posts()
+	var asns []string
+	for _, p := range posts {
+		if asn := fakeapic.Attribute(p.Body, "rtctrlSetASPathASN", "asn"); asn != "" {
+			asns = append(asns, fakeapic.Attribute(p.Body, "rtctrlSetASPathASN", "dn")+"="+asn)
+		}
+	}
+	if diff := cmp.Diff([]string{ruleDn + "/saspath-prepend/asn-0=65002"}, asns); diff != "" {
+		t.Errorf("e.Update(...): the AS numbers should be saved by their position: -want, +got:\n%s\n", diff)
+	}
+	want := []string{ruleDn + "/saspath-prepend/asn-1", ruleDn + "/scomm", ruleDn + "/smetric", ruleDn + "/spref"}
+	if diff := cmp.Diff(want, fakeapic.Deleted(posts)); diff != "" {
+		t.Errorf("e.Update(...): the unset actions and the extra AS number should be deleted: -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snmppolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/fabric/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	policyPath = "/api/node/mo/uni/fabric/snmppol-default.json"
+	groupDn    = "uni/fabric/snmppol-default/clgrp-nms"
+)
+
+func snmpPolicy(communities []string, clients ...v1alpha1.SNMPClient) *v1alpha1.SNMPPolicy {
+	return &v1alpha1.SNMPPolicy{Spec: v1alpha1.SNMPPolicySpec{ForProvider: v1alpha1.SNMPPolicyParameters{
+		Name:        "default",
+		AdminState:  "enabled",
+		Communities: communities,
+		ClientGroups: []v1alpha1.SNMPClientGroup{{
+			Name:          "nms",
+			ManagementEPG: "oob-default",
+			Clients:       clients,
+		}},
+	}}}
+}
+
+// apic returns a fake APIC with the SNMP policy default, its communities
+// public and private and its client group nms of the clients 10.0.0.1 and
+// 10.0.0.2.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet(policyPath, `{"totalCount":"1","imdata":[{"snmpPol":{"attributes":{"dn":"uni/fabric/snmppol-default","name":"default","descr":"","nameAlias":"","adminSt":"enabled","contact":"","loc":""}}}]}`)
+	s.RespondChildren(policyPath, `{"totalCount":"3","imdata":[
+{"snmpCommunityP":{"attributes":{"dn":"uni/fabric/snmppol-default/community-public","name":"public"}}},
+{"snmpCommunityP":{"attributes":{"dn":"uni/fabric/snmppol-default/community-private","name":"private"}}},
+{"snmpClientGrpP":{"attributes":{"dn":"`+groupDn+`","name":"nms","descr":""}}}]}`)
+	s.RespondChildren("/api/node/mo/"+groupDn+".json", `{"totalCount":"2","imdata":[
+{"snmpClientP":{"attributes":{"dn":"`+groupDn+`/client-[10.0.0.2]","addr":"10.0.0.2","name":"backup"}}},
+{"snmpClientP":{"attributes":{"dn":"`+groupDn+`/client-[10.0.0.1]","addr":"10.0.0.1","name":"primary"}}}]}`)
+	s.RespondGet("/api/node/mo/"+groupDn+"/rsepg.json",
+		`{"totalCount":"1","imdata":[{"snmpRsEpg":{"attributes":{"dn":"`+groupDn+`/rsepg","tDn":"uni/tn-mgmt/mgmtp-default/oob-default"}}}]}`)
+	return s
+}
+
+var (
+	primary = v1alpha1.SNMPClient{Address: "10.0.0.1", Name: "primary"}
+	backup  = v1alpha1.SNMPClient{Address: "10.0.0.2", Name: "backup"}
+)
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotSNMPPolicy": {
+			reason: "An error should be returned if the managed resource is not a SNMPPolicy",
+			want: want{
+				err: errors.New(errNotSNMPPolicy),
+			},
+		},
+		"UpToDate": {
+			reason: "The communities and the clients should be compared whatever their order",
+			mg:     snmpPolicy([]string{"public", "private"}, primary, backup),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"CommunityRemoved": {
+			reason: "A community that is no longer desired should be drift",
+			mg:     snmpPolicy([]string{"private"}, primary, backup),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"ClientRemoved": {
+			reason: "A client that is no longer desired should be drift",
+			mg:     snmpPolicy([]string{"private", "public"}, primary),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		mg      *v1alpha1.SNMPPolicy
+		deleted []string
+	}{
+		"UpToDate": {
+			reason: "No child should be deleted if all of them are desired",
+			mg:     snmpPolicy([]string{"private", "public"}, backup, primary),
+		},
+		"CommunityRemoved": {
+			reason:  "Only the community that is no longer desired should be deleted",
+			mg:      snmpPolicy([]string{"private"}, primary, backup),
+			deleted: []string{"uni/fabric/snmppol-default/community-public"},
+		},
+		"ClientRemoved": {
+			reason:  "Only the client that is no longer desired should be deleted from its group",
+			mg:      snmpPolicy([]string{"private", "public"}, primary),
+			deleted: []string{groupDn + "/client-[10.0.0.2]"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.deleted, fakeapic.Deleted(s.Posts())); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
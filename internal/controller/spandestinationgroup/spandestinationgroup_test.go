@@ -0,0 +1,149 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package spandestinationgroup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/monitoring/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const destDn = "uni/tn-web/destgrp-analyzers/dest-analyzers"
+
+// spanDestinationGroup returns a SpanDestinationGroup created an hour ago
+// with the supplied ERSPAN destination IP, which expires the supplied
+// duration after its creation, if any.
+func spanDestinationGroup(ip string, expireAfter *metav1.Duration) *v1alpha1.SpanDestinationGroup {
+	return &v1alpha1.SpanDestinationGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "analyzers", CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour))},
+		Spec: v1alpha1.SpanDestinationGroupSpec{ForProvider: v1alpha1.SpanDestinationGroupParameters{
+			Name:   "analyzers",
+			Tenant: "web",
+			Destination: v1alpha1.SpanERSPANDestination{
+				EndpointGroupDn: "uni/tn-common/ap-monitoring/epg-analyzers",
+				DestinationIP:   ip,
+				SourceIPPrefix:  "10.0.0.1",
+				Version:         "ver2",
+				DSCP:            "unspecified",
+				TTL:             "64",
+				Mtu:             "1518",
+				FlowID:          "1",
+			},
+			ExpireAfter: expireAfter,
+		}},
+	}
+}
+
+// apic returns a fake APIC with the SPAN destination group analyzers, which
+// sends the mirrored traffic to 192.168.1.10.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet("/api/node/mo/uni/tn-web/destgrp-analyzers.json",
+		`{"totalCount":"1","imdata":[{"spanDestGrp":{"attributes":{"dn":"uni/tn-web/destgrp-analyzers","name":"analyzers","descr":""}}}]}`)
+	s.RespondGet("/api/node/mo/"+destDn+"/rsdestEpg.json",
+		`{"totalCount":"1","imdata":[{"spanRsDestEpg":{"attributes":{"dn":"`+destDn+`/rsdestEpg","tDn":"uni/tn-common/ap-monitoring/epg-analyzers","ip":"192.168.1.10","srcIpPrefix":"10.0.0.1","ver":"ver2","dscp":"unspecified","ttl":"64","mtu":"1518","flowId":"1"}}}]}`)
+	return s
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o       managed.ExternalObservation
+		err     error
+		deleted bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotSpanDestinationGroup": {
+			reason: "An error should be returned if the managed resource is not a SpanDestinationGroup",
+			want: want{
+				err: errors.New(errNotSpanDestinationGroup),
+			},
+		},
+		"UpToDate": {
+			reason: "A SpanDestinationGroup that has not expired yet should be observed like any other",
+			mg:     spanDestinationGroup("192.168.1.10", &metav1.Duration{Duration: 2 * time.Hour}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"DestinationIPChanged": {
+			reason: "Another ERSPAN destination IP should be drift",
+			mg:     spanDestinationGroup("192.168.1.20", nil),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"Expired": {
+			reason: "An expired SpanDestinationGroup should be deleted and reported up to date, even if it drifted",
+			mg:     spanDestinationGroup("192.168.1.20", &metav1.Duration{Duration: 30 * time.Minute}),
+			want: want{
+				o:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				deleted: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			deleted := false
+			kube := &test.MockClient{MockDelete: func(_ context.Context, _ client.Object, _ ...client.DeleteOption) error {
+				deleted = true
+				return nil
+			}}
+
+			e := external{apicClient: s.APICClient(), kube: kube, recorder: event.NewNopRecorder()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if deleted != tc.want.deleted {
+				t.Errorf("\n%s\ne.Observe(...): want deleted %t, got %t", tc.reason, tc.want.deleted, deleted)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package spansourcegroup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/monitoring/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const grpDn = "uni/tn-web/srcgrp-debug"
+
+var errBoom = errors.New("boom")
+
+// spanSourceGroup returns a SpanSourceGroup created an hour ago, which
+// expires the supplied duration after its creation, if any.
+func spanSourceGroup(expireAfter *metav1.Duration, sources ...v1alpha1.SpanSource) *v1alpha1.SpanSourceGroup {
+	return &v1alpha1.SpanSourceGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "debug", CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour))},
+		Spec: v1alpha1.SpanSourceGroupSpec{ForProvider: v1alpha1.SpanSourceGroupParameters{
+			Name:             "debug",
+			Tenant:           "web",
+			AdminState:       "enabled",
+			DestinationGroup: "analyzers",
+			Sources:          sources,
+			ExpireAfter:      expireAfter,
+		}},
+	}
+}
+
+// apic returns a fake APIC with the SPAN source group debug, which mirrors
+// the traffic of the EPGs frontend and backend to the destination group
+// analyzers.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet("/api/node/mo/"+grpDn+".json",
+		`{"totalCount":"1","imdata":[{"spanSrcGrp":{"attributes":{"dn":"`+grpDn+`","name":"debug","descr":"","adminSt":"enabled"}}}]}`)
+	s.RespondChildren("/api/node/mo/"+grpDn+".json", `{"totalCount":"3","imdata":[
+{"spanSpanLbl":{"attributes":{"dn":"`+grpDn+`/spanlbl-analyzers","name":"analyzers"}}},
+{"spanSrc":{"attributes":{"dn":"`+grpDn+`/src-frontend","name":"frontend","dir":"both"}}},
+{"spanSrc":{"attributes":{"dn":"`+grpDn+`/src-backend","name":"backend","dir":"in"}}}]}`)
+	s.RespondGet("/api/node/mo/"+grpDn+"/src-frontend/rssrcToEpg.json",
+		`{"totalCount":"1","imdata":[{"spanRsSrcToEpg":{"attributes":{"dn":"`+grpDn+`/src-frontend/rssrcToEpg","tDn":"uni/tn-web/ap-shop/epg-frontend"}}}]}`)
+	s.RespondGet("/api/node/mo/"+grpDn+"/src-backend/rssrcToEpg.json",
+		`{"totalCount":"1","imdata":[{"spanRsSrcToEpg":{"attributes":{"dn":"`+grpDn+`/src-backend/rssrcToEpg","tDn":"uni/tn-web/ap-shop/epg-backend"}}}]}`)
+	return s
+}
+
+var (
+	frontend = v1alpha1.SpanSource{Name: "frontend", ApplicationProfile: "shop", EndpointGroup: "frontend", Direction: "both"}
+	backend  = v1alpha1.SpanSource{Name: "backend", ApplicationProfile: "shop", EndpointGroup: "backend", Direction: "in"}
+)
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o       managed.ExternalObservation
+		err     error
+		deleted bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		delete error
+		want   want
+	}{
+		"NotSpanSourceGroup": {
+			reason: "An error should be returned if the managed resource is not a SpanSourceGroup",
+			want: want{
+				err: errors.New(errNotSpanSourceGroup),
+			},
+		},
+		"UpToDate": {
+			reason: "A SpanSourceGroup that never expires should be observed like any other",
+			mg:     spanSourceGroup(nil, frontend, backend),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"SourceRemoved": {
+			reason: "A source that is no longer desired should be drift",
+			mg:     spanSourceGroup(nil, frontend),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"NotExpired": {
+			reason: "A SpanSourceGroup that has not expired yet should not be deleted",
+			mg:     spanSourceGroup(&metav1.Duration{Duration: 2 * time.Hour}, frontend, backend),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"Expired": {
+			reason: "An expired SpanSourceGroup should be deleted and reported up to date, so that the session is not changed in the meantime",
+			mg:     spanSourceGroup(&metav1.Duration{Duration: 30 * time.Minute}, frontend),
+			want: want{
+				o:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				deleted: true,
+			},
+		},
+		"ExpiredDeleteFailed": {
+			reason: "An error should be returned if an expired SpanSourceGroup cannot be deleted",
+			mg:     spanSourceGroup(&metav1.Duration{Duration: 30 * time.Minute}, frontend),
+			delete: errBoom,
+			want: want{
+				err:     errors.Wrap(errBoom, "cannot delete expired managed resource"),
+				deleted: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			deleted := false
+			kube := &test.MockClient{MockDelete: func(_ context.Context, _ client.Object, _ ...client.DeleteOption) error {
+				deleted = true
+				return tc.delete
+			}}
+
+			e := external{apicClient: s.APICClient(), kube: kube, recorder: event.NewNopRecorder()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if deleted != tc.want.deleted {
+				t.Errorf("\n%s\ne.Observe(...): want deleted %t, got %t", tc.reason, tc.want.deleted, deleted)
+			}
+		})
+	}
+}
+
+func TestObserveExpiresAt(t *testing.T) {
+	s := apic()
+	defer s.Close()
+
+	e := external{apicClient: s.APICClient(), kube: &test.MockClient{}, recorder: event.NewNopRecorder()}
+	cr := spanSourceGroup(&metav1.Duration{Duration: 2 * time.Hour}, frontend, backend)
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("e.Observe(...): %s", err)
+	}
+	want := metav1.NewTime(cr.GetCreationTimestamp().Add(2 * time.Hour))
+	if diff := cmp.Diff(&want, cr.Status.AtProvider.ExpiresAt); diff != "" {
+		t.Errorf("e.Observe(...): the SpanSourceGroup should expire expireAfter after its creation: -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	s := apic()
+	defer s.Close()
+
+	e := external{apicClient: s.APICClient()}
+	if _, err := e.Update(context.Background(), spanSourceGroup(nil, frontend)); err != nil {
+		t.Fatalf("e.Update(...): %s", err)
+	}
+	posts := s.Posts()
+	if diff := cmp.Diff([]string{grpDn + "/src-frontend/rssrcToEpg"}, fakeapic.Saved(posts, "spanRsSrcToEpg")); diff != "" {
+		t.Errorf("e.Update(...): the desired source should point to its EPG: -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff([]string{grpDn + "/src-backend"}, fakeapic.Deleted(posts)); diff != "" {
+		t.Errorf("e.Update(...): only the source that is no longer desired should be deleted: -want, +got:\n%s\n", diff)
+	}
+}
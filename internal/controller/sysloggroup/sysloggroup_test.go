@@ -0,0 +1,192 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sysloggroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/fabric/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const groupPath = "/api/node/mo/uni/fabric/slgroup-syslog.json"
+
+func syslogGroup(destinations ...v1alpha1.SyslogRemoteDestination) *v1alpha1.SyslogGroup {
+	return &v1alpha1.SyslogGroup{Spec: v1alpha1.SyslogGroupSpec{ForProvider: v1alpha1.SyslogGroupParameters{
+		Name:                "syslog",
+		Format:              "aci",
+		IncludeMilliseconds: "yes",
+		RemoteDestinations:  destinations,
+	}}}
+}
+
+// destination returns the remote destination with the supplied host, reached
+// through the out-of-band management EPG.
+func destination(host string) v1alpha1.SyslogRemoteDestination {
+	return v1alpha1.SyslogRemoteDestination{
+		Host:               host,
+		Port:               "514",
+		Severity:           "warnings",
+		ForwardingFacility: "local7",
+		AdminState:         "enabled",
+		ManagementEPG:      "oob-default",
+	}
+}
+
+// apic returns a fake APIC with the syslog group syslog and its remote
+// destinations 10.0.0.1 and 10.0.0.2.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet(groupPath, `{"totalCount":"1","imdata":[{"syslogGroup":{"attributes":{"dn":"uni/fabric/slgroup-syslog","name":"syslog","descr":"","nameAlias":"","format":"aci","includeMilliSeconds":"yes"}}}]}`)
+	s.RespondChildren(groupPath, `{"totalCount":"2","imdata":[
+{"syslogRemoteDest":{"attributes":{"dn":"uni/fabric/slgroup-syslog/rdst-10.0.0.2","host":"10.0.0.2","name":"","port":"514","severity":"warnings","forwardingFacility":"local7","adminState":"enabled"}}},
+{"syslogRemoteDest":{"attributes":{"dn":"uni/fabric/slgroup-syslog/rdst-10.0.0.1","host":"10.0.0.1","name":"","port":"514","severity":"warnings","forwardingFacility":"local7","adminState":"enabled"}}}]}`)
+	for _, host := range []string{"10.0.0.1", "10.0.0.2"} {
+		dn := "uni/fabric/slgroup-syslog/rdst-" + host + "/rsARemoteHostToEpg"
+		s.RespondGet("/api/node/mo/"+dn+".json",
+			`{"totalCount":"1","imdata":[{"fileRsARemoteHostToEpg":{"attributes":{"dn":"`+dn+`","tDn":"uni/tn-mgmt/mgmtp-default/oob-default"}}}]}`)
+	}
+	return s
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	moved := destination("10.0.0.2")
+	moved.Port = "1514"
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotSyslogGroup": {
+			reason: "An error should be returned if the managed resource is not a SyslogGroup",
+			want: want{
+				err: errors.New(errNotSyslogGroup),
+			},
+		},
+		"UpToDate": {
+			reason: "The remote destinations should be compared whatever their order",
+			mg:     syslogGroup(destination("10.0.0.1"), destination("10.0.0.2")),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"DestinationRemoved": {
+			reason: "A remote destination that is no longer desired should be drift",
+			mg:     syslogGroup(destination("10.0.0.1")),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"DestinationChanged": {
+			reason: "A remote destination with another port should be drift",
+			mg:     syslogGroup(destination("10.0.0.1"), moved),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		saved   []string
+		deleted []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.SyslogGroup
+		want   want
+	}{
+		"DestinationRemoved": {
+			reason: "Only the remote destination that is no longer desired should be deleted",
+			mg:     syslogGroup(destination("10.0.0.2")),
+			want: want{
+				saved:   []string{"uni/fabric/slgroup-syslog/rdst-10.0.0.2"},
+				deleted: []string{"uni/fabric/slgroup-syslog/rdst-10.0.0.1"},
+			},
+		},
+		"DestinationAdded": {
+			reason: "A new remote destination should be created without deleting the others",
+			mg:     syslogGroup(destination("10.0.0.1"), destination("10.0.0.2"), destination("10.0.0.3")),
+			want: want{
+				saved: []string{
+					"uni/fabric/slgroup-syslog/rdst-10.0.0.1",
+					"uni/fabric/slgroup-syslog/rdst-10.0.0.2",
+					"uni/fabric/slgroup-syslog/rdst-10.0.0.3",
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): %s", tc.reason, err)
+			}
+			posts := s.Posts()
+			if diff := cmp.Diff(tc.want.saved, fakeapic.Saved(posts, "syslogRemoteDest")); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want saved, +got saved:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, fakeapic.Deleted(posts)); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tenantconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	apisv1alpha1 "github.com/jgomezve/provider-aci/apis/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+	"github.com/jgomezve/provider-aci/internal/clients/snapshot"
+	tenantconfigutil "github.com/jgomezve/provider-aci/internal/clients/tenantconfig"
+	"github.com/jgomezve/provider-aci/internal/features"
+	"github.com/jgomezve/provider-aci/internal/subscription"
+)
+
+const (
+	errNotTenantConfig = "managed resource is not a TenantConfig custom resource"
+	errTrackPCUsage    = "cannot track ProviderConfig usage"
+	errGetPC           = "cannot get ProviderConfig"
+	errGetCreds        = "cannot get credentials"
+
+	errNewClient = "cannot create new Service"
+	errSnapshot  = "cannot snapshot tenant before change"
+	errNoTenant  = "tenant %s does not exist"
+)
+
+// A NoOpService does nothing.
+type NoOpService struct{}
+
+// var (
+// 	newNoOpService = func(_ []byte) (interface{}, error) { return &NoOpService{}, nil }
+// )
+
+// Setup adds a controller that reconciles TenantConfig managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.TenantConfigGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TenantConfigGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			recorder:     recorder,
+			newServiceFn: aciclient.NewClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.TenantConfig{})
+	return subscription.Complete(mgr, o, b, v1alpha1.TenantConfigGroupVersionKind, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	recorder     event.Recorder
+	newServiceFn func(clientUrl, username string, options ...aciclient.Option) *aciclient.Client
+}
+
+type SecretData struct {
+	Url      string `json:"url"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Insecure bool   `json:"insecure"`
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+
+	var secretData SecretData
+	cr, ok := mg.(*v1alpha1.TenantConfig)
+	if !ok {
+		return nil, errors.New(errNotTenantConfig)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	err = json.Unmarshal(data, &secretData)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := c.newServiceFn(secretData.Url, secretData.Username, aciclient.Password(secretData.Password), aciclient.Insecure(secretData.Insecure))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{apicClient: svc, snapshotter: snapshot.NewSnapshotter(svc, c.kube, c.recorder, pc)}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	apicClient *aciclient.Client
+	// snapshotter takes a snapshot of the tenant before destructive changes,
+	// if enabled in the ProviderConfig.
+	snapshotter *snapshot.Snapshotter
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.TenantConfig)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTenantConfig)
+	}
+
+	fvTenant, err := tenantconfigutil.Read(c.apicClient, cr.Spec.ForProvider.Tenant)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	// A TenantConfig never creates its tenant, so a missing tenant is an
+	// error unless the TenantConfig is being deleted anyway.
+	if fvTenant == nil {
+		if meta.WasDeleted(cr) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Errorf(errNoTenant, cr.Spec.ForProvider.Tenant)
+	}
+	annotation := tenantconfigutil.Annotation(cr.GetName())
+	desired := tenantconfigutil.NewTree(cr.Spec.ForProvider, annotation)
+	// A TenantConfig without objects exists as long as its tenant does.
+	if !tenantconfigutil.Owns(fvTenant, annotation) && len(desired.Children) > 0 {
+		return managed.ExternalObservation{}, nil
+	}
+	// LateInitializer not required for ACI
+
+	cr.SetConditions(xpv1.Available())
+
+	cr.Status.AtProvider.Dn = fvTenant.Attributes["dn"]
+	return managed.ExternalObservation{
+		// // Return false when the external resource does not exist. This lets
+		// // the managed resource reconciler know that it needs to call Create to
+		// // (re)create the resource, or that it has successfully been deleted.
+		ResourceExists: true,
+
+		// // Return false when the external resource exists, but it not up to date
+		// // with the desired managed resource state. This lets the managed
+		// // resource reconciler know that it needs to call Update.
+		ResourceUpToDate: tenantconfigutil.IsUptoDate(cr, fvTenant),
+
+		// // Return any details that may be required to connect to the external
+		// // resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.TenantConfig)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTenantConfig)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	// Posting the tree would otherwise create the tenant implicitly.
+	fvTenant, err := tenantconfigutil.Read(c.apicClient, cr.Spec.ForProvider.Tenant)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if fvTenant == nil {
+		return managed.ExternalCreation{}, errors.Errorf(errNoTenant, cr.Spec.ForProvider.Tenant)
+	}
+
+	desired := tenantconfigutil.NewTree(cr.Spec.ForProvider, tenantconfigutil.Annotation(cr.GetName()))
+	if err := mo.PostTree(c.apicClient, desired.Attributes["dn"], desired); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "Cannot create tenant configuration")
+	}
+
+	return managed.ExternalCreation{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.TenantConfig)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTenantConfig)
+	}
+
+	fvTenant, err := tenantconfigutil.Read(c.apicClient, cr.Spec.ForProvider.Tenant)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	annotation := tenantconfigutil.Annotation(cr.GetName())
+	desired := tenantconfigutil.NewTree(cr.Spec.ForProvider, annotation)
+	if deleted := tenantconfigutil.DeleteStale(desired, fvTenant, annotation); deleted > 0 {
+		if err := c.snapshotter.Take(ctx, cr, cr.Spec.ForProvider.Tenant, fmt.Sprintf("deleting %d objects of %s", deleted, desired.Attributes["dn"])); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSnapshot)
+		}
+	}
+	if err := mo.PostTree(c.apicClient, desired.Attributes["dn"], desired); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot update tenant configuration")
+	}
+	return managed.ExternalUpdate{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.TenantConfig)
+	if !ok {
+		return errors.New(errNotTenantConfig)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	fvTenant, err := tenantconfigutil.Read(c.apicClient, cr.Spec.ForProvider.Tenant)
+	if err != nil || fvTenant == nil {
+		return err
+	}
+	// The tenant itself and the objects not owned by the TenantConfig are
+	// kept.
+	deletion := tenantconfigutil.NewDeletion(fvTenant, tenantconfigutil.Annotation(cr.GetName()))
+	if len(deletion.Children) == 0 {
+		return nil
+	}
+	if err := c.snapshotter.Take(ctx, cr, cr.Spec.ForProvider.Tenant, fmt.Sprintf("deleting the objects of %s", cr.GetName())); err != nil {
+		return errors.Wrap(err, errSnapshot)
+	}
+	return mo.PostTree(c.apicClient, deletion.Attributes["dn"], deletion)
+}
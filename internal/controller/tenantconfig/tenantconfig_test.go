@@ -0,0 +1,268 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tenantconfig
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const tenantPath = "/api/node/mo/uni/tn-crossplane.json"
+
+// subtree is the tenant as returned by the APIC with rsp-subtree=full: the
+// children have an RN and no DN. The VRF web and the bridge domain old are
+// owned by the TenantConfig web-zone, the bridge domain legacy is not.
+const subtree = `{"totalCount":"1","imdata":[{"fvTenant":{"attributes":{"dn":"uni/tn-crossplane","name":"crossplane"},"children":[
+{"fvCtx":{"attributes":{"rn":"ctx-web","name":"web","descr":"","pcEnfPref":"enforced","annotation":"crossplane:web-zone"}}},
+{"fvBD":{"attributes":{"rn":"BD-old","name":"old","descr":"","arpFlood":"no","unicastRoute":"yes","unkMacUcastAct":"proxy","annotation":"crossplane:web-zone"},"children":[
+{"fvRsCtx":{"attributes":{"rn":"rsctx","tnFvCtxName":"web"}}},
+{"fvSubnet":{"attributes":{"rn":"subnet-[10.0.0.1/24]","ip":"10.0.0.1/24","scope":"private","descr":"","annotation":"crossplane:web-zone"}}}]}},
+{"fvBD":{"attributes":{"rn":"BD-legacy","name":"legacy","descr":"","arpFlood":"no","unicastRoute":"yes","unkMacUcastAct":"proxy","annotation":""},"children":[
+{"fvRsCtx":{"attributes":{"rn":"rsctx","tnFvCtxName":"web"}}}]}}]}}]}`
+
+func tenantConfig(p v1alpha1.TenantConfigParameters) *v1alpha1.TenantConfig {
+	return &v1alpha1.TenantConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-zone"},
+		Spec:       v1alpha1.TenantConfigSpec{ForProvider: p},
+	}
+}
+
+// missingTenant returns a TenantConfig of a tenant the APIC does not have.
+func missingTenant() *v1alpha1.TenantConfig {
+	p := vrfOnly
+	p.Tenant = "missing"
+	return tenantConfig(p)
+}
+
+var (
+	vrfOnly = v1alpha1.TenantConfigParameters{
+		Tenant: "crossplane",
+		Vrfs:   []v1alpha1.TenantConfigVrf{{Name: "web", PcEnfPref: "enforced"}},
+	}
+	vrfAndBd = v1alpha1.TenantConfigParameters{
+		Tenant: "crossplane",
+		Vrfs:   []v1alpha1.TenantConfigVrf{{Name: "web", PcEnfPref: "enforced"}},
+		BridgeDomains: []v1alpha1.TenantConfigBridgeDomain{{
+			Name: "old", Vrf: "web", ArpFlood: "no", UnicastRoute: "yes", UnkMacUcastAct: "proxy",
+			Subnets: []v1alpha1.TenantConfigSubnet{{IP: "10.0.0.1/24", Scope: "private"}},
+		}},
+	}
+)
+
+// deleted returns the DNs of the objects deleted by the supplied POST body.
+func deleted(body map[string]interface{}) []string {
+	var dns []string
+	for _, v := range body {
+		obj, _ := v.(map[string]interface{})
+		attrs, _ := obj["attributes"].(map[string]interface{})
+		if attrs["status"] == "deleted" {
+			dn, _ := attrs["dn"].(string)
+			dns = append(dns, dn)
+		}
+		children, _ := obj["children"].([]interface{})
+		for _, c := range children {
+			child, _ := c.(map[string]interface{})
+			dns = append(dns, deleted(child)...)
+		}
+	}
+	sort.Strings(dns)
+	return dns
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotTenantConfig": {
+			reason: "An error should be returned if the managed resource is not a TenantConfig",
+			want: want{
+				err: errors.New(errNotTenantConfig),
+			},
+		},
+		"UpToDate": {
+			reason: "The owned objects of the subtree, read by RN, should match the TenantConfig",
+			mg:     tenantConfig(vrfAndBd),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"Drifted": {
+			reason: "An owned object missing from the TenantConfig should be drift",
+			mg:     tenantConfig(vrfOnly),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"TenantMissing": {
+			reason: "An error should be returned if the tenant does not exist, as a TenantConfig never creates it",
+			mg:     missingTenant(),
+			want: want{
+				err: errors.Errorf(errNoTenant, "missing"),
+			},
+		},
+		"TenantMissingWhileDeleting": {
+			reason: "A TenantConfig being deleted should not exist once its tenant is gone",
+			mg: func() resource.Managed {
+				cr := missingTenant()
+				now := metav1.Now()
+				cr.SetDeletionTimestamp(&now)
+				return cr
+			}(),
+			want: want{
+				o: managed.ExternalObservation{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apic := fakeapic.New()
+			defer apic.Close()
+			apic.RespondGet(tenantPath, subtree)
+
+			e := external{apicClient: apic.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.TenantConfig
+		err    error
+		posts  int
+	}{
+		"TenantExists": {
+			reason: "The tree should be posted under the existing tenant",
+			mg:     tenantConfig(vrfAndBd),
+			posts:  1,
+		},
+		"TenantMissing": {
+			reason: "Nothing should be posted if the tenant does not exist, as the POST would create it",
+			mg:     missingTenant(),
+			err:    errors.Errorf(errNoTenant, "missing"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apic := fakeapic.New()
+			defer apic.Close()
+			apic.RespondGet(tenantPath, subtree)
+
+			e := external{apicClient: apic.APICClient()}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if got := len(apic.Posts()); got != tc.posts {
+				t.Errorf("\n%s\ne.Create(...): want %d POST, got %d", tc.reason, tc.posts, got)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		mg      *v1alpha1.TenantConfig
+		deleted []string
+	}{
+		"UpToDate": {
+			reason: "No object should be deleted if all the owned objects are desired",
+			mg:     tenantConfig(vrfAndBd),
+		},
+		"StaleBridgeDomain": {
+			reason:  "Only the owned bridge domain removed from the TenantConfig should be deleted, by DN",
+			mg:      tenantConfig(vrfOnly),
+			deleted: []string{"uni/tn-crossplane/BD-old"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apic := fakeapic.New()
+			defer apic.Close()
+			apic.RespondGet(tenantPath, subtree)
+
+			e := external{apicClient: apic.APICClient()}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): %s", tc.reason, err)
+			}
+			posts := apic.Posts()
+			if len(posts) != 1 {
+				t.Fatalf("\n%s\ne.Update(...): want 1 POST, got %d", tc.reason, len(posts))
+			}
+			if diff := cmp.Diff(tc.deleted, deleted(posts[0].Body)); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	apic := fakeapic.New()
+	defer apic.Close()
+	apic.RespondGet(tenantPath, subtree)
+
+	e := external{apicClient: apic.APICClient()}
+	if err := e.Delete(context.Background(), tenantConfig(vrfAndBd)); err != nil {
+		t.Fatalf("e.Delete(...): %s", err)
+	}
+	posts := apic.Posts()
+	if len(posts) != 1 {
+		t.Fatalf("e.Delete(...): want 1 POST, got %d", len(posts))
+	}
+	want := []string{"uni/tn-crossplane/BD-old", "uni/tn-crossplane/ctx-web"}
+	if diff := cmp.Diff(want, deleted(posts[0].Body)); diff != "" {
+		t.Errorf("e.Delete(...): only the owned objects should be deleted: -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tenantpolicy
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+	tenantpolicyutil "github.com/jgomezve/provider-aci/internal/clients/tenantpolicy"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const hsrpPath = "/api/node/mo/uni/tn-crossplane/hsrpIfPol-fast.json"
+
+func hsrpPolicy(control ...string) *v1alpha1.HSRPInterfacePolicy {
+	return &v1alpha1.HSRPInterfacePolicy{Spec: v1alpha1.HSRPInterfacePolicySpec{ForProvider: v1alpha1.HSRPInterfacePolicyParameters{
+		TenantPolicyParameters: v1alpha1.TenantPolicyParameters{Name: "fast", Tenant: "crossplane"},
+		Control:                control,
+		Delay:                  "0",
+		ReloadDelay:            "0",
+	}}}
+}
+
+// badPolicy is a tenant policy with an attribute that is not a string.
+type badPolicy struct {
+	v1alpha1.HSRPInterfacePolicy
+	Spec struct {
+		ForProvider struct {
+			v1alpha1.TenantPolicyParameters
+			Priority int `aci:"prio"`
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, k := range policyKinds {
+		if err := tenantpolicyutil.Validate(k.object.(resource.Managed)); err != nil {
+			t.Errorf("Validate(%s): the tenant policy kinds should be valid: %s", k.gvk.Kind, err)
+		}
+	}
+	if err := tenantpolicyutil.Validate(&badPolicy{}); err == nil || !strings.Contains(err.Error(), "Priority") {
+		t.Errorf("Validate(...): the attribute Priority that is not a string or a list of strings should be reported, got %v", err)
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotTenantPolicy": {
+			reason: "An error should be returned if the managed resource is not a tenant policy",
+			want: want{
+				err: errors.New(errNotTenantPolicy),
+			},
+		},
+		"NotTenantPolicyKind": {
+			reason: "An error should be returned if the managed resource does not embed the tenant policy parameters",
+			mg:     &v1alpha1.BridgeDomain{},
+			want: want{
+				err: errors.New(errNotTenantPolicy),
+			},
+		},
+		"UpToDate": {
+			reason: "The lists of the policy should be compared as sets",
+			mg:     hsrpPolicy("bia", "bfd"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"Drifted": {
+			reason: "A policy whose attributes differ should not be up to date",
+			mg:     hsrpPolicy("bia"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apic := fakeapic.New()
+			defer apic.Close()
+			apic.RespondGet(hsrpPath, `{"totalCount":"1","imdata":[{"hsrpIfPol":{"attributes":{"dn":"uni/tn-crossplane/hsrpIfPol-fast","name":"fast","descr":"","ctrl":"bfd,bia","delay":"0","reloadDelay":"0"}}}]}`)
+
+			e := external{apicClient: apic.APICClient(), kind: tenantpolicyutil.HSRPInterfacePolicy}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracklist
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/services/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const listDn = "uni/tn-web/tracklist-fw"
+
+func trackList(members ...string) *v1alpha1.TrackList {
+	return &v1alpha1.TrackList{Spec: v1alpha1.TrackListSpec{ForProvider: v1alpha1.TrackListParameters{
+		Name:           "fw",
+		Tenant:         "web",
+		Type:           "percentage",
+		PercentageUp:   "1",
+		PercentageDown: "0",
+		WeightUp:       "1",
+		WeightDown:     "0",
+		Members:        members,
+	}}}
+}
+
+// apic returns a fake APIC with the track list fw of the track members fw1
+// and fw2.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet("/api/node/mo/"+listDn+".json",
+		`{"totalCount":"1","imdata":[{"fvTrackList":{"attributes":{"dn":"`+listDn+`","name":"fw","descr":"","type":"percentage","percentageUp":"1","percentageDown":"0","weightUp":"1","weightDown":"0"}}}]}`)
+	s.RespondChildren("/api/node/mo/"+listDn+".json", `{"totalCount":"2","imdata":[
+{"fvRsOtmListMember":{"attributes":{"dn":"`+listDn+`/rsotmListMember-[uni/tn-web/trackmember-fw2]","tDn":"uni/tn-web/trackmember-fw2"}}},
+{"fvRsOtmListMember":{"attributes":{"dn":"`+listDn+`/rsotmListMember-[uni/tn-web/trackmember-fw1]","tDn":"uni/tn-web/trackmember-fw1"}}}]}`)
+	return s
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotTrackList": {
+			reason: "An error should be returned if the managed resource is not a TrackList",
+			want: want{
+				err: errors.New(errNotTrackList),
+			},
+		},
+		"UpToDate": {
+			reason: "The members should be read back by name, whatever their order",
+			mg:     trackList("fw1", "fw2"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"MemberRemoved": {
+			reason: "A member that is no longer desired should be drift",
+			mg:     trackList("fw1"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	s := apic()
+	defer s.Close()
+
+	e := external{apicClient: s.APICClient()}
+	if _, err := e.Update(context.Background(), trackList("fw1", "fw3")); err != nil {
+		t.Fatalf("e.Update(...): %s", err)
+	}
+	posts := s.Posts()
+	saved := []string{
+		listDn + "/rsotmListMember-[uni/tn-web/trackmember-fw1]",
+		listDn + "/rsotmListMember-[uni/tn-web/trackmember-fw3]",
+	}
+	if diff := cmp.Diff(saved, fakeapic.Saved(posts, "fvRsOtmListMember")); diff != "" {
+		t.Errorf("e.Update(...): -want saved, +got saved:\n%s\n", diff)
+	}
+	deleted := []string{listDn + "/rsotmListMember-[uni/tn-web/trackmember-fw2]"}
+	if diff := cmp.Diff(deleted, fakeapic.Deleted(posts)); diff != "" {
+		t.Errorf("e.Update(...): only the member that is no longer desired should be removed: -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trackmember
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/services/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const memberPath = "/api/node/mo/uni/tn-web/trackmember-fw1.json"
+
+func trackMember(bd, l3Out, ipsla string) *v1alpha1.TrackMember {
+	return &v1alpha1.TrackMember{Spec: v1alpha1.TrackMemberSpec{ForProvider: v1alpha1.TrackMemberParameters{
+		Name:                  "fw1",
+		Tenant:                "web",
+		DestinationIP:         "10.0.0.1",
+		BridgeDomain:          bd,
+		L3Out:                 l3Out,
+		IPSLAMonitoringPolicy: ipsla,
+	}}}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotTrackMember": {
+			reason: "An error should be returned if the managed resource is not a TrackMember",
+			want: want{
+				err: errors.New(errNotTrackMember),
+			},
+		},
+		"UpToDate": {
+			reason: "The bridge domain should be read back from the scope of the track member",
+			mg:     trackMember("web", "", "icmp"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"ScopeChanged": {
+			reason: "A track member scoped to a bridge domain instead of the desired L3Out should be drift",
+			mg:     trackMember("", "web", "icmp"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"IPSLAMonitoringPolicyChanged": {
+			reason: "A track member monitored by another IP SLA monitoring policy should be drift",
+			mg:     trackMember("web", "", "tcp"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := fakeapic.New()
+			defer s.Close()
+			s.RespondGet(memberPath,
+				`{"totalCount":"1","imdata":[{"fvTrackMember":{"attributes":{"dn":"uni/tn-web/trackmember-fw1","name":"fw1","descr":"","dstIpAddr":"10.0.0.1","scopeDn":"uni/tn-web/BD-web"}}}]}`)
+			s.RespondGet("/api/node/mo/uni/tn-web/trackmember-fw1/rsIpslaMonPol.json",
+				`{"totalCount":"1","imdata":[{"fvRsIpslaMonPol":{"attributes":{"dn":"uni/tn-web/trackmember-fw1/rsIpslaMonPol","tDn":"uni/tn-web/ipslaMonitoringPol-icmp"}}}]}`)
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NotTrackMember": {
+			reason: "An error should be returned if the managed resource is not a TrackMember",
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				err: errors.New(errNotTrackMember),
+			},
+		},
+		"BridgeDomainAndL3Out": {
+			reason: "An error should be returned if both a bridge domain and an L3Out are set",
+			args: args{
+				ctx: context.Background(),
+				mg: &v1alpha1.TrackMember{Spec: v1alpha1.TrackMemberSpec{ForProvider: v1alpha1.TrackMemberParameters{
+					Name:          "fw1",
+					DestinationIP: "10.0.0.1",
+					BridgeDomain:  "web",
+					L3Out:         "internet",
+				}}},
+			},
+			want: want{
+				err: errors.Wrap(errors.New("exactly one of bridgeDomain and l3Out is required"), errInvalidScope),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{apicClient: nil}
+			got, err := e.Create(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vmmcontroller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/vmm/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	controllerPath = "/api/node/mo/uni/vmmp-VMware/dom-prod/ctrlr-vcenter.json"
+	credentialPath = "/api/node/class/uni/vmmp-VMware/dom-prod/ctrlr-vcenter/vmmRsAcc.json"
+
+	vcenter    = `{"totalCount":"1","imdata":[{"vmmCtrlrP":{"attributes":{"dn":"uni/vmmp-VMware/dom-prod/ctrlr-vcenter","name":"vcenter","nameAlias":"","hostOrIp":"10.0.0.10","rootContName":"dc1","dvsVersion":"unmanaged","statsMode":"enabled"}}}]}`
+	credential = `{"totalCount":"1","imdata":[{"vmmRsAcc":{"attributes":{"dn":"uni/vmmp-VMware/dom-prod/ctrlr-vcenter/rsacc","tDn":"uni/vmmp-VMware/dom-prod/usracc-admin"}}}]}`
+)
+
+func vmmController(credential string) *v1alpha1.VMMController {
+	return &v1alpha1.VMMController{Spec: v1alpha1.VMMControllerSpec{ForProvider: v1alpha1.VMMControllerParameters{
+		Name:            "vcenter",
+		VMMDomain:       "prod",
+		HostOrIP:        "10.0.0.10",
+		Datacenter:      "dc1",
+		Credential:      credential,
+		DvsVersion:      "unmanaged",
+		StatsCollection: "enabled",
+	}}}
+}
+
+// apic returns a fake APIC with the VMM controller vcenter of the VMM domain
+// prod, associated with the credential admin.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet(controllerPath, vcenter)
+	s.RespondGet(credentialPath, credential)
+	return s
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotVMMController": {
+			reason: "An error should be returned if the managed resource is not a VMMController",
+			want: want{
+				err: errors.New(errNotVMMController),
+			},
+		},
+		"UpToDate": {
+			reason: "The credential should be observed by the name of the target of its relation",
+			mg:     vmmController("admin"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"CredentialChanged": {
+			reason: "A VMM controller associated with another credential should not be up to date",
+			mg:     vmmController("readonly"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		credential string
+		deleted    []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.VMMController
+		want   want
+	}{
+		"CredentialChanged": {
+			reason: "The VMM controller should be associated with the desired credential",
+			mg:     vmmController("readonly"),
+			want: want{
+				credential: "uni/vmmp-VMware/dom-prod/usracc-readonly",
+			},
+		},
+		"CredentialRemoved": {
+			reason: "The association with the credential should be deleted if none is desired",
+			mg:     vmmController(""),
+			want: want{
+				deleted: []string{"uni/vmmp-VMware/dom-prod/ctrlr-vcenter/rsacc"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): %s", tc.reason, err)
+			}
+			credential := ""
+			for _, p := range s.Posts() {
+				if tDn := fakeapic.Attribute(p.Body, "vmmRsAcc", "tDn"); tDn != "" {
+					credential = tDn
+				}
+			}
+			if diff := cmp.Diff(tc.want.credential, credential); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want credential, +got credential:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, fakeapic.Deleted(s.Posts())); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
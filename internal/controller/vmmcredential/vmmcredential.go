@@ -0,0 +1,287 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vmmcredential
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/ciscoecosystem/aci-go-client/v2/models"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	apisv1alpha1 "github.com/jgomezve/provider-aci/apis/v1alpha1"
+	"github.com/jgomezve/provider-aci/apis/vmm/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/secretversion"
+	vmmcredentialutil "github.com/jgomezve/provider-aci/internal/clients/vmmcredential"
+	"github.com/jgomezve/provider-aci/internal/features"
+	"github.com/jgomezve/provider-aci/internal/subscription"
+)
+
+const (
+	errNotVMMCredential = "managed resource is not a VMMCredential custom resource"
+	errTrackPCUsage     = "cannot track ProviderConfig usage"
+	errGetPC            = "cannot get ProviderConfig"
+	errGetCreds         = "cannot get credentials"
+
+	errNewClient      = "cannot create new Service"
+	errGetPassword    = "cannot get password"
+	errRecordPassword = "cannot record password version"
+)
+
+// A NoOpService does nothing.
+type NoOpService struct{}
+
+// var (
+// 	newNoOpService = func(_ []byte) (interface{}, error) { return &NoOpService{}, nil }
+// )
+
+// Setup adds a controller that reconciles VMMCredential managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.VMMCredentialGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.VMMCredentialGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: aciclient.NewClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.VMMCredential{})
+	return subscription.Complete(mgr, o, b, v1alpha1.VMMCredentialGroupVersionKind, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(clientUrl, username string, options ...aciclient.Option) *aciclient.Client
+}
+
+type SecretData struct {
+	Url      string `json:"url"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Insecure bool   `json:"insecure"`
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+
+	var secretData SecretData
+	cr, ok := mg.(*v1alpha1.VMMCredential)
+	if !ok {
+		return nil, errors.New(errNotVMMCredential)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+	err = json.Unmarshal(data, &secretData)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := c.newServiceFn(secretData.Url, secretData.Username, aciclient.Password(secretData.Password), aciclient.Insecure(secretData.Insecure))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{apicClient: svc, kube: c.kube}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	apicClient *aciclient.Client
+	// The password of the credential is read from a Secret.
+	kube client.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.VMMCredential)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotVMMCredential)
+	}
+
+	dn := fmt.Sprintf("uni/vmmp-VMware/dom-%s/usracc-%s", cr.Spec.ForProvider.VMMDomain, cr.Spec.ForProvider.Name)
+	vmmUsrAccPCont, err := c.apicClient.Get(dn)
+
+	if err != nil {
+		if fmt.Sprintf("%s", err) != "Error retrieving Object: Object may not exist" {
+			return managed.ExternalObservation{}, err
+		}
+	}
+	if count := models.G(vmmUsrAccPCont, "totalCount"); count == "0" {
+		return managed.ExternalObservation{}, nil
+	}
+	vmmUsrAccP := models.VMMCredentialFromContainer(vmmUsrAccPCont)
+
+	if vmmUsrAccP.DistinguishedName == "" {
+		return managed.ExternalObservation{}, fmt.Errorf("VMM credential %s not found", dn)
+	}
+	// LateInitializer not required for ACI
+
+	cr.SetConditions(xpv1.Available())
+
+	_, version, err := secretversion.Read(ctx, c.kube, cr.Spec.ForProvider.PasswordSecretRef)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPassword)
+	}
+
+	cr.Status.AtProvider.Dn = vmmUsrAccP.DistinguishedName
+	return managed.ExternalObservation{
+		// // Return false when the external resource does not exist. This lets
+		// // the managed resource reconciler know that it needs to call Create to
+		// // (re)create the resource, or that it has successfully been deleted.
+		ResourceExists: true,
+
+		// // Return false when the external resource exists, but it not up to date
+		// // with the desired managed resource state. This lets the managed
+		// // resource reconciler know that it needs to call Update.
+		ResourceUpToDate: vmmcredentialutil.IsUptoDate(cr.Spec.ForProvider, vmmUsrAccP.VMMCredentialAttributes, vmmUsrAccP.Description, vmmUsrAccP.NameAlias) &&
+			!secretversion.Changed(cr, vmmcredentialutil.AnnotationKeyPasswordVersion, version),
+
+		// // Return any details that may be required to connect to the external
+		// // resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.VMMCredential)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotVMMCredential)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	vmmUsrAccP, version, err := c.newVMMCredential(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	err = c.apicClient.Save(vmmUsrAccP)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "Cannot create VMM Credential")
+	}
+	secretversion.Record(cr, vmmcredentialutil.AnnotationKeyPasswordVersion, version)
+
+	return managed.ExternalCreation{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.VMMCredential)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotVMMCredential)
+	}
+
+	vmmUsrAccP, version, err := c.newVMMCredential(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	vmmUsrAccP.Status = "modified"
+	err = c.apicClient.Save(vmmUsrAccP)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot update VMM Credential")
+	}
+	if secretversion.Changed(cr, vmmcredentialutil.AnnotationKeyPasswordVersion, version) {
+		if err := secretversion.Persist(ctx, c.kube, cr, vmmcredentialutil.AnnotationKeyPasswordVersion, version); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRecordPassword)
+		}
+	}
+	return managed.ExternalUpdate{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.VMMCredential)
+	if !ok {
+		return errors.New(errNotVMMCredential)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	dn := fmt.Sprintf("uni/vmmp-VMware/dom-%s/usracc-%s", cr.Spec.ForProvider.VMMDomain, cr.Spec.ForProvider.Name)
+	err := c.apicClient.DeleteByDn(dn, "vmmUsrAccP")
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// newVMMCredential returns the vmmUsrAccP of the supplied credential and the
+// version of the Secret of its password.
+func (c *external) newVMMCredential(ctx context.Context, cr *v1alpha1.VMMCredential) (*models.VMMCredential, string, error) {
+	pwd, version, err := secretversion.Read(ctx, c.kube, cr.Spec.ForProvider.PasswordSecretRef)
+	if err != nil {
+		return nil, "", errors.Wrap(err, errGetPassword)
+	}
+	vmmUsrAccPAttr := models.VMMCredentialAttributes{}
+	vmmUsrAccPAttr.Name = cr.Spec.ForProvider.Name
+	vmmUsrAccPAttr.Usr = cr.Spec.ForProvider.Username
+	vmmUsrAccPAttr.Pwd = string(pwd)
+	return models.NewVMMCredential(fmt.Sprintf("usracc-%s", cr.Spec.ForProvider.Name), fmt.Sprintf("uni/vmmp-VMware/dom-%s", cr.Spec.ForProvider.VMMDomain), cr.Spec.ForProvider.Description, cr.Spec.ForProvider.NameAlias, vmmUsrAccPAttr), version, nil
+}
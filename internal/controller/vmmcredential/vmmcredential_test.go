@@ -0,0 +1,155 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vmmcredential
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/vmm/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+	vmmcredentialutil "github.com/jgomezve/provider-aci/internal/clients/vmmcredential"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	credentialPath = "/api/node/mo/uni/vmmp-VMware/dom-vcenter/usracc-admin.json"
+
+	credential = `{"totalCount":"1","imdata":[{"vmmUsrAccP":{"attributes":{"dn":"uni/vmmp-VMware/dom-vcenter/usracc-admin","name":"admin","usr":"administrator@vsphere.local","descr":"","nameAlias":""}}}]}`
+
+	// pushedVersion is the version of the Secret of the password last
+	// pushed to APIC.
+	pushedVersion = "uid/1"
+)
+
+// vmmCredential returns a VMMCredential whose password was last pushed from
+// the Secret with the supplied version.
+func vmmCredential(version string) *v1alpha1.VMMCredential {
+	return &v1alpha1.VMMCredential{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "admin",
+			Annotations: map[string]string{vmmcredentialutil.AnnotationKeyPasswordVersion: version},
+		},
+		Spec: v1alpha1.VMMCredentialSpec{ForProvider: v1alpha1.VMMCredentialParameters{
+			Name:      "admin",
+			VMMDomain: "vcenter",
+			Username:  "administrator@vsphere.local",
+			PasswordSecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Name: "vcenter", Namespace: "crossplane-system"},
+				Key:             "password",
+			},
+		}},
+	}
+}
+
+// secret returns a client of the Secret of the password with the supplied
+// UID and resource version.
+func secret(uid, resourceVersion string) *test.MockClient {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			s := obj.(*corev1.Secret)
+			s.SetUID(types.UID(uid))
+			s.SetResourceVersion(resourceVersion)
+			s.Data = map[string][]byte{"password": []byte("s3cr3t")}
+			return nil
+		},
+		MockUpdate: test.NewMockUpdateFn(nil),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		upToDate bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		kube   client.Client
+		want   want
+	}{
+		"NotVMMCredential": {
+			reason: "An error should be returned if the managed resource is not a VMMCredential",
+			want: want{
+				err: errors.New(errNotVMMCredential),
+			},
+		},
+		"SecretUnchanged": {
+			reason: "The credential should be up to date if its Secret did not change since its password was pushed",
+			mg:     vmmCredential(pushedVersion),
+			kube:   secret("uid", "1"),
+			want: want{
+				upToDate: true,
+			},
+		},
+		"SecretRotated": {
+			reason: "The credential should not be up to date if its Secret changed since its password was pushed",
+			mg:     vmmCredential(pushedVersion),
+			kube:   secret("uid", "2"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apic := fakeapic.New()
+			defer apic.Close()
+			apic.RespondGet(credentialPath, credential)
+
+			e := external{apicClient: apic.APICClient(), kube: tc.kube}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if err == nil && (!got.ResourceExists || got.ResourceUpToDate != tc.want.upToDate) {
+				t.Errorf("\n%s\ne.Observe(...): want exists and up to date %t, got %+v", tc.reason, tc.want.upToDate, got)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	apic := fakeapic.New()
+	defer apic.Close()
+
+	mg := vmmCredential(pushedVersion)
+	e := external{apicClient: apic.APICClient(), kube: secret("uid", "2")}
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("e.Update(...): %s", err)
+	}
+	if diff := cmp.Diff("uid/2", mg.GetAnnotations()[vmmcredentialutil.AnnotationKeyPasswordVersion]); diff != "" {
+		t.Errorf("e.Update(...): the version of the rotated Secret should be recorded: -want, +got:\n%s\n", diff)
+	}
+}
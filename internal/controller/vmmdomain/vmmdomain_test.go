@@ -0,0 +1,188 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vmmdomain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/vmm/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	domainPath   = "/api/node/mo/uni/vmmp-VMware/dom-prod.json"
+	vlanPoolPath = "/api/node/class/uni/vmmp-VMware/dom-prod/infraRsVlanNs.json"
+	lagsPath     = "/api/node/mo/uni/vmmp-VMware/dom-prod/vswitchpolcont.json"
+
+	domain   = `{"totalCount":"1","imdata":[{"vmmDomP":{"attributes":{"dn":"uni/vmmp-VMware/dom-prod","name":"prod","nameAlias":"","accessMode":"read-write"}}}]}`
+	vlanPool = `{"totalCount":"1","imdata":[{"infraRsVlanNs":{"attributes":{"dn":"uni/vmmp-VMware/dom-prod/rsvlanNs","tDn":"uni/infra/vlanns-[vmm]-dynamic"}}}]}`
+	// lags are the enhanced LAG policies lag-b and lag-a of the VMM domain,
+	// not sorted by name.
+	lags = `{"totalCount":"2","imdata":[
+{"lacpEnhancedLagPol":{"attributes":{"dn":"uni/vmmp-VMware/dom-prod/vswitchpolcont/enlacplagp-lag-b","name":"lag-b","mode":"active","lbmode":"src-dst-ip","numLinks":"2"}}},
+{"lacpEnhancedLagPol":{"attributes":{"dn":"uni/vmmp-VMware/dom-prod/vswitchpolcont/enlacplagp-lag-a","name":"lag-a","mode":"active","lbmode":"src-dst-ip","numLinks":"4"}}}]}`
+)
+
+var (
+	lagA = v1alpha1.EnhancedLagPolicy{Name: "lag-a", Mode: "active", LoadBalancingMode: "src-dst-ip", NumberOfLinks: "4"}
+	lagB = v1alpha1.EnhancedLagPolicy{Name: "lag-b", Mode: "active", LoadBalancingMode: "src-dst-ip", NumberOfLinks: "2"}
+)
+
+func vmmDomain(vlanPool string, lags ...v1alpha1.EnhancedLagPolicy) *v1alpha1.VMMDomain {
+	return &v1alpha1.VMMDomain{Spec: v1alpha1.VMMDomainSpec{ForProvider: v1alpha1.VMMDomainParameters{
+		Name:                   "prod",
+		AccessMode:             "read-write",
+		VlanPool:               vlanPool,
+		VlanPoolAllocationMode: "dynamic",
+		EnhancedLagPolicies:    lags,
+	}}}
+}
+
+// apic returns a fake APIC with the VMM domain prod, its VLAN pool and its
+// enhanced LAG policies.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet(domainPath, domain)
+	s.RespondGet(vlanPoolPath, vlanPool)
+	s.RespondChildren(lagsPath, lags)
+	return s
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotVMMDomain": {
+			reason: "An error should be returned if the managed resource is not a VMMDomain",
+			want: want{
+				err: errors.New(errNotVMMDomain),
+			},
+		},
+		"UpToDate": {
+			reason: "The VLAN pool and the enhanced LAG policies should match whatever their order",
+			mg:     vmmDomain("vmm", lagA, lagB),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"VlanPoolChanged": {
+			reason: "A VMM domain associated with another VLAN pool should not be up to date",
+			mg:     vmmDomain("vcenter", lagA, lagB),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"LagPolicyRemoved": {
+			reason: "A VMM domain with an enhanced LAG policy that is no longer desired should not be up to date",
+			mg:     vmmDomain("vmm", lagA),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		saved   []string
+		deleted []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.VMMDomain
+		want   want
+	}{
+		"LagPolicyRemoved": {
+			reason: "The enhanced LAG policies that are no longer desired should be deleted",
+			mg:     vmmDomain("vmm", lagA),
+			want: want{
+				saved:   []string{"uni/vmmp-VMware/dom-prod/vswitchpolcont/enlacplagp-lag-a"},
+				deleted: []string{"uni/vmmp-VMware/dom-prod/vswitchpolcont/enlacplagp-lag-b"},
+			},
+		},
+		"VlanPoolRemoved": {
+			reason: "The association with the VLAN pool should be deleted if none is desired",
+			mg:     vmmDomain("", lagA, lagB),
+			want: want{
+				saved: []string{
+					"uni/vmmp-VMware/dom-prod/vswitchpolcont/enlacplagp-lag-a",
+					"uni/vmmp-VMware/dom-prod/vswitchpolcont/enlacplagp-lag-b",
+				},
+				deleted: []string{"uni/vmmp-VMware/dom-prod/rsvlanNs"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): %s", tc.reason, err)
+			}
+			posts := s.Posts()
+			if diff := cmp.Diff(tc.want.saved, fakeapic.Saved(posts, "lacpEnhancedLagPol")); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want saved, +got saved:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, fakeapic.Deleted(posts)); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -34,12 +34,15 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
-	"github.com/ciscoecosystem/aci-go-client/v2/models"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
 	apisv1alpha1 "github.com/jgomezve/provider-aci/apis/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/mo"
+	securitydomainutil "github.com/jgomezve/provider-aci/internal/clients/securitydomain"
+	"github.com/jgomezve/provider-aci/internal/clients/snapshot"
 	vrfutil "github.com/jgomezve/provider-aci/internal/clients/vrf"
 	"github.com/jgomezve/provider-aci/internal/features"
+	"github.com/jgomezve/provider-aci/internal/subscription"
 )
 
 const (
@@ -49,6 +52,7 @@ const (
 	errGetCreds     = "cannot get credentials"
 
 	errNewClient = "cannot create new Service"
+	errSnapshot  = "cannot snapshot tenant before change"
 )
 
 // A NoOpService does nothing.
@@ -68,23 +72,26 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.VrfGroupVersionKind),
 		managed.WithExternalConnecter(&connector{
 			kube:         mgr.GetClient(),
 			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			recorder:     recorder,
 			newServiceFn: aciclient.NewClient}),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...))
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		WithEventFilter(resource.DesiredStateChanged()).
-		For(&v1alpha1.Vrf{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		For(&v1alpha1.Vrf{})
+	return subscription.Complete(mgr, o, b, v1alpha1.VrfGroupVersionKind, ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
 
 // A connector is expected to produce an ExternalClient when its Connect method
@@ -92,6 +99,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube         client.Client
 	usage        resource.Tracker
+	recorder     event.Recorder
 	newServiceFn func(clientUrl, username string, options ...aciclient.Option) *aciclient.Client
 }
 
@@ -140,7 +148,7 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
-	return &external{apicClient: svc}, nil
+	return &external{apicClient: svc, snapshotter: snapshot.NewSnapshotter(svc, c.kube, c.recorder, pc)}, nil
 }
 
 // An ExternalClient observes, then either creates, updates, or deletes an
@@ -149,6 +157,9 @@ type external struct {
 	// A 'client' used to connect to the external resource API. In practice this
 	// would be something like an AWS SDK client.
 	apicClient *aciclient.Client
+	// snapshotter takes a snapshot of the tenant before destructive changes,
+	// if enabled in the ProviderConfig.
+	snapshotter *snapshot.Snapshotter
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -157,28 +168,20 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotVrf)
 	}
 
-	dn := fmt.Sprintf("uni/tn-%s/ctx-%s", cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Name)
-	fvCtxCont, err := c.apicClient.Get(dn)
-
+	dn := vrfutil.VrfDn(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Name)
+	fvCtx, err := mo.Read(c.apicClient, dn, vrfutil.FvCtxClassName)
 	if err != nil {
-		if fmt.Sprintf("%s", err) != "Error retrieving Object: Object may not exist" {
-			return managed.ExternalObservation{}, err
-		}
+		return managed.ExternalObservation{}, err
 	}
-	if count := models.G(fvCtxCont, "totalCount"); count == "0" {
+	if fvCtx == nil {
 		return managed.ExternalObservation{}, nil
 	}
-	fvCtx := models.VRFFromContainer(fvCtxCont)
-
-	if fvCtx.DistinguishedName == "" {
-		return managed.ExternalObservation{}, fmt.Errorf("VRF %s not found", dn)
-	}
 	// LateInitializer not required for ACI
 
 	cr.SetConditions(xpv1.Available())
 
-	cr.Status.AtProvider.PcTag = models.G(fvCtxCont.S("imdata").Index(0).S("fvCtx", "attributes"), "pcTag")
-	cr.Status.AtProvider.Dn = models.G(fvCtxCont.S("imdata").Index(0).S("fvCtx", "attributes"), "dn")
+	cr.Status.AtProvider.PcTag = fvCtx["pcTag"]
+	cr.Status.AtProvider.Dn = fvCtx["dn"]
 	return managed.ExternalObservation{
 		// // Return false when the external resource does not exist. This lets
 		// // the managed resource reconciler know that it needs to call Create to
@@ -188,7 +191,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		// // Return false when the external resource exists, but it not up to date
 		// // with the desired managed resource state. This lets the managed
 		// // resource reconciler know that it needs to call Update.
-		ResourceUpToDate: vrfutil.IsUptoDate(cr.Spec.ForProvider, fvCtx.VRFAttributes),
+		ResourceUpToDate: vrfutil.IsUptoDate(c.apicClient, cr, fvCtx),
 
 		// // Return any details that may be required to connect to the external
 		// // resource. These will be stored as the connection secret.
@@ -205,13 +208,17 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	fmt.Printf("Creating: %+v", cr)
 	cr.SetConditions(xpv1.Creating())
 
-	fvCtxAttr := models.VRFAttributes{}
-	fvCtxAttr.NameAlias = cr.Spec.ForProvider.NameAlias
-	fvCtx := models.NewVRF(fmt.Sprintf("ctx-%s", cr.Spec.ForProvider.Name), fmt.Sprintf("uni/tn-%s", cr.Spec.ForProvider.Tenant), "", fvCtxAttr)
+	fvCtx := vrfutil.NewVrf(cr.Spec.ForProvider)
 	err := c.apicClient.Save(fvCtx)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, "Cannot create VRF")
 	}
+	if err := vrfutil.ReconcileRelations(c.apicClient, fvCtx.Dn, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "Cannot create VRF relations")
+	}
+	if err := securitydomainutil.ReconcileDomainRefs(c.apicClient, fvCtx.Dn, cr.Spec.ForProvider.SecurityDomains); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "Cannot update VRF security domains")
+	}
 
 	return managed.ExternalCreation{
 		// Optionally return any details that may be required to connect to the
@@ -227,14 +234,23 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	fmt.Printf("Updating: %+v", cr)
-	fvCtxAttr := models.VRFAttributes{}
-	fvCtxAttr.NameAlias = cr.Spec.ForProvider.NameAlias
-	fvCtx := models.NewVRF(fmt.Sprintf("ctx-%s", cr.Spec.ForProvider.Name), fmt.Sprintf("uni/tn-%s", cr.Spec.ForProvider.Tenant), "", fvCtxAttr)
+	fvCtx := vrfutil.NewVrf(cr.Spec.ForProvider)
+	if !vrfutil.RelationsUpToDate(c.apicClient, fvCtx.Dn, cr.Spec.ForProvider) {
+		if err := c.snapshotter.Take(ctx, cr, cr.Spec.ForProvider.Tenant, fmt.Sprintf("changing the relations of %s", fvCtx.Dn)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSnapshot)
+		}
+	}
 	fvCtx.Status = "modified"
 	err := c.apicClient.Save(fvCtx)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot Update VRF")
 	}
+	if err := vrfutil.ReconcileRelations(c.apicClient, fvCtx.Dn, cr.Spec.ForProvider); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot update VRF relations")
+	}
+	if err := securitydomainutil.ReconcileDomainRefs(c.apicClient, fvCtx.Dn, cr.Spec.ForProvider.SecurityDomains); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "Cannot update VRF security domains")
+	}
 	return managed.ExternalUpdate{
 		// Optionally return any details that may be required to connect to the
 		// external resource. These will be stored as the connection secret.
@@ -249,8 +265,11 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 	}
 
 	cr.SetConditions(xpv1.Deleting())
-	dn := fmt.Sprintf("uni/tn-%s/ctx-%s", cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Name)
-	err := c.apicClient.DeleteByDn(dn, "fvCtx")
+	dn := vrfutil.VrfDn(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Name)
+	if err := c.snapshotter.Take(ctx, cr, cr.Spec.ForProvider.Tenant, fmt.Sprintf("deleting %s", dn)); err != nil {
+		return errors.Wrap(err, errSnapshot)
+	}
+	err := c.apicClient.DeleteByDn(dn, vrfutil.FvCtxClassName)
 	if err != nil {
 		return err
 	}
@@ -19,12 +19,20 @@ package vrf
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	apisv1alpha1 "github.com/jgomezve/provider-aci/apis/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+	"github.com/jgomezve/provider-aci/internal/clients/snapshot"
 )
 
 // Unlike many Kubernetes projects Crossplane does not use third party testing
@@ -73,3 +81,135 @@ func TestObserve(t *testing.T) {
 		})
 	}
 }
+
+// exports returns how many tenant snapshots were triggered by the supplied
+// POSTs.
+func exports(posts []fakeapic.Request) int {
+	n := 0
+	for _, p := range posts {
+		if fakeapic.Attribute(p.Body, "configExportP", "adminSt") == "triggered" {
+			n++
+		}
+	}
+	return n
+}
+
+// changed returns whether the supplied POSTs changed the VRF.
+func changed(posts []fakeapic.Request) bool {
+	for _, p := range posts {
+		if fakeapic.Attribute(p.Body, "fvCtx", "dn") != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		exports int
+		// changed is whether the VRF was changed before the snapshot
+		// completed.
+		changed bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		p      v1alpha1.VrfParameters
+		want   want
+	}{
+		"RelationsUpToDate": {
+			reason: "No snapshot should be taken if the relations and the vzAny of the VRF would not change",
+			p:      v1alpha1.VrfParameters{Tenant: "crossplane", Name: "web"},
+			want:   want{changed: true},
+		},
+		"PolicyChanged": {
+			reason: "A snapshot should be taken before a policy relation of the VRF is changed",
+			p:      v1alpha1.VrfParameters{Tenant: "crossplane", Name: "web", BgpTimersPolicy: "fast"},
+			want:   want{exports: 1},
+		},
+		"EigrpChanged": {
+			reason: "A snapshot should be taken before an EIGRP address family policy of the VRF is changed",
+			p: v1alpha1.VrfParameters{Tenant: "crossplane", Name: "web", EigrpAddressFamilyPolicies: []v1alpha1.VrfEigrpAddressFamilyPolicy{
+				{AddressFamily: "ipv4-ucast", Policy: "eigrp"},
+			}},
+			want: want{exports: 1},
+		},
+		"VzAnyChanged": {
+			reason: "A snapshot should be taken before the contracts of the vzAny of the VRF are changed",
+			p:      v1alpha1.VrfParameters{Tenant: "crossplane", Name: "web", VzAny: v1alpha1.VrfVzAny{ConsumedContracts: []string{"web"}}},
+			want:   want{exports: 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apic := fakeapic.New()
+			defer apic.Close()
+
+			kube := &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}
+			pc := &apisv1alpha1.ProviderConfig{Spec: apisv1alpha1.ProviderConfigSpec{Snapshot: &apisv1alpha1.SnapshotConfig{BeforeChanges: true}}}
+			e := external{
+				apicClient:  apic.APICClient(),
+				snapshotter: snapshot.NewSnapshotter(apic.APICClient(), kube, event.NewNopRecorder(), pc),
+			}
+			cr := &v1alpha1.Vrf{ObjectMeta: metav1.ObjectMeta{Name: "web"}, Spec: v1alpha1.VrfSpec{ForProvider: tc.p}}
+
+			// The fake APIC never completes a snapshot, the change has to
+			// wait for it until the reconcile times out.
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			_, _ = e.Update(ctx, cr)
+			posts := apic.Posts()
+			if got := exports(posts); got != tc.want.exports {
+				t.Errorf("\n%s\ne.Update(...): want %d snapshots, got %d", tc.reason, tc.want.exports, got)
+			}
+			if got := changed(posts); got != tc.want.changed {
+				t.Errorf("\n%s\ne.Update(...): want VRF changed %t, got %t", tc.reason, tc.want.changed, got)
+			}
+		})
+	}
+}
+
+func TestEigrpAddressFamilyPolicy(t *testing.T) {
+	const vrfDn = "uni/tn-crossplane/ctx-web"
+	cr := &v1alpha1.Vrf{ObjectMeta: metav1.ObjectMeta{Name: "web"}, Spec: v1alpha1.VrfSpec{ForProvider: v1alpha1.VrfParameters{
+		Tenant: "crossplane", Name: "web", PcEnfPref: "enforced",
+		EigrpAddressFamilyPolicies: []v1alpha1.VrfEigrpAddressFamilyPolicy{{AddressFamily: "ipv4-ucast", Policy: "eigrp"}},
+	}}}
+
+	created := fakeapic.New()
+	defer created.Close()
+	e := external{apicClient: created.APICClient()}
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("e.Update(...): %s", err)
+	}
+	want := []string{vrfDn + "/rsctxToEigrpCtxAfPol-[eigrp]-ipv4-ucast"}
+	if diff := cmp.Diff(want, fakeapic.Saved(created.Posts(), "fvRsCtxToEigrpCtxAfPol")); diff != "" {
+		t.Fatalf("e.Update(...): the relation should be named after its policy and address family: -want, +got:\n%s\n", diff)
+	}
+
+	// The APIC now reports the relation with the DN it was created with.
+	apic := fakeapic.New()
+	defer apic.Close()
+	apic.RespondGet("/api/node/mo/"+vrfDn+".json",
+		`{"totalCount":"1","imdata":[{"fvCtx":{"attributes":{"dn":"`+vrfDn+`","name":"web","nameAlias":"","descr":"","pcEnfPref":"enforced","pcEnfDir":"","ipDataPlaneLearning":"","knwMcastAct":"","bdEnforcedEnable":""}}}]}`)
+	apic.RespondChildren("/api/node/mo/"+vrfDn+".json",
+		`{"totalCount":"1","imdata":[{"fvRsCtxToEigrpCtxAfPol":{"attributes":{"dn":"`+want[0]+`","af":"ipv4-ucast","tnEigrpCtxAfPolName":"eigrp"}}}]}`)
+	e = external{apicClient: apic.APICClient()}
+	o, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %s", err)
+	}
+	if !o.ResourceUpToDate {
+		t.Errorf("e.Observe(...): the VRF with its created relation should be up to date")
+	}
+	if posts := apic.Posts(); len(posts) != 0 {
+		t.Errorf("e.Observe(...): want no POST, got %d", len(posts))
+	}
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("e.Update(...): %s", err)
+	}
+	if deleted := fakeapic.Deleted(apic.Posts()); len(deleted) != 0 {
+		t.Errorf("e.Update(...): the relation should not be deleted and re-added, got deleted %v", deleted)
+	}
+}
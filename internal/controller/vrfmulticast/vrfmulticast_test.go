@@ -0,0 +1,175 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vrfmulticast
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const pimDn = "uni/tn-web/ctx-web/pimctxp"
+
+func vrfMulticast(p v1alpha1.VrfMulticastParameters) *v1alpha1.VrfMulticast {
+	p.Tenant = "web"
+	p.Vrf = "web"
+	p.Mtu = "1500"
+	return &v1alpha1.VrfMulticast{Spec: v1alpha1.VrfMulticastSpec{ForProvider: p}}
+}
+
+// apic returns a fake APIC with PIM enabled on the VRF web. It has two static
+// rendezvous points, the first one only serves the groups of the route map
+// rp-groups, forwards the Auto-RP messages but not the BSR ones and limits
+// SSM to the groups of the route map ssm.
+func apic() *fakeapic.Server {
+	s := fakeapic.New()
+	s.RespondGet("/api/node/mo/"+pimDn+".json",
+		`{"totalCount":"1","imdata":[{"pimCtxP":{"attributes":{"dn":"`+pimDn+`","mtu":"1500","ctrl":""}}}]}`)
+	s.RespondChildren("/api/node/mo/"+pimDn+"/staticrp.json", `{"totalCount":"2","imdata":[
+{"pimStaticRPEntryPol":{"attributes":{"dn":"`+pimDn+`/staticrp/staticrpent-[10.0.0.2]","rpIp":"10.0.0.2"}}},
+{"pimStaticRPEntryPol":{"attributes":{"dn":"`+pimDn+`/staticrp/staticrpent-[10.0.0.1]","rpIp":"10.0.0.1"}}}]}`)
+	s.RespondGet("/api/node/mo/"+pimDn+"/staticrp/staticrpent-[10.0.0.1]/rpgrprange/rsfilterToRtMapPol.json",
+		`{"totalCount":"1","imdata":[{"rtdmcRsFilterToRtMapPol":{"attributes":{"dn":"`+pimDn+`/staticrp/staticrpent-[10.0.0.1]/rpgrprange/rsfilterToRtMapPol","tDn":"uni/tn-web/rtmap-rp-groups"}}}]}`)
+	s.RespondGet("/api/node/mo/"+pimDn+"/autorp.json",
+		`{"totalCount":"1","imdata":[{"pimAutoRPPol":{"attributes":{"dn":"`+pimDn+`/autorp","ctrl":"forward"}}}]}`)
+	s.RespondGet("/api/node/mo/"+pimDn+"/bsrp.json",
+		`{"totalCount":"1","imdata":[{"pimBSRPPol":{"attributes":{"dn":"`+pimDn+`/bsrp","ctrl":""}}}]}`)
+	s.RespondGet("/api/node/mo/"+pimDn+"/ssmpat/ssmrange/rsfilterToRtMapPol.json",
+		`{"totalCount":"1","imdata":[{"rtdmcRsFilterToRtMapPol":{"attributes":{"dn":"`+pimDn+`/ssmpat/ssmrange/rsfilterToRtMapPol","tDn":"uni/tn-web/rtmap-ssm"}}}]}`)
+	return s
+}
+
+var (
+	firstRP  = v1alpha1.MulticastRP{Address: "10.0.0.1", GroupRouteMap: "rp-groups"}
+	secondRP = v1alpha1.MulticastRP{Address: "10.0.0.2"}
+)
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotVrfMulticast": {
+			reason: "An error should be returned if the managed resource is not a VrfMulticast",
+			want: want{
+				err: errors.New(errNotVrfMulticast),
+			},
+		},
+		"UpToDate": {
+			reason: "The rendezvous points, forwarding and ranges should match",
+			mg: vrfMulticast(v1alpha1.VrfMulticastParameters{
+				StaticRPs:        []v1alpha1.MulticastRP{firstRP, secondRP},
+				AutoRPForwarding: "enabled",
+				BSRForwarding:    "disabled",
+				SSMRangeRouteMap: "ssm",
+			}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"GroupRouteMapChanged": {
+			reason: "A rendezvous point serving other groups should be drift",
+			mg: vrfMulticast(v1alpha1.VrfMulticastParameters{
+				StaticRPs:        []v1alpha1.MulticastRP{{Address: "10.0.0.1"}, secondRP},
+				AutoRPForwarding: "enabled",
+				BSRForwarding:    "disabled",
+				SSMRangeRouteMap: "ssm",
+			}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"AutoRPForwardingDisabled": {
+			reason: "Auto-RP messages that are no longer forwarded should be drift",
+			mg: vrfMulticast(v1alpha1.VrfMulticastParameters{
+				StaticRPs:        []v1alpha1.MulticastRP{firstRP, secondRP},
+				AutoRPForwarding: "disabled",
+				BSRForwarding:    "disabled",
+				SSMRangeRouteMap: "ssm",
+			}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := apic()
+			defer s.Close()
+
+			e := external{apicClient: s.APICClient()}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	s := apic()
+	defer s.Close()
+
+	e := external{apicClient: s.APICClient()}
+	mg := vrfMulticast(v1alpha1.VrfMulticastParameters{
+		StaticRPs:        []v1alpha1.MulticastRP{firstRP},
+		AutoRPForwarding: "enabled",
+		BSRForwarding:    "disabled",
+	})
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("e.Update(...): %s", err)
+	}
+	posts := s.Posts()
+	if diff := cmp.Diff([]string{pimDn + "/staticrp/staticrpent-[10.0.0.1]/rpgrprange/rsfilterToRtMapPol"}, fakeapic.Saved(posts, "rtdmcRsFilterToRtMapPol")); diff != "" {
+		t.Errorf("e.Update(...): only the group range of the remaining rendezvous point should point to a route map: -want, +got:\n%s\n", diff)
+	}
+	want := []string{
+		pimDn + "/asmpat/sharedrange/rsfilterToRtMapPol",
+		pimDn + "/ssmpat/ssmrange/rsfilterToRtMapPol",
+		pimDn + "/staticrp/staticrpent-[10.0.0.2]",
+	}
+	if diff := cmp.Diff(want, fakeapic.Deleted(posts)); diff != "" {
+		t.Errorf("e.Update(...): the removed rendezvous point and the unset ranges should be deleted: -want, +got:\n%s\n", diff)
+	}
+}
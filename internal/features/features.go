@@ -29,4 +29,9 @@ const (
 	// Management Policies. See the below design for more details.
 	// https://github.com/crossplane/crossplane/blob/master/design/design-doc-observe-only-resources.md
 	EnableAlphaManagementPolicies feature.Flag = "EnableAlphaManagementPolicies"
+
+	// EnableAlphaSubscriptions enables alpha support for reconciling
+	// managed resources when their APIC objects change, using the APIC
+	// websocket subscriptions.
+	EnableAlphaSubscriptions feature.Flag = "EnableAlphaSubscriptions"
 )
@@ -0,0 +1,326 @@
+package subscription
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/ciscoecosystem/aci-go-client/v2/models"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisv1alpha1 "github.com/jgomezve/provider-aci/apis/v1alpha1"
+)
+
+const (
+	// APIC subscriptions expire after 80 seconds without refresh.
+	refreshInterval = 30 * time.Second
+	retryInterval   = 30 * time.Second
+
+	errGetPC        = "cannot get ProviderConfig"
+	errGetCreds     = "cannot get credentials"
+	errLogin        = "cannot log in to APIC"
+	errDial         = "cannot open APIC websocket"
+	errSubscribe    = "cannot subscribe to %s"
+	errRefresh      = "cannot refresh subscription %s"
+	errNotification = "cannot receive notification"
+)
+
+type credentials struct {
+	Url      string `json:"url"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Insecure bool   `json:"insecure"`
+}
+
+// A notification is a change notification received on the APIC websocket.
+type notification struct {
+	SubscriptionIDs []string `json:"subscriptionId"`
+}
+
+// A session is the websocket of a ProviderConfig and its subscriptions. It
+// reconnects and resubscribes when the websocket or a refresh fails.
+type session struct {
+	name    string
+	kube    client.Client
+	log     logging.Logger
+	enqueue func([]target)
+
+	mu sync.Mutex
+	// apicClient is the client of the connected websocket, nil if
+	// disconnected.
+	apicClient *aciclient.Client
+	targets    map[string]map[target]bool
+	dns        map[target]string
+	// ids are the DNs of the subscription IDs, subscribed the DNs.
+	ids        map[string]string
+	subscribed map[string]string
+}
+
+func newSession(name string, kube client.Client, log logging.Logger, enqueue func([]target)) *session {
+	return &session{
+		name:       name,
+		kube:       kube,
+		log:        log,
+		enqueue:    enqueue,
+		targets:    map[string]map[target]bool{},
+		dns:        map[target]string{},
+		ids:        map[string]string{},
+		subscribed: map[string]string{},
+	}
+}
+
+// run serves the websocket until the supplied context is done.
+func (s *session) run(ctx context.Context) {
+	for {
+		if err := s.serve(ctx); err != nil && ctx.Err() == nil {
+			s.log.Info("Subscriptions unhealthy, polling", "error", err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// subscribe subscribes the supplied managed resource to the APIC object with
+// the supplied DN, and returns whether the subscription is healthy.
+func (s *session) subscribe(ctx context.Context, dn string, t target) bool {
+	s.mu.Lock()
+	if old, ok := s.dns[t]; ok && old != dn {
+		s.drop(old, t)
+	}
+	s.dns[t] = dn
+	if s.targets[dn] == nil {
+		s.targets[dn] = map[target]bool{}
+	}
+	s.targets[dn][t] = true
+	a := s.apicClient
+	_, ok := s.subscribed[dn]
+	s.mu.Unlock()
+
+	if ok || a == nil {
+		return ok
+	}
+	id, err := subscribe(a, dn)
+	if err != nil {
+		s.log.Debug("Cannot subscribe", "dn", dn, "error", err.Error())
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.apicClient != a {
+		return false
+	}
+	s.ids[id] = dn
+	s.subscribed[dn] = id
+	return true
+}
+
+// unsubscribe drops the subscription of the supplied managed resource. The
+// APIC subscription expires once it is not refreshed anymore.
+func (s *session) unsubscribe(t target) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if dn, ok := s.dns[t]; ok {
+		s.drop(dn, t)
+	}
+}
+
+func (s *session) drop(dn string, t target) {
+	delete(s.dns, t)
+	delete(s.targets[dn], t)
+	if len(s.targets[dn]) > 0 {
+		return
+	}
+	delete(s.targets, dn)
+	delete(s.subscribed, dn)
+	for id, d := range s.ids {
+		if d == dn {
+			delete(s.ids, id)
+		}
+	}
+}
+
+// serve connects the websocket, subscribes to all the DNs and enqueues the
+// managed resources of the notifications until the websocket fails.
+func (s *session) serve(ctx context.Context) error {
+	a, conn, err := s.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close() //nolint:errcheck
+
+	s.mu.Lock()
+	dns := make([]string, 0, len(s.targets))
+	for dn := range s.targets {
+		dns = append(dns, dn)
+	}
+	s.mu.Unlock()
+	ids := map[string]string{}
+	subscribed := map[string]string{}
+	for _, dn := range dns {
+		// The managed resources of a DN that cannot be subscribed to are
+		// polled, and subscribed again when they are next reconciled.
+		id, err := subscribe(a, dn)
+		if err != nil {
+			s.log.Debug("Cannot subscribe", "dn", dn, "error", err.Error())
+			continue
+		}
+		ids[id] = dn
+		subscribed[dn] = id
+	}
+	s.mu.Lock()
+	s.apicClient, s.ids, s.subscribed = a, ids, subscribed
+	s.mu.Unlock()
+	defer s.disconnect()
+	s.log.Debug("Subscriptions healthy", "subscriptions", len(ids))
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(refreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				conn.Close() //nolint:errcheck
+				return
+			case <-ticker.C:
+				if err := s.refresh(a); err != nil {
+					s.log.Info("Subscriptions unhealthy, polling", "error", err.Error())
+					conn.Close() //nolint:errcheck
+					return
+				}
+			}
+		}
+	}()
+
+	for {
+		var n notification
+		if err := websocket.JSON.Receive(conn, &n); err != nil {
+			return errors.Wrap(err, errNotification)
+		}
+		s.notify(n)
+	}
+}
+
+// disconnect drops all the subscriptions and enqueues all the managed
+// resources, so that they are polled at the poll interval until they are
+// subscribed again. Changes missed while disconnected are observed too.
+func (s *session) disconnect() {
+	s.mu.Lock()
+	s.apicClient, s.ids, s.subscribed = nil, map[string]string{}, map[string]string{}
+	targets := make([]target, 0, len(s.dns))
+	for t := range s.dns {
+		targets = append(targets, t)
+	}
+	s.mu.Unlock()
+	s.enqueue(targets)
+}
+
+// connect logs in to the APIC of the ProviderConfig and opens its websocket.
+func (s *session) connect(ctx context.Context) (*aciclient.Client, *websocket.Conn, error) {
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := s.kube.Get(ctx, types.NamespacedName{Name: s.name}, pc); err != nil {
+		return nil, nil, errors.Wrap(err, errGetPC)
+	}
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, s.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errGetCreds)
+	}
+	var creds credentials
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return nil, nil, errors.Wrap(err, errGetCreds)
+	}
+
+	a := aciclient.NewClient(creds.Url, creds.Username, aciclient.Password(creds.Password), aciclient.Insecure(creds.Insecure))
+	if err := a.Authenticate(); err != nil {
+		return nil, nil, errors.Wrap(err, errLogin)
+	}
+	wsURL, err := WebsocketURL(creds.Url, a.AuthToken.Token)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errDial)
+	}
+	cfg, err := websocket.NewConfig(wsURL, creds.Url)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errDial)
+	}
+	cfg.TlsConfig = &tls.Config{InsecureSkipVerify: creds.Insecure} //nolint:gosec
+	conn, err := websocket.DialConfig(cfg)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errDial)
+	}
+	return a, conn, nil
+}
+
+// WebsocketURL returns the URL of the websocket of the APIC with the supplied
+// URL for the supplied login token.
+func WebsocketURL(apicURL, token string) (string, error) {
+	u, err := url.Parse(apicURL)
+	if err != nil {
+		return "", err
+	}
+	scheme := "wss"
+	if u.Scheme == "http" {
+		scheme = "ws"
+	}
+	return fmt.Sprintf("%s://%s/socket%s", scheme, u.Host, token), nil
+}
+
+// subscribe subscribes to the changes of the subtree of the APIC object with
+// the supplied DN and returns the ID of the subscription.
+func subscribe(a *aciclient.Client, dn string) (string, error) {
+	cont, err := a.GetViaURL(fmt.Sprintf("/api/node/mo/%s.json?query-target=subtree&subscription=yes", dn))
+	if err != nil {
+		return "", errors.Wrapf(err, errSubscribe, dn)
+	}
+	id := models.StripQuotes(cont.S("subscriptionId").String())
+	if id == "" || id == "null" {
+		return "", errors.Errorf(errSubscribe, dn)
+	}
+	return id, nil
+}
+
+// refresh refreshes all the subscriptions so that they do not expire.
+func (s *session) refresh(a *aciclient.Client) error {
+	s.mu.Lock()
+	ids := make([]string, 0, len(s.ids))
+	for id := range s.ids {
+		ids = append(ids, id)
+	}
+	s.mu.Unlock()
+	for _, id := range ids {
+		if _, err := a.GetViaURL(fmt.Sprintf("/api/subscriptionRefresh.json?id=%s", id)); err != nil {
+			return errors.Wrapf(err, errRefresh, id)
+		}
+	}
+	return nil
+}
+
+// notify enqueues the managed resources subscribed to the objects of the
+// supplied notification.
+func (s *session) notify(n notification) {
+	s.mu.Lock()
+	var targets []target
+	for _, id := range n.SubscriptionIDs {
+		for t := range s.targets[s.ids[id]] {
+			targets = append(targets, t)
+		}
+	}
+	s.mu.Unlock()
+	s.enqueue(targets)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	apisv1alpha1 "github.com/jgomezve/provider-aci/apis/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/fakeapic"
+)
+
+const (
+	goodDn = "uni/tn-crossplane/BD-web"
+	badDn  = "uni/tn-crossplane/BD-db"
+)
+
+// apicKube returns a client of a ProviderConfig with the credentials of the
+// supplied fake APIC.
+func apicKube(t *testing.T, apic *fakeapic.Server) client.Client {
+	t.Helper()
+	creds, err := json.Marshal(credentials{Url: apic.URL, Username: "admin", Password: "password", Insecure: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		switch o := obj.(type) {
+		case *apisv1alpha1.ProviderConfig:
+			o.Spec.Credentials.Source = xpv1.CredentialsSourceSecret
+			o.Spec.Credentials.SecretRef = &xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Name: "apic", Namespace: "crossplane-system"},
+				Key:             "credentials",
+			}
+		case *corev1.Secret:
+			o.Data = map[string][]byte{"credentials": creds}
+		}
+		return nil
+	}}
+}
+
+func receive(t *testing.T, enqueued <-chan []target) []target {
+	t.Helper()
+	select {
+	case targets := <-enqueued:
+		return targets
+	case <-time.After(5 * time.Second):
+		t.Fatal("no managed resource enqueued")
+		return nil
+	}
+}
+
+func TestServe(t *testing.T) {
+	apic := fakeapic.New()
+	defer apic.Close()
+	apic.FailGet("/api/node/mo/"+badDn+".json", "unknown object")
+
+	enqueued := make(chan []target, 8)
+	s := newSession("example", apicKube(t, apic), logging.NewNopLogger(), func(targets []target) { enqueued <- targets })
+	good := target{gvk: v1alpha1.BridgeDomainGroupVersionKind, name: "web"}
+	bad := target{gvk: v1alpha1.BridgeDomainGroupVersionKind, name: "db"}
+	s.subscribe(context.Background(), goodDn, good)
+	s.subscribe(context.Background(), badDn, bad)
+
+	errs := make(chan error, 1)
+	go func() { errs <- s.serve(context.Background()) }()
+
+	// Wait for the websocket to be served before sending notifications.
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		s.mu.Lock()
+		connected := s.apicClient != nil
+		s.mu.Unlock()
+		if connected {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("session not connected")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	s.mu.Lock()
+	_, badSubscribed := s.subscribed[badDn]
+	_, goodSubscribed := s.subscribed[goodDn]
+	s.mu.Unlock()
+	if badSubscribed || !goodSubscribed {
+		t.Errorf("serve(...): a DN that cannot be subscribed to should be skipped, the others subscribed")
+	}
+
+	apic.Notify(apic.SubscriptionID(goodDn))
+	if diff := cmp.Diff([]target{good}, receive(t, enqueued), cmp.AllowUnexported(target{})); diff != "" {
+		t.Errorf("serve(...): a notification should enqueue its managed resources: -want, +got:\n%s\n", diff)
+	}
+
+	apic.Disconnect()
+	if err := <-errs; err == nil {
+		t.Errorf("serve(...): want error on disconnect")
+	}
+	sorted := cmpopts.SortSlices(func(x, y target) bool { return x.name < y.name })
+	if diff := cmp.Diff([]target{bad, good}, receive(t, enqueued), cmp.AllowUnexported(target{}), sorted); diff != "" {
+		t.Errorf("serve(...): a disconnect should enqueue all the managed resources: -want, +got:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,228 @@
+// Package subscription reconciles managed resources when their APIC objects
+// change, using the APIC websocket subscriptions, instead of only polling
+// them.
+package subscription
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/jgomezve/provider-aci/internal/clients/expiry"
+	"github.com/jgomezve/provider-aci/internal/features"
+)
+
+const (
+	fieldPathDn = "status.atProvider.dn"
+
+	eventBufferSize = 1024
+
+	errNotSetUp = "subscriptions are enabled but not set up"
+)
+
+// subscribers are the Subscribers of the controller managers.
+var subscribers sync.Map
+
+// A target is a managed resource subscribed to the APIC object with its DN.
+type target struct {
+	gvk  schema.GroupVersionKind
+	name string
+}
+
+// A Subscriber subscribes to the APIC objects of the managed resources, with
+// a websocket per ProviderConfig, and enqueues a managed resource when its
+// APIC object, or an object of its subtree, changes.
+type Subscriber struct {
+	kube                client.Client
+	scheme              *runtime.Scheme
+	log                 logging.Logger
+	healthyPollInterval time.Duration
+
+	mu       sync.Mutex
+	ctx      context.Context
+	sessions map[string]*session
+	events   map[schema.GroupVersionKind]chan event.GenericEvent
+}
+
+// Setup adds a Subscriber to the supplied controller manager. The managed
+// resources with a healthy subscription are polled at the supplied interval
+// instead of the poll interval of the controller options.
+func Setup(mgr ctrl.Manager, o controller.Options, healthyPollInterval time.Duration) error {
+	s := &Subscriber{
+		kube:                mgr.GetClient(),
+		scheme:              mgr.GetScheme(),
+		log:                 o.Logger.WithValues("controller", "subscription"),
+		healthyPollInterval: healthyPollInterval,
+		sessions:            map[string]*session{},
+		events:              map[schema.GroupVersionKind]chan event.GenericEvent{},
+	}
+	subscribers.Store(mgr, s)
+	return mgr.Add(s)
+}
+
+// Complete completes the supplied controller builder of the managed
+// resources of the supplied kind with the supplied reconciler. If
+// subscriptions are enabled, the managed resources are also reconciled when
+// their APIC objects change.
+func Complete(mgr ctrl.Manager, o controller.Options, b *builder.Builder, gvk schema.GroupVersionKind, r reconcile.Reconciler) error {
+	if !o.Features.Enabled(features.EnableAlphaSubscriptions) {
+		return b.Complete(r)
+	}
+	v, ok := subscribers.Load(mgr)
+	if !ok {
+		return errors.New(errNotSetUp)
+	}
+	s := v.(*Subscriber)
+	return b.
+		Watches(&source.Channel{Source: s.register(gvk)}, &handler.EnqueueRequestForObject{}).
+		Complete(&subscribedReconciler{Reconciler: r, subscriber: s, gvk: gvk, pollInterval: o.PollInterval})
+}
+
+// Start runs the subscriptions until the supplied context is done.
+func (s *Subscriber) Start(ctx context.Context) error {
+	s.mu.Lock()
+	s.ctx = ctx
+	for _, ss := range s.sessions {
+		go ss.run(ctx)
+	}
+	s.mu.Unlock()
+	<-ctx.Done()
+	return nil
+}
+
+func (s *Subscriber) register(gvk schema.GroupVersionKind) <-chan event.GenericEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ch, ok := s.events[gvk]
+	if !ok {
+		ch = make(chan event.GenericEvent, eventBufferSize)
+		s.events[gvk] = ch
+	}
+	return ch
+}
+
+// enqueue enqueues the supplied managed resources. A managed resource is
+// dropped if its queue is full, it is polled anyway.
+func (s *Subscriber) enqueue(targets []target) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, t := range targets {
+		ch, ok := s.events[t.gvk]
+		if !ok {
+			continue
+		}
+		obj, err := s.scheme.New(t.gvk)
+		if err != nil {
+			continue
+		}
+		o, ok := obj.(client.Object)
+		if !ok {
+			continue
+		}
+		o.SetName(t.name)
+		select {
+		case ch <- event.GenericEvent{Object: o}:
+		default:
+		}
+	}
+}
+
+// session returns the session of the ProviderConfig with the supplied name,
+// starting it if the Subscriber is started.
+func (s *Subscriber) session(pc string) *session {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ss, ok := s.sessions[pc]
+	if !ok {
+		ss = newSession(pc, s.kube, s.log.WithValues("providerConfig", pc), s.enqueue)
+		s.sessions[pc] = ss
+		if s.ctx != nil {
+			go ss.run(s.ctx)
+		}
+	}
+	return ss
+}
+
+// forget drops the subscription of the supplied managed resource from all
+// the sessions.
+func (s *Subscriber) forget(t target) {
+	s.mu.Lock()
+	sessions := make([]*session, 0, len(s.sessions))
+	for _, ss := range s.sessions {
+		sessions = append(sessions, ss)
+	}
+	s.mu.Unlock()
+	for _, ss := range sessions {
+		ss.unsubscribe(t)
+	}
+}
+
+// track subscribes to the APIC object of the supplied managed resource and
+// returns whether it can be polled at the healthy poll interval: its
+// subscription is healthy and it does not expire, as the APIC does not notify
+// expiry.
+func (s *Subscriber) track(ctx context.Context, t target) bool {
+	obj, err := s.scheme.New(t.gvk)
+	if err != nil {
+		return false
+	}
+	mg, ok := obj.(resource.Managed)
+	if !ok {
+		return false
+	}
+	if err := s.kube.Get(ctx, types.NamespacedName{Name: t.name}, mg); err != nil || meta.WasDeleted(mg) {
+		s.forget(t)
+		return false
+	}
+	p, err := fieldpath.PaveObject(mg)
+	if err != nil {
+		return false
+	}
+	dn, _ := p.GetString(fieldPathDn)
+	ref := mg.GetProviderConfigReference()
+	if dn == "" || ref == nil {
+		s.forget(t)
+		return false
+	}
+	healthy := s.session(ref.Name).subscribe(ctx, dn, t)
+	if at, _ := p.GetString(expiry.FieldPathExpiresAt); at != "" {
+		return false
+	}
+	return healthy
+}
+
+// A subscribedReconciler subscribes to the APIC object of each managed
+// resource it reconciles, and polls the managed resources with a healthy
+// subscription at the healthy poll interval.
+type subscribedReconciler struct {
+	reconcile.Reconciler
+	subscriber   *Subscriber
+	gvk          schema.GroupVersionKind
+	pollInterval time.Duration
+}
+
+func (r *subscribedReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	res, err := r.Reconciler.Reconcile(ctx, req)
+	healthy := r.subscriber.track(ctx, target{gvk: r.gvk, name: req.Name})
+	if healthy && err == nil && res.RequeueAfter == r.pollInterval {
+		res.RequeueAfter = r.subscriber.healthyPollInterval
+	}
+	return res, err
+}
@@ -0,0 +1,304 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	aciclient "github.com/ciscoecosystem/aci-go-client/v2/client"
+	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	appv1alpha1 "github.com/jgomezve/provider-aci/apis/application-management/v1alpha1"
+	monv1alpha1 "github.com/jgomezve/provider-aci/apis/monitoring/v1alpha1"
+	"github.com/jgomezve/provider-aci/apis/networking/v1alpha1"
+	"github.com/jgomezve/provider-aci/internal/clients/expiry"
+)
+
+const (
+	pollInterval        = time.Minute
+	healthyPollInterval = 30 * time.Minute
+
+	dn = "uni/tn-crossplane/ctx-prod/pimctxp"
+)
+
+func newTestSubscriber(t *testing.T, kube client.Client) *Subscriber {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.SchemeBuilder.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := appv1alpha1.SchemeBuilder.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := monv1alpha1.SchemeBuilder.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return &Subscriber{
+		kube:                kube,
+		scheme:              scheme,
+		log:                 logging.NewNopLogger(),
+		healthyPollInterval: healthyPollInterval,
+		sessions:            map[string]*session{},
+		events:              map[schema.GroupVersionKind]chan event.GenericEvent{},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	type args struct {
+		connected bool
+		kube      client.Client
+		result    reconcile.Result
+	}
+
+	type want struct {
+		result     reconcile.Result
+		subscribed bool
+	}
+
+	vrfMulticast := func(obj client.Object) error {
+		cr := obj.(*v1alpha1.VrfMulticast)
+		cr.SetName("prod")
+		cr.SetProviderConfigReference(&xpv1.Reference{Name: "example"})
+		cr.Status.AtProvider.Dn = dn
+		return nil
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"HealthySubscription": {
+			reason: "A managed resource with a healthy subscription should be polled at the healthy poll interval",
+			args: args{
+				connected: true,
+				kube:      &test.MockClient{MockGet: test.NewMockGetFn(nil, vrfMulticast)},
+				result:    reconcile.Result{RequeueAfter: pollInterval},
+			},
+			want: want{
+				result:     reconcile.Result{RequeueAfter: healthyPollInterval},
+				subscribed: true,
+			},
+		},
+		"UnhealthySubscription": {
+			reason: "A managed resource should be polled at the poll interval while its websocket is disconnected",
+			args: args{
+				kube:   &test.MockClient{MockGet: test.NewMockGetFn(nil, vrfMulticast)},
+				result: reconcile.Result{RequeueAfter: pollInterval},
+			},
+			want: want{
+				result:     reconcile.Result{RequeueAfter: pollInterval},
+				subscribed: true,
+			},
+		},
+		"NotUpToDate": {
+			reason: "The requeue of a managed resource that is not polled should not change",
+			args: args{
+				connected: true,
+				kube:      &test.MockClient{MockGet: test.NewMockGetFn(nil, vrfMulticast)},
+				result:    reconcile.Result{Requeue: true},
+			},
+			want: want{
+				result:     reconcile.Result{Requeue: true},
+				subscribed: true,
+			},
+		},
+		"Deleted": {
+			reason: "The subscription of a deleted managed resource should be dropped",
+			args: args{
+				connected: true,
+				kube:      &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "prod"))},
+			},
+			want: want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := newTestSubscriber(t, tc.args.kube)
+			ss := s.session("example")
+			if tc.args.connected {
+				ss.apicClient = &aciclient.Client{}
+				ss.subscribed[dn] = "1"
+				ss.ids["1"] = dn
+			}
+			ss.targets[dn] = map[target]bool{{gvk: v1alpha1.VrfMulticastGroupVersionKind, name: "prod"}: true}
+			ss.dns[target{gvk: v1alpha1.VrfMulticastGroupVersionKind, name: "prod"}] = dn
+
+			r := &subscribedReconciler{
+				Reconciler: reconcile.Func(func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+					return tc.args.result, nil
+				}),
+				subscriber:   s,
+				gvk:          v1alpha1.VrfMulticastGroupVersionKind,
+				pollInterval: pollInterval,
+			}
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: client.ObjectKey{Name: "prod"}})
+			if err != nil {
+				t.Fatalf("\n%s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if _, subscribed := ss.targets[dn]; subscribed != tc.want.subscribed {
+				t.Errorf("\n%s\nr.Reconcile(...): want subscribed %t, got %t", tc.reason, tc.want.subscribed, subscribed)
+			}
+		})
+	}
+}
+
+func TestReconcileExpiring(t *testing.T) {
+	const srcDn = "uni/infra/srcgrp-capture"
+
+	cases := map[string]struct {
+		reason    string
+		expiresIn time.Duration
+		min, max  time.Duration
+	}{
+		"ExpiresBeforePoll": {
+			reason:    "A SpanSourceGroup with a healthy subscription should be requeued when it expires",
+			expiresIn: 30 * time.Second,
+			min:       29 * time.Second,
+			max:       31 * time.Second,
+		},
+		"ExpiresAfterPoll": {
+			reason:    "A SpanSourceGroup with a healthy subscription should be polled at the poll interval until it expires",
+			expiresIn: 10 * time.Minute,
+			min:       pollInterval,
+			max:       pollInterval,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			expiresAt := metav1.NewTime(time.Now().Add(tc.expiresIn))
+			kube := &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				cr := obj.(*monv1alpha1.SpanSourceGroup)
+				cr.SetName("capture")
+				cr.SetProviderConfigReference(&xpv1.Reference{Name: "example"})
+				cr.Status.AtProvider.Dn = srcDn
+				cr.Status.AtProvider.ExpiresAt = &expiresAt
+				return nil
+			})}
+			s := newTestSubscriber(t, kube)
+			ss := s.session("example")
+			ss.apicClient = &aciclient.Client{}
+			ss.subscribed[srcDn] = "1"
+
+			polled := reconcile.Func(func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				return reconcile.Result{RequeueAfter: pollInterval}, nil
+			})
+			r := &subscribedReconciler{
+				Reconciler:   expiry.NewReconciler(polled, kube, func() resource.Managed { return &monv1alpha1.SpanSourceGroup{} }),
+				subscriber:   s,
+				gvk:          monv1alpha1.SpanSourceGroupGroupVersionKind,
+				pollInterval: pollInterval,
+			}
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: client.ObjectKey{Name: "capture"}})
+			if err != nil {
+				t.Fatalf("\n%s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			if got.RequeueAfter < tc.min || got.RequeueAfter > tc.max {
+				t.Errorf("\n%s\nr.Reconcile(...): want requeue after %s to %s, got %s", tc.reason, tc.min, tc.max, got.RequeueAfter)
+			}
+		})
+	}
+}
+
+func TestTrack(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		gvk    schema.GroupVersionKind
+		dn     string
+		mg     func(obj client.Object) error
+	}{
+		"BridgeDomain": {
+			reason: "A BridgeDomain should be subscribed to the DN of its fvBD",
+			gvk:    v1alpha1.BridgeDomainGroupVersionKind,
+			dn:     "uni/tn-crossplane/BD-web",
+			mg: func(obj client.Object) error {
+				cr := obj.(*v1alpha1.BridgeDomain)
+				cr.SetProviderConfigReference(&xpv1.Reference{Name: "example"})
+				cr.Status.AtProvider.Dn = "uni/tn-crossplane/BD-web"
+				return nil
+			},
+		},
+		"EndpointGroup": {
+			reason: "An EndpointGroup should be subscribed to the DN of its fvAEPg",
+			gvk:    appv1alpha1.EndpointGroupGroupVersionKind,
+			dn:     "uni/tn-crossplane/ap-shop/epg-web",
+			mg: func(obj client.Object) error {
+				cr := obj.(*appv1alpha1.EndpointGroup)
+				cr.SetProviderConfigReference(&xpv1.Reference{Name: "example"})
+				cr.Status.AtProvider.Dn = "uni/tn-crossplane/ap-shop/epg-web"
+				return nil
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := newTestSubscriber(t, &test.MockClient{MockGet: test.NewMockGetFn(nil, tc.mg)})
+			ss := s.session("example")
+			ss.apicClient = &aciclient.Client{}
+			ss.subscribed[tc.dn] = "1"
+
+			if !s.track(context.Background(), target{gvk: tc.gvk, name: "web"}) {
+				t.Errorf("\n%s\ns.track(...): want healthy subscription", tc.reason)
+			}
+			if diff := cmp.Diff(map[target]bool{{gvk: tc.gvk, name: "web"}: true}, ss.targets[tc.dn]); diff != "" {
+				t.Errorf("\n%s\ns.track(...): -want targets, +got targets:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestWebsocketURL(t *testing.T) {
+	cases := map[string]struct {
+		apicURL string
+		want    string
+	}{
+		"HTTPS": {apicURL: "https://apic.example.com", want: "wss://apic.example.com/sockettoken"},
+		"HTTP":  {apicURL: "http://10.0.0.1:8080", want: "ws://10.0.0.1:8080/sockettoken"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := WebsocketURL(tc.apicURL, "token")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("WebsocketURL(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}